@@ -0,0 +1,322 @@
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// finishedGameStatus is the status of a game which is final
+const finishedGameStatus = 3
+
+// preseasonGamePrefix starts the IDs of preseason games
+const preseasonGamePrefix = "001"
+
+// cacheLockTimeout is how long a request waits for the daemon or the TUI to finish writing the cache
+var cacheLockTimeout = 30 * time.Second
+
+// maxAttempts is how often a request is tried before the backfill stops, each retry waiting twice as long
+const maxAttempts = 3
+
+// Fetcher fetches the responses the backfill archives into the cache, it is implemented by nbaAPI.Client
+type Fetcher interface {
+	FetchDailyScoresForDate(date string) error
+	FetchBoxScore(gameID string) error
+}
+
+// CachePaths tells where the fetcher caches the scoreboard of a date and the box score of a game. Lock is the
+// lockfile shared with the daemon and the TUI, held while the fetcher writes into the cache.
+type CachePaths struct {
+	Scoreboard func(date string) string
+	BoxScore   func(gameID string) string
+	Lock       string
+}
+
+// Progress is what has been archived of a season, saved after every request so a backfill can be resumed
+type Progress struct {
+	Season    string   `json:"season"`
+	Dates     []string `json:"dates"`
+	BoxScores []string `json:"boxScores"`
+
+	dates     map[string]bool
+	boxScores map[string]bool
+}
+
+func (p *Progress) index() {
+	p.dates = make(map[string]bool, len(p.Dates))
+	for _, d := range p.Dates {
+		p.dates[d] = true
+	}
+	p.boxScores = make(map[string]bool, len(p.BoxScores))
+	for _, id := range p.BoxScores {
+		p.boxScores[id] = true
+	}
+}
+
+func (p *Progress) addDate(date string) {
+	p.dates[date] = true
+	p.Dates = append(p.Dates, date)
+}
+
+func (p *Progress) addBoxScore(gameID string) {
+	p.boxScores[gameID] = true
+	p.BoxScores = append(p.BoxScores, gameID)
+}
+
+// Backfill archives the scoreboard of every game date of a season and the box score of every finished game. The
+// archive of a season is a folder of its own, which the cleanup of the cache does not touch:
+//
+//	scoreboards/<date>.json
+//	boxscores/<gameID>.json
+//	progress.json
+type Backfill struct {
+	fetcher Fetcher
+	fs      filesystemops.FileSystemHandler
+	cache   CachePaths
+	dir     string
+	season  string
+	delay   time.Duration
+	out     io.Writer
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// New instantiates the backfill of a season into dir. Requests are at least delay apart, and the progress
+// is reported to out.
+func New(fetcher Fetcher, fs filesystemops.FileSystemHandler, cache CachePaths, dir, season string,
+	delay time.Duration, out io.Writer) *Backfill {
+	return &Backfill{
+		fetcher: fetcher,
+		fs:      fs,
+		cache:   cache,
+		dir:     dir,
+		season:  season,
+		delay:   delay,
+		out:     out,
+		sleep:   sleepContext,
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+var seasonPattern = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+
+// ValidateSeason checks a season is written like 2024-25
+func ValidateSeason(season string) error {
+	m := seasonPattern.FindStringSubmatch(season)
+	if m == nil {
+		return fmt.Errorf("invalid season %q, expected e.g. 2024-25", season)
+	}
+	start, _ := strconv.Atoi(m[1])
+	end, _ := strconv.Atoi(m[2])
+	if (start+1)%100 != end {
+		return fmt.Errorf("invalid season %q, expected e.g. %d-%02d", season, start, (start+1)%100)
+	}
+	return nil
+}
+
+// ScheduleDates returns the dates of a season's schedule with games, up to and including until. The schedule
+// knows when the season really started and ended, e.g. the 2020 bubble playoffs in August and September.
+// Preseason games are left out.
+func ScheduleDates(schedule []types.ScheduledGame, until time.Time) []string {
+	last := until.Format("2006-01-02")
+	seen := make(map[string]bool)
+	var dates []string
+	for _, g := range schedule {
+		if strings.HasPrefix(g.GameID, preseasonGamePrefix) || g.GameDate == "" || g.GameDate > last || seen[g.GameDate] {
+			continue
+		}
+		seen[g.GameDate] = true
+		dates = append(dates, g.GameDate)
+	}
+	sort.Strings(dates)
+	return dates
+}
+
+func (b *Backfill) scoreboardPath(date string) string {
+	return filepath.Join(b.dir, "scoreboards", date+".json")
+}
+
+func (b *Backfill) boxScorePath(gameID string) string {
+	return filepath.Join(b.dir, "boxscores", gameID+".json")
+}
+
+func (b *Backfill) progressPath() string {
+	return filepath.Join(b.dir, "progress.json")
+}
+
+// LoadProgress reads the progress of the season, nothing has been archived when there is no progress file
+func (b *Backfill) LoadProgress() (*Progress, error) {
+	p := &Progress{Season: b.season}
+	data, err := filesystemops.ReadConfigFile(b.fs, b.progressPath())
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		if err = json.Unmarshal(data, p); err != nil {
+			return nil, fmt.Errorf("could not unmarshal progress file %s: %w", b.progressPath(), err)
+		}
+	}
+	p.index()
+	return p, nil
+}
+
+func (b *Backfill) saveProgress(p *Progress) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal progress: %w", err)
+	}
+	return b.fs.WriteFile(b.progressPath(), data)
+}
+
+// fetch calls the API through the rate limit, retrying with a growing pause, and archives the cached response
+func (b *Backfill) fetch(ctx context.Context, fetch func() error, cached, archived string) ([]byte, error) {
+	var err error
+	wait := b.delay
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = b.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		if err = WithCacheLock(b.cache.Lock, fetch); err == nil {
+			break
+		}
+		wait *= 2
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed after %d attempts: %w", maxAttempts, err)
+	}
+
+	data, err := b.fs.ReadFile(cached)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("the response cached at %s is not valid JSON", cached)
+	}
+	if err = b.fs.WriteFile(archived, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// WithCacheLock runs fetch while holding the cache lockfile at path, waiting for a refresh of the daemon or the
+// TUI in progress to finish. Without a path fetch runs right away.
+func WithCacheLock(path string, fetch func() error) error {
+	if path == "" {
+		return fetch()
+	}
+	lock, err := filesystemops.TryLock(path)
+	if errors.Is(err, filesystemops.ErrLocked) {
+		lock, err = filesystemops.WaitLock(path, cacheLockTimeout)
+	}
+	if err != nil {
+		return fmt.Errorf("could not lock the cache: %w", err)
+	}
+	defer func() {
+		if err := lock.Unlock(); err != nil {
+			log.Println(err)
+		}
+	}()
+	return fetch()
+}
+
+// finishedGames returns the IDs of the finished games of an archived scoreboard
+func (b *Backfill) finishedGames(date string) ([]string, error) {
+	data, err := b.fs.ReadFile(b.scoreboardPath(date))
+	if err != nil {
+		return nil, err
+	}
+	var rs types.ResponseSet
+	if err = json.Unmarshal(data, &rs); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the scoreboard of %s: %w", date, err)
+	}
+	if rs.Scoreboard == nil {
+		return nil, nil
+	}
+	var ids []string
+	for _, g := range rs.Scoreboard.Games {
+		if g.GameStatus == finishedGameStatus {
+			ids = append(ids, g.GameID)
+		}
+	}
+	return ids, nil
+}
+
+// Run archives the scoreboards of the given dates, then the box scores of their finished games, skipping
+// what the progress file says is archived already. It stops at the first request which keeps failing, or
+// when the context is done, with the progress saved.
+func (b *Backfill) Run(ctx context.Context, dates []string) error {
+	p, err := b.LoadProgress()
+	if err != nil {
+		return err
+	}
+
+	for _, date := range dates {
+		if p.dates[date] {
+			continue
+		}
+		_, err = b.fetch(ctx, func() error { return b.fetcher.FetchDailyScoresForDate(date) },
+			b.cache.Scoreboard(date), b.scoreboardPath(date))
+		if err != nil {
+			return fmt.Errorf("scoreboard of %s: %w", date, err)
+		}
+		p.addDate(date)
+		if err = b.saveProgress(p); err != nil {
+			return err
+		}
+		fmt.Fprintf(b.out, "scoreboard %s archived\n", date)
+	}
+
+	var games []string
+	for _, date := range dates {
+		ids, err := b.finishedGames(date)
+		if err != nil {
+			return err
+		}
+		games = append(games, ids...)
+	}
+	sort.Strings(games)
+
+	done := 0
+	for _, gameID := range games {
+		if p.boxScores[gameID] {
+			done++
+			continue
+		}
+		_, err = b.fetch(ctx, func() error { return b.fetcher.FetchBoxScore(gameID) },
+			b.cache.BoxScore(gameID), b.boxScorePath(gameID))
+		if err != nil {
+			return fmt.Errorf("box score of %s: %w", gameID, err)
+		}
+		p.addBoxScore(gameID)
+		if err = b.saveProgress(p); err != nil {
+			return err
+		}
+		done++
+		fmt.Fprintf(b.out, "box score %s archived (%d/%d)\n", gameID, done, len(games))
+	}
+
+	fmt.Fprintf(b.out, "%s: %d dates and %d box scores archived in %s\n", b.season, len(p.Dates),
+		len(p.BoxScores), b.dir)
+	return nil
+}
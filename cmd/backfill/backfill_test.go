@@ -0,0 +1,211 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// memoryFs keeps the files in memory
+type memoryFs struct {
+	files map[string][]byte
+}
+
+func (m *memoryFs) WriteFile(path string, data []byte) error {
+	m.files[path] = data
+	return nil
+}
+
+func (m *memoryFs) ReadFile(path string) ([]byte, error) {
+	data, ok := m.files[path]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found: %w", path, os.ErrNotExist)
+	}
+	return data, nil
+}
+
+func (m *memoryFs) FileExists(path string) bool {
+	_, ok := m.files[path]
+	return ok
+}
+
+func (m *memoryFs) CleanOldFiles([]string) error       { return nil }
+func (m *memoryFs) EnsureDirectoryExists(string) error { return nil }
+func (m *memoryFs) ListFiles(string) ([]string, error) { return nil, nil }
+func (m *memoryFs) RemoveFile(path string) error       { delete(m.files, path); return nil }
+
+// fakeFetcher writes a scoreboard with two games, one of them finished, for every date
+type fakeFetcher struct {
+	fs       *memoryFs
+	requests []string
+	failures map[string]int
+}
+
+func (f *fakeFetcher) fail(key string) error {
+	if f.failures[key] > 0 {
+		f.failures[key]--
+		return errors.New("429 too many requests")
+	}
+	return nil
+}
+
+func (f *fakeFetcher) FetchDailyScoresForDate(date string) error {
+	f.requests = append(f.requests, date)
+	if err := f.fail(date); err != nil {
+		return err
+	}
+	id := strings.ReplaceAll(date, "-", "")
+	data := fmt.Sprintf(`{"scoreboard":{"gameDate":"%s","games":[{"gameId":"F%s","gameStatus":3},`+
+		`{"gameId":"S%s","gameStatus":1}]}}`, date, id, id)
+	return f.fs.WriteFile("/cache/"+date+"_dsb", []byte(data))
+}
+
+func (f *fakeFetcher) FetchBoxScore(gameID string) error {
+	f.requests = append(f.requests, gameID)
+	if err := f.fail(gameID); err != nil {
+		return err
+	}
+	return f.fs.WriteFile("/cache/boxscores/"+gameID, []byte(`{"boxScoreTraditional":{"gameId":"`+gameID+`"}}`))
+}
+
+var testCache = CachePaths{
+	Scoreboard: func(date string) string { return "/cache/" + date + "_dsb" },
+	BoxScore:   func(gameID string) string { return "/cache/boxscores/" + gameID },
+}
+
+func newTestBackfill(fetcher *fakeFetcher) *Backfill {
+	b := New(fetcher, fetcher.fs, testCache, "/archive/2024-25", "2024-25", time.Millisecond, io.Discard)
+	b.sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	return b
+}
+
+func TestValidateSeason(t *testing.T) {
+	if err := ValidateSeason("2024-25"); err != nil {
+		t.Errorf("ValidateSeason(2024-25) error: %v", err)
+	}
+	for _, season := range []string{"2024", "2024-26", "24-25"} {
+		if err := ValidateSeason(season); err == nil {
+			t.Errorf("expected an error for season %q", season)
+		}
+	}
+}
+
+func TestScheduleDates(t *testing.T) {
+	// the 2019-20 season was suspended in March and finished in the bubble, the Finals ending in October
+	schedule := []types.ScheduledGame{
+		{GameID: "0011900001", GameDate: "2019-10-04"},
+		{GameID: "0021900001", GameDate: "2019-10-22"},
+		{GameID: "0021900002", GameDate: "2019-10-22"},
+		{GameID: "0021900970", GameDate: "2020-03-11"},
+		{GameID: "0021900971", GameDate: "2020-07-30"},
+		{GameID: "0041900406", GameDate: "2020-10-11"},
+	}
+
+	dates := ScheduleDates(schedule, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	want := []string{"2019-10-22", "2020-03-11", "2020-07-30", "2020-10-11"}
+	if strings.Join(dates, ",") != strings.Join(want, ",") {
+		t.Errorf("ScheduleDates() = %v, want %v", dates, want)
+	}
+
+	dates = ScheduleDates(schedule, time.Date(2020, 3, 11, 15, 0, 0, 0, time.UTC))
+	if len(dates) != 2 || dates[1] != "2020-03-11" {
+		t.Errorf("expected the dates up to March 11th, got %v", dates)
+	}
+}
+
+func TestWithCacheLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.lock")
+	held := false
+	err := WithCacheLock(path, func() error {
+		_, err := filesystemops.TryLock(path)
+		held = errors.Is(err, filesystemops.ErrLocked)
+		return nil
+	})
+	if err != nil || !held {
+		t.Errorf("expected the lock to be held while fetching, got %v", err)
+	}
+
+	lock, err := filesystemops.TryLock(path)
+	if err != nil {
+		t.Fatalf("expected the lock to be released, got %v", err)
+	}
+	defer lock.Unlock()
+
+	cacheLockTimeout = 10 * time.Millisecond
+	defer func() { cacheLockTimeout = 30 * time.Second }()
+	fetched := false
+	if err = WithCacheLock(path, func() error { fetched = true; return nil }); err == nil || fetched {
+		t.Errorf("expected no fetch while another process holds the lock, got %v", err)
+	}
+}
+
+func TestBackfill_Run(t *testing.T) {
+	fs := &memoryFs{files: map[string][]byte{}}
+	fetcher := &fakeFetcher{fs: fs, failures: map[string]int{"2024-10-23": 1}}
+	dates := []string{"2024-10-22", "2024-10-23"}
+
+	if err := newTestBackfill(fetcher).Run(context.Background(), dates); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+
+	for _, path := range []string{
+		"/archive/2024-25/scoreboards/2024-10-22.json",
+		"/archive/2024-25/scoreboards/2024-10-23.json",
+		"/archive/2024-25/boxscores/F20241022.json",
+		"/archive/2024-25/boxscores/F20241023.json",
+		"/archive/2024-25/progress.json",
+	} {
+		if _, ok := fs.files[path]; !ok {
+			t.Errorf("expected %s to be archived", path)
+		}
+	}
+	if _, ok := fs.files["/archive/2024-25/boxscores/S20241022.json"]; ok {
+		t.Error("expected the box score of an unfinished game not to be fetched")
+	}
+	// the failed request is retried once
+	if len(fetcher.requests) != 5 {
+		t.Errorf("expected 5 requests, got %v", fetcher.requests)
+	}
+}
+
+func TestBackfill_Resume(t *testing.T) {
+	fs := &memoryFs{files: map[string][]byte{}}
+	fetcher := &fakeFetcher{fs: fs, failures: map[string]int{"F20241023": maxAttempts}}
+	dates := []string{"2024-10-22", "2024-10-23"}
+
+	err := newTestBackfill(fetcher).Run(context.Background(), dates)
+	if err == nil || !strings.Contains(err.Error(), "F20241023") {
+		t.Fatalf("expected the backfill to stop at the failing box score, got %v", err)
+	}
+
+	fetcher.requests = nil
+	if err = newTestBackfill(fetcher).Run(context.Background(), dates); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if len(fetcher.requests) != 1 || fetcher.requests[0] != "F20241023" {
+		t.Errorf("expected only the missing box score to be fetched when resuming, got %v", fetcher.requests)
+	}
+}
+
+func TestBackfill_Cancelled(t *testing.T) {
+	fs := &memoryFs{files: map[string][]byte{}}
+	fetcher := &fakeFetcher{fs: fs}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := newTestBackfill(fetcher).Run(ctx, []string{"2024-10-22"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the backfill to stop when cancelled, got %v", err)
+	}
+	if len(fetcher.requests) != 0 {
+		t.Errorf("expected no requests once cancelled, got %v", fetcher.requests)
+	}
+}
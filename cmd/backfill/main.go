@@ -0,0 +1,90 @@
+package backfill
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+)
+
+// DefaultDelay keeps the backfill well below the rate at which stats.nba.com starts refusing requests
+const DefaultDelay = 2 * time.Second
+
+// Main runs the backfill of the command line, `nba-now backfill -season 2024-25 [-delay 2s]`, and returns the
+// exit code. An interrupted backfill continues where it stopped when run again.
+func Main(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("backfill", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	season := flags.String("season", "", "the season to archive, e.g. 2024-25")
+	delay := flags.Duration("delay", DefaultDelay, "the pause between two requests")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *season == "" {
+		fmt.Fprintln(stderr, "the season is required, e.g. -season 2024-25")
+		flags.Usage()
+		return 2
+	}
+	if *delay < 500*time.Millisecond {
+		fmt.Fprintln(stderr, "the delay has to be at least 500ms")
+		return 2
+	}
+
+	if err := ValidateSeason(*season); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	cl := nbaAPI.NewClient()
+	cache := CachePaths{
+		Scoreboard: func(date string) string {
+			return pathManager.PathFactoryForDate(date).GetFullPath("dailyScores", "")
+		},
+		BoxScore: func(gameID string) string { return cl.Paths.GetFullPath("boxScore", gameID) },
+		Lock:     cl.Paths.GetFullPath("cacheLock", ""),
+	}
+
+	eastern, _ := time.LoadLocation("America/New_York")
+	// the games of today are not final yet
+	dates, err := seasonDates(cl, *season, cache.Lock, time.Now().In(eastern).AddDate(0, 0, -1))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dir := cl.Paths.GetFullPath("archive", *season)
+	b := New(cl, cl.FileSystem, cache, dir, *season, *delay, stdout)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err = b.Run(ctx, dates); err != nil {
+		fmt.Fprintln(stderr, "backfill stopped:", err)
+		fmt.Fprintln(stderr, "run it again to continue where it stopped")
+		return 1
+	}
+	return 0
+}
+
+// seasonDates reads the dates with games from the season schedule
+func seasonDates(cl *nbaAPI.Client, season, lockPath string, until time.Time) ([]string, error) {
+	if err := WithCacheLock(lockPath, func() error { return cl.FetchSeasonSchedule(season) }); err != nil {
+		return nil, fmt.Errorf("could not fetch the schedule of %s: %w", season, err)
+	}
+	rs, err := cl.Loader.LoadSeasonSchedule(season)
+	if err != nil {
+		return nil, fmt.Errorf("could not load the schedule of %s: %w", season, err)
+	}
+	schedule, err := converters.PopulateSeasonSchedule(rs)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the schedule of %s: %w", season, err)
+	}
+	return ScheduleDates(schedule, until), nil
+}
@@ -0,0 +1,215 @@
+package converters
+
+import (
+	"sort"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// Bracket challenge scoring: a correct series winner is worth more in every round,
+// guessing the exact number of games on top of that adds half the round's points.
+var (
+	challengeWinnerPoints = [5]int{0, 10, 20, 40, 80}
+	challengeGamesPoints  = [5]int{0, 5, 10, 20, 40}
+)
+
+// challengeFeeders lists, per later round series, the two series whose winners meet in it (cursor indexes).
+// The order is the order in which the series have to be resolved.
+var challengeFeeders = []struct {
+	series   int
+	top, bot int
+}{
+	{4, 0, 1}, {5, 2, 3}, {9, 11, 12}, {10, 13, 14}, // Semis
+	{6, 4, 5}, {8, 9, 10}, // Conference finals
+	{7, 6, 8}, // Finals
+}
+
+// SeriesRound returns the round of the series at a bracket cursor index
+func SeriesRound(series int) int {
+	switch {
+	case series <= 3 || (series >= 11 && series <= 14):
+		return 1
+	case series <= 5 || series == 9 || series == 10:
+		return 2
+	case series == 6 || series == 8:
+		return 3
+	case series == 7:
+		return 4
+	}
+	return 0
+}
+
+// PredictedBracket fills the bracket with the picks of a prediction: series which have not started yet
+// show the picked result and the undecided later rounds are filled with the picked winners.
+// Series which have already started are left as they are.
+func PredictedBracket(bracket types.PlayoffBracket, prediction types.BracketPrediction) types.PlayoffBracket {
+	if len(bracket.Series) < 15 {
+		return bracket
+	}
+	series := make([]types.PlayoffSeries, len(bracket.Series))
+	copy(series, bracket.Series)
+
+	pickedWinner := func(idx int) (types.PlayoffTeam, bool) {
+		if series[idx].Status != "pre" {
+			return series[idx].Winner()
+		}
+		pick, ok := prediction.Pick(idx)
+		if !ok {
+			return types.PlayoffTeam{}, false
+		}
+		for _, team := range []types.PlayoffTeam{series[idx].TopTeam, series[idx].BottomTeam} {
+			if team.Tricode == pick.Winner {
+				return team, true
+			}
+		}
+		return types.PlayoffTeam{}, false
+	}
+
+	applyPick := func(idx int) {
+		pick, ok := prediction.Pick(idx)
+		if !ok || series[idx].Status != "pre" {
+			return
+		}
+		games := pick.Games
+		if games < 4 || games > 7 {
+			games = 4
+		}
+		switch pick.Winner {
+		case series[idx].TopTeam.Tricode:
+			series[idx].TopTeam.Wins, series[idx].BottomTeam.Wins = 4, games-4
+		case series[idx].BottomTeam.Tricode:
+			series[idx].TopTeam.Wins, series[idx].BottomTeam.Wins = games-4, 4
+		}
+	}
+
+	for idx := 0; idx < 15; idx++ {
+		if SeriesRound(idx) == 1 {
+			applyPick(idx)
+		}
+	}
+	for _, feeder := range challengeFeeders {
+		s := &series[feeder.series]
+		if s.Status == "pre" {
+			if team, ok := pickedWinner(feeder.top); ok && IsTBD(s.TopTeam) {
+				s.TopTeam = advancedTeam(team, team.Seed)
+			}
+			if team, ok := pickedWinner(feeder.bot); ok && IsTBD(s.BottomTeam) {
+				s.BottomTeam = advancedTeam(team, team.Seed)
+			}
+		}
+		applyPick(feeder.series)
+	}
+
+	bracket.Series = series
+	return bracket
+}
+
+// PrunePicks removes the picks of series which have not started yet and whose picked winner can no longer play
+// in them, e.g. after an earlier round pick was changed
+func PrunePicks(bracket types.PlayoffBracket, prediction types.BracketPrediction) types.BracketPrediction {
+	if len(bracket.Series) < 15 {
+		return prediction
+	}
+	for {
+		predicted := PredictedBracket(bracket, prediction)
+		valid := prediction.Picks[:0:0]
+		for _, pick := range prediction.Picks {
+			if pick.Series < 0 || pick.Series >= 15 {
+				continue
+			}
+			s := predicted.Series[pick.Series]
+			if s.Status == "pre" && pick.Winner != s.TopTeam.Tricode && pick.Winner != s.BottomTeam.Tricode {
+				continue
+			}
+			valid = append(valid, pick)
+		}
+		if len(valid) == len(prediction.Picks) {
+			return prediction
+		}
+		prediction.Picks = valid
+	}
+}
+
+// ScorePrediction scores a prediction against the actual bracket. A pick is correct when the picked team won
+// a series of that round, so the score does not depend on how the API orders the later round series.
+// MaxPoints is the score the prediction can still reach if all remaining picks turn out right.
+func ScorePrediction(prediction types.BracketPrediction, actual types.PlayoffBracket) types.PredictionScore {
+	// games played in the series each team won, per round
+	wonInGames := [5]map[string]int{}
+	eliminated := make(map[string]bool)
+	for i := range wonInGames {
+		wonInGames[i] = make(map[string]int)
+	}
+	for idx, series := range actual.Series {
+		if idx >= 15 || !series.IsComplete() {
+			continue
+		}
+		winner, _ := series.Winner()
+		loser, _ := series.Loser()
+		wonInGames[SeriesRound(idx)][winner.Tricode] = series.TopTeam.Wins + series.BottomTeam.Wins
+		eliminated[loser.Tricode] = true
+	}
+
+	score := types.PredictionScore{Name: prediction.Name, Picks: len(prediction.Picks), UpdatedAt: prediction.UpdatedAt}
+	for _, pick := range prediction.Picks {
+		round := SeriesRound(pick.Series)
+		if round == 0 {
+			continue
+		}
+		games, won := wonInGames[round][pick.Winner]
+		switch {
+		case won:
+			score.CorrectWinners++
+			score.Points += challengeWinnerPoints[round]
+			score.MaxPoints += challengeWinnerPoints[round]
+			if pick.Games == games {
+				score.CorrectGames++
+				score.Points += challengeGamesPoints[round]
+				score.MaxPoints += challengeGamesPoints[round]
+			}
+		case !eliminated[pick.Winner]:
+			score.MaxPoints += challengeWinnerPoints[round]
+			if pick.Games >= 4 && pick.Games <= 7 {
+				score.MaxPoints += challengeGamesPoints[round]
+			}
+		}
+	}
+	return score
+}
+
+// PredictionScoreHeaders returns the table headers matching the fields of types.PredictionScore
+func PredictionScoreHeaders() []string {
+	return structJSONHeaders(types.PredictionScore{})
+}
+
+// BuildLeaderboard scores all predictions and ranks them by points, then by correct winners.
+// Equal scores share a rank.
+func BuildLeaderboard(predictions []types.BracketPrediction, actual types.PlayoffBracket) []types.PredictionScore {
+	scores := make([]types.PredictionScore, 0, len(predictions))
+	for _, prediction := range predictions {
+		scores = append(scores, ScorePrediction(prediction, actual))
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Points != scores[j].Points {
+			return scores[i].Points > scores[j].Points
+		}
+		if scores[i].CorrectWinners != scores[j].CorrectWinners {
+			return scores[i].CorrectWinners > scores[j].CorrectWinners
+		}
+		return scores[i].Name < scores[j].Name
+	})
+	for i := range scores {
+		if i > 0 && scores[i].Points == scores[i-1].Points && scores[i].CorrectWinners == scores[i-1].CorrectWinners {
+			scores[i].Rank = scores[i-1].Rank
+		} else {
+			scores[i].Rank = i + 1
+		}
+	}
+	return scores
+}
+
+// IsTBD tells if a bracket slot has no team yet
+func IsTBD(team types.PlayoffTeam) bool {
+	return team.Tricode == "" || team.Tricode == "TBD"
+}
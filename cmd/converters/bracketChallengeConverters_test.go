@@ -0,0 +1,122 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// challengeBracket returns a bracket in which the first round is complete in the East and not started in the West:
+// BOS beat MIA in 5 (0), NYK beat CLE in 7 (1), MIL beat IND in 6 (2), PHI beat ORL in 4 (3).
+func challengeBracket() types.PlayoffBracket {
+	team := func(tricode string, seed, wins int) types.PlayoffTeam {
+		return types.PlayoffTeam{Tricode: tricode, Seed: seed, Wins: wins}
+	}
+	tbd := types.PlayoffTeam{Tricode: "TBD"}
+	series := make([]types.PlayoffSeries, 15)
+	for i := range series {
+		series[i] = types.PlayoffSeries{Round: SeriesRound(i), Status: "pre", TopTeam: tbd, BottomTeam: tbd}
+	}
+	series[0] = types.PlayoffSeries{Round: 1, Status: "complete", TopTeam: team("BOS", 1, 4), BottomTeam: team("MIA", 8, 1)}
+	series[1] = types.PlayoffSeries{Round: 1, Status: "complete", TopTeam: team("CLE", 4, 3), BottomTeam: team("NYK", 5, 4)}
+	series[2] = types.PlayoffSeries{Round: 1, Status: "complete", TopTeam: team("MIL", 3, 4), BottomTeam: team("IND", 6, 2)}
+	series[3] = types.PlayoffSeries{Round: 1, Status: "complete", TopTeam: team("PHI", 2, 4), BottomTeam: team("ORL", 7, 0)}
+	series[11] = types.PlayoffSeries{Round: 1, Status: "pre", TopTeam: team("OKC", 1, 0), BottomTeam: team("MEM", 8, 0)}
+	series[12] = types.PlayoffSeries{Round: 1, Status: "pre", TopTeam: team("DEN", 4, 0), BottomTeam: team("LAC", 5, 0)}
+	return types.PlayoffBracket{Season: "2024-25", Series: series}
+}
+
+func TestScorePrediction(t *testing.T) {
+	prediction := types.BracketPrediction{Name: "ann", Picks: []types.SeriesPick{
+		{Series: 0, Winner: "BOS", Games: 5},  // winner and games right: 10 + 5
+		{Series: 1, Winner: "NYK", Games: 6},  // winner right: 10
+		{Series: 2, Winner: "IND", Games: 7},  // wrong
+		{Series: 4, Winner: "BOS", Games: 6},  // undecided, BOS alive: max 20 + 10
+		{Series: 5, Winner: "ORL", Games: 6},  // ORL eliminated
+		{Series: 11, Winner: "OKC", Games: 4}, // undecided: max 10 + 5
+	}}
+
+	score := ScorePrediction(prediction, challengeBracket())
+
+	if score.Points != 25 {
+		t.Errorf("Points = %d, want 25", score.Points)
+	}
+	if score.CorrectWinners != 2 || score.CorrectGames != 1 {
+		t.Errorf("CorrectWinners/CorrectGames = %d/%d, want 2/1", score.CorrectWinners, score.CorrectGames)
+	}
+	if score.MaxPoints != 25+30+15 {
+		t.Errorf("MaxPoints = %d, want %d", score.MaxPoints, 25+30+15)
+	}
+	if score.Picks != 6 {
+		t.Errorf("Picks = %d, want 6", score.Picks)
+	}
+}
+
+func TestBuildLeaderboard(t *testing.T) {
+	predictions := []types.BracketPrediction{
+		{Name: "zoe", Picks: []types.SeriesPick{{Series: 0, Winner: "BOS", Games: 4}}},
+		{Name: "ann", Picks: []types.SeriesPick{{Series: 0, Winner: "BOS", Games: 5}}},
+		{Name: "bob", Picks: []types.SeriesPick{{Series: 0, Winner: "MIA", Games: 5}}},
+		{Name: "amy", Picks: []types.SeriesPick{{Series: 0, Winner: "BOS", Games: 6}}},
+	}
+
+	board := BuildLeaderboard(predictions, challengeBracket())
+
+	want := []struct {
+		name string
+		rank int
+	}{{"ann", 1}, {"amy", 2}, {"zoe", 2}, {"bob", 4}}
+	for i, w := range want {
+		if board[i].Name != w.name || board[i].Rank != w.rank {
+			t.Errorf("board[%d] = %s (#%d), want %s (#%d)", i, board[i].Name, board[i].Rank, w.name, w.rank)
+		}
+	}
+}
+
+func TestPredictedBracket_FillsLaterRounds(t *testing.T) {
+	prediction := types.BracketPrediction{Picks: []types.SeriesPick{
+		{Series: 11, Winner: "MEM", Games: 7},
+		{Series: 12, Winner: "DEN", Games: 5},
+		{Series: 9, Winner: "DEN", Games: 6},
+	}}
+
+	predicted := PredictedBracket(challengeBracket(), prediction)
+
+	if s := predicted.Series[11]; s.TopTeam.Wins != 3 || s.BottomTeam.Wins != 4 {
+		t.Errorf("West 1v8 predicted result = %d-%d, want 3-4", s.TopTeam.Wins, s.BottomTeam.Wins)
+	}
+	semi := predicted.Series[9]
+	if semi.TopTeam.Tricode != "MEM" || semi.BottomTeam.Tricode != "DEN" {
+		t.Errorf("West semi = %s vs %s, want MEM vs DEN", semi.TopTeam.Tricode, semi.BottomTeam.Tricode)
+	}
+	if semi.BottomTeam.Wins != 4 || semi.TopTeam.Wins != 2 {
+		t.Errorf("West semi predicted result = %d-%d, want 2-4", semi.TopTeam.Wins, semi.BottomTeam.Wins)
+	}
+	// the East semis are filled with the actual winners
+	if east := predicted.Series[4]; east.TopTeam.Tricode != "BOS" || east.BottomTeam.Tricode != "NYK" {
+		t.Errorf("East semi = %s vs %s, want BOS vs NYK", east.TopTeam.Tricode, east.BottomTeam.Tricode)
+	}
+}
+
+func TestPrunePicks_DropsPicksOfEliminatedPath(t *testing.T) {
+	prediction := types.BracketPrediction{Picks: []types.SeriesPick{
+		{Series: 11, Winner: "OKC", Games: 4},
+		{Series: 12, Winner: "DEN", Games: 5},
+		{Series: 9, Winner: "OKC", Games: 6},
+		{Series: 8, Winner: "OKC", Games: 6},
+	}}
+	// changing the first round pick makes the OKC picks of the semis invalid
+	prediction.SetPick(types.SeriesPick{Series: 11, Winner: "MEM", Games: 6})
+
+	pruned := PrunePicks(challengeBracket(), prediction)
+
+	if len(pruned.Picks) != 2 {
+		t.Fatalf("len(Picks) = %d, want 2: %+v", len(pruned.Picks), pruned.Picks)
+	}
+	if _, ok := pruned.Pick(9); ok {
+		t.Error("West semi pick should have been pruned")
+	}
+	if pick, ok := pruned.Pick(11); !ok || pick.Winner != "MEM" {
+		t.Errorf("West 1v8 pick = %+v, want MEM", pick)
+	}
+}
@@ -0,0 +1,83 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// PopulateDraftHistory extracts the picks of a draft from the drafthistory API response, in draft order
+func PopulateDraftHistory(rs types.ResponseSet) ([]types.DraftPick, []string, error) {
+	set, ok := findResultSet(rs, "DraftHistory")
+	if !ok {
+		if len(rs.ResultSets) == 0 {
+			return nil, nil, fmt.Errorf("no draft history data found")
+		}
+		set = rs.ResultSets[0]
+	}
+
+	var picks []types.DraftPick
+	for _, row := range set.RowSet {
+		if len(row) != len(set.Headers) {
+			return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+		}
+
+		data := make(map[string]interface{})
+		for i, value := range row {
+			data[set.Headers[i]] = value
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal draft pick: %v", err)
+		}
+
+		var pick types.DraftPick
+		if err = json.Unmarshal(jsonData, &pick); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal draft pick: %v", err)
+		}
+		picks = append(picks, pick)
+	}
+
+	sort.SliceStable(picks, func(i, j int) bool { return picks[i].OverallPick < picks[j].OverallPick })
+	return picks, DraftHistoryHeaders(), nil
+}
+
+// DraftHistoryHeaders returns the column headers of the draft picks table
+func DraftHistoryHeaders() []string {
+	return structJSONHeaders(types.DraftPick{})
+}
+
+// FilterDraftPicks returns the picks made by a team (abbreviation) of players coming from a college or club
+// whose name contains college, ignoring case. Empty filters match every pick.
+func FilterDraftPicks(picks []types.DraftPick, team, college string) []types.DraftPick {
+	college = strings.ToLower(strings.TrimSpace(college))
+	var filtered []types.DraftPick
+	for _, pick := range picks {
+		if team != "" && pick.TeamAbbreviation != team {
+			continue
+		}
+		if college != "" && !strings.Contains(strings.ToLower(pick.Organization), college) {
+			continue
+		}
+		filtered = append(filtered, pick)
+	}
+	return filtered
+}
+
+// DraftTeams returns the abbreviations of the teams which made a pick in the draft, sorted alphabetically
+func DraftTeams(picks []types.DraftPick) []string {
+	seen := make(map[string]bool)
+	var teams []string
+	for _, pick := range picks {
+		if pick.TeamAbbreviation != "" && !seen[pick.TeamAbbreviation] {
+			seen[pick.TeamAbbreviation] = true
+			teams = append(teams, pick.TeamAbbreviation)
+		}
+	}
+	sort.Strings(teams)
+	return teams
+}
@@ -0,0 +1,89 @@
+package converters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func draftHistoryResponse() types.ResponseSet {
+	return types.ResponseSet{ResultSets: []types.ResultSet{{
+		Name: "DraftHistory",
+		Headers: []string{"PERSON_ID", "PLAYER_NAME", "SEASON", "ROUND_NUMBER", "ROUND_PICK", "OVERALL_PICK",
+			"DRAFT_TYPE", "TEAM_ID", "TEAM_CITY", "TEAM_NAME", "TEAM_ABBREVIATION", "ORGANIZATION", "ORGANIZATION_TYPE"},
+		RowSet: [][]interface{}{
+			{2544.0, "LeBron James", "2003", 1.0, 1.0, 1.0, "Draft", 1610612739.0, "Cleveland", "Cavaliers", "CLE",
+				"St. Vincent-St. Mary HS (OH)", "High School"},
+			{2548.0, "Dwyane Wade", "2003", 1.0, 5.0, 5.0, "Draft", 1610612748.0, "Miami", "Heat", "MIA",
+				"Marquette", "College/University"},
+			{2546.0, "Carmelo Anthony", "2003", 1.0, 3.0, 3.0, "Draft", 1610612743.0, "Denver", "Nuggets", "DEN",
+				"Syracuse", "College/University"},
+			{2585.0, "Zaza Pachulia", "2003", 2.0, 13.0, 42.0, "Draft", 1610612753.0, "Orlando", "Magic", "ORL",
+				"Ulker (Turkey)", "Other Team/Club"},
+		},
+	}}}
+}
+
+func TestPopulateDraftHistory(t *testing.T) {
+	picks, headers, err := PopulateDraftHistory(draftHistoryResponse())
+	if err != nil {
+		t.Fatalf("PopulateDraftHistory() error: %v", err)
+	}
+	if len(headers) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if len(picks) != 4 {
+		t.Fatalf("expected 4 picks, got %d", len(picks))
+	}
+	if picks[1].PlayerName != "Carmelo Anthony" || picks[1].OverallPick != 3 {
+		t.Errorf("picks are not in draft order: %+v", picks)
+	}
+	if picks[0].PlayerID != 2544 || picks[0].TeamAbbreviation != "CLE" {
+		t.Errorf("first pick = %+v", picks[0])
+	}
+	if picks[3].RoundNumber != 2 || picks[3].RoundPick != 13 {
+		t.Errorf("second round pick = %+v", picks[3])
+	}
+
+	if _, _, err = PopulateDraftHistory(types.ResponseSet{}); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+}
+
+func TestFilterDraftPicks(t *testing.T) {
+	picks, _, _ := PopulateDraftHistory(draftHistoryResponse())
+
+	names := func(picks []types.DraftPick) []string {
+		var out []string
+		for _, p := range picks {
+			out = append(out, p.PlayerName)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name, team, college string
+		want                []string
+	}{
+		{"no filter", "", "", []string{"LeBron James", "Carmelo Anthony", "Dwyane Wade", "Zaza Pachulia"}},
+		{"team", "MIA", "", []string{"Dwyane Wade"}},
+		{"college ignores case", "", "syra", []string{"Carmelo Anthony"}},
+		{"team and college", "DEN", "marquette", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(FilterDraftPicks(picks, tt.team, tt.college)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterDraftPicks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDraftTeams(t *testing.T) {
+	picks, _, _ := PopulateDraftHistory(draftHistoryResponse())
+	want := []string{"CLE", "DEN", "MIA", "ORL"}
+	if got := DraftTeams(picks); !reflect.DeepEqual(got, want) {
+		t.Errorf("DraftTeams() = %v, want %v", got, want)
+	}
+}
@@ -95,3 +95,33 @@ func parseISO8601Duration(d string) string {
 	}
 	return minutes + ":" + seconds
 }
+
+// PopulateSeasonSchedule flattens the scheduleleaguev2 response into a list of games, ordered as returned by the API
+// (chronologically). Both finished and upcoming games are included.
+func PopulateSeasonSchedule(rs types.ResponseSet) ([]types.ScheduledGame, error) {
+	if rs.LeagueSchedule == nil || len(rs.LeagueSchedule.GameDates) == 0 {
+		return nil, fmt.Errorf("no games found in schedule response")
+	}
+
+	var games []types.ScheduledGame
+	for _, gameDate := range rs.LeagueSchedule.GameDates {
+		for _, game := range gameDate.Games {
+			date := game.GameDateEst
+			if len(date) >= 10 {
+				date = date[:10]
+			}
+			games = append(games, types.ScheduledGame{
+				GameID:     game.GameID,
+				GameDate:   date,
+				GameStatus: game.GameStatus,
+				HomeTeamID: game.HomeTeam.TeamID,
+				AwayTeamID: game.AwayTeam.TeamID,
+				HomeScore:  game.HomeTeam.Score,
+				AwayScore:  game.AwayTeam.Score,
+				HomeSeed:   game.HomeTeam.Seed,
+				AwaySeed:   game.AwayTeam.Seed,
+			})
+		}
+	}
+	return games, nil
+}
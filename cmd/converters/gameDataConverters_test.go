@@ -107,3 +107,38 @@ func TestPopulateBoxScore_Success(t *testing.T) {
 		t.Errorf("Unexpected away team data: %+v", boxScore.AwayTeam)
 	}
 }
+
+func TestPopulateSeasonSchedule(t *testing.T) {
+	rs := types.ResponseSet{LeagueSchedule: &types.LeagueSchedule{
+		GameDates: []types.ScheduleGameDate{
+			{GameDate: "10/22/2024 00:00:00", Games: []types.ScheduleGame{
+				{GameID: "0022400061", GameStatus: 3, GameDateEst: "2024-10-22T00:00:00Z",
+					HomeTeam: types.ScheduleTeam{TeamID: 1610612738, Score: 132},
+					AwayTeam: types.ScheduleTeam{TeamID: 1610612752, Score: 109}},
+			}},
+			{GameDate: "04/13/2025 00:00:00", Games: []types.ScheduleGame{
+				{GameID: "0022401200", GameStatus: 1, GameDateEst: "2025-04-13T00:00:00Z",
+					HomeTeam: types.ScheduleTeam{TeamID: 1610612752},
+					AwayTeam: types.ScheduleTeam{TeamID: 1610612738}},
+			}},
+		},
+	}}
+
+	games, err := PopulateSeasonSchedule(rs)
+	if err != nil {
+		t.Fatalf("PopulateSeasonSchedule() error: %v", err)
+	}
+	if len(games) != 2 {
+		t.Fatalf("expected 2 games, got %d", len(games))
+	}
+	if games[0].GameDate != "2024-10-22" || !games[0].IsFinal() || games[0].HomeScore != 132 {
+		t.Errorf("unexpected first game: %+v", games[0])
+	}
+	if games[1].IsFinal() || !games[1].IsRegularSeason() {
+		t.Errorf("expected second game to be an unplayed regular season game: %+v", games[1])
+	}
+
+	if _, err = PopulateSeasonSchedule(types.ResponseSet{}); err == nil {
+		t.Error("expected error for a response without a schedule")
+	}
+}
@@ -0,0 +1,255 @@
+package converters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// injuryReportColumns are the column headers of the official injury report, in the order the report prints them.
+// The JSON form of the report uses the same headers as keys.
+var injuryReportColumns = []string{"Game Date", "Game Time", "Matchup", "Team", "Player Name", "Current Status", "Reason"}
+
+var (
+	injuryReportTitle = regexp.MustCompile(`Injury Report:\s*(.+)`)
+	injuryReportPage  = regexp.MustCompile(`^Page\s+\d+\s+of\s+\d+$`)
+)
+
+// PopulateInjuryReport parses the official injury report, either the PDF the league publishes or its JSON form
+func PopulateInjuryReport(data []byte) (types.InjuryReport, []string, error) {
+	var report types.InjuryReport
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("%PDF")) {
+		report, err = parseInjuryReportPDF(data)
+	} else {
+		report, err = parseInjuryReportJSON(data)
+	}
+	if err != nil {
+		return types.InjuryReport{}, nil, err
+	}
+	return report, structJSONHeaders(types.InjuryReportEntry{}), nil
+}
+
+// parseInjuryReportJSON reads a report of rows keyed by the report's column headers
+func parseInjuryReportJSON(data []byte) (types.InjuryReport, error) {
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return types.InjuryReport{}, fmt.Errorf("failed to unmarshal injury report: %w", err)
+	}
+
+	var report types.InjuryReport
+	for _, row := range rows {
+		cells := make(map[string]string, len(injuryReportColumns))
+		for _, col := range injuryReportColumns {
+			cells[col] = cellString(row[col])
+		}
+		if e, ok := injuryEntry(cells); ok {
+			report.Entries = append(report.Entries, e)
+		}
+	}
+	return report, nil
+}
+
+// parseInjuryReportPDF rebuilds the table of the injury report from the positions of its text. The column of
+// a text is the rightmost header starting left of it. The report only prints the date, time, matchup and team
+// on the first row of a group, and wraps long reasons onto a row of their own.
+func parseInjuryReportPDF(data []byte) (types.InjuryReport, error) {
+	runs := extractPDFText(data)
+	if len(runs) == 0 {
+		return types.InjuryReport{}, fmt.Errorf("no text found in the injury report")
+	}
+
+	var report types.InjuryReport
+	var columnX []float64
+	carried := make(map[string]string)
+	headerFound := false
+
+	pages := make(map[int][]pdfTextRun)
+	var pageOrder []int
+	for _, r := range runs {
+		if _, ok := pages[r.page]; !ok {
+			pageOrder = append(pageOrder, r.page)
+		}
+		pages[r.page] = append(pages[r.page], r)
+	}
+
+	for _, page := range pageOrder {
+		rows := groupPDFRows(pages[page])
+
+		headerY := math.Inf(1)
+		for _, row := range rows {
+			if m := injuryReportTitle.FindStringSubmatch(joinRunText(row)); m != nil && report.Published == "" {
+				report.Published = strings.TrimSpace(m[1])
+			}
+			if x, ok := injuryHeaderColumns(row); ok {
+				columnX = x
+				headerY = row[0].y
+				headerFound = true
+				break
+			}
+		}
+		if columnX == nil {
+			continue
+		}
+
+		for _, row := range rows {
+			if row[0].y >= headerY {
+				continue
+			}
+			cells := make(map[string]string)
+			for _, r := range row {
+				text := strings.TrimSpace(r.text)
+				if text == "" || injuryReportPage.MatchString(text) {
+					continue
+				}
+				col := injuryReportColumns[pdfColumn(columnX, r.x)]
+				cells[col] = strings.TrimSpace(cells[col] + " " + text)
+			}
+			if len(cells) == 0 {
+				continue
+			}
+
+			// a row holding nothing but a reason continues the reason of the previous player
+			if len(cells) == 1 && cells["Reason"] != "" && len(report.Entries) > 0 {
+				last := &report.Entries[len(report.Entries)-1]
+				last.Reason = strings.TrimSpace(last.Reason + " " + cells["Reason"])
+				continue
+			}
+
+			for _, col := range injuryReportColumns[:4] {
+				if cells[col] != "" {
+					carried[col] = cells[col]
+				} else {
+					cells[col] = carried[col]
+				}
+			}
+			if e, ok := injuryEntry(cells); ok {
+				report.Entries = append(report.Entries, e)
+			}
+		}
+	}
+
+	if !headerFound {
+		return types.InjuryReport{}, fmt.Errorf("injury report table not found")
+	}
+	return report, nil
+}
+
+// groupPDFRows groups the runs of a page into rows of the same baseline, top to bottom and left to right
+func groupPDFRows(runs []pdfTextRun) [][]pdfTextRun {
+	sorted := append([]pdfTextRun(nil), runs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if math.Abs(sorted[i].y-sorted[j].y) > 1.5 {
+			return sorted[i].y > sorted[j].y
+		}
+		return sorted[i].x < sorted[j].x
+	})
+
+	var rows [][]pdfTextRun
+	for _, r := range sorted {
+		if n := len(rows); n > 0 && math.Abs(rows[n-1][0].y-r.y) <= 1.5 {
+			rows[n-1] = append(rows[n-1], r)
+			continue
+		}
+		rows = append(rows, []pdfTextRun{r})
+	}
+	return rows
+}
+
+// injuryHeaderColumns returns the x position of every column when the row is the header row of the report
+func injuryHeaderColumns(row []pdfTextRun) ([]float64, bool) {
+	x := make([]float64, len(injuryReportColumns))
+	found := 0
+	for i, col := range injuryReportColumns {
+		for _, r := range row {
+			if strings.TrimSpace(r.text) == col {
+				x[i] = r.x
+				found++
+				break
+			}
+		}
+	}
+	return x, found == len(injuryReportColumns)
+}
+
+// pdfColumn returns the index of the rightmost column starting left of x, allowing for a little slack
+func pdfColumn(columnX []float64, x float64) int {
+	col := 0
+	for i, cx := range columnX {
+		if x+3 >= cx {
+			col = i
+		}
+	}
+	return col
+}
+
+func joinRunText(row []pdfTextRun) string {
+	parts := make([]string, len(row))
+	for i, r := range row {
+		parts[i] = r.text
+	}
+	return strings.Join(parts, " ")
+}
+
+// injuryEntry turns the cells of a report row into an entry. Rows without a player, like the teams that have
+// not submitted their report yet, are skipped.
+func injuryEntry(cells map[string]string) (types.InjuryReportEntry, bool) {
+	player := strings.TrimSpace(cells["Player Name"])
+	status := strings.TrimSpace(cells["Current Status"])
+	if player == "" || status == "" {
+		return types.InjuryReportEntry{}, false
+	}
+	return types.InjuryReportEntry{
+		GameDate: cells["Game Date"],
+		GameTime: cells["Game Time"],
+		Matchup:  cells["Matchup"],
+		Team:     cells["Team"],
+		Player:   reportPlayerName(player),
+		Status:   status,
+		Reason:   cells["Reason"],
+	}, true
+}
+
+// reportPlayerName turns the "Last, First" names of the report into "First Last"
+func reportPlayerName(name string) string {
+	last, first, ok := strings.Cut(name, ",")
+	if !ok {
+		return name
+	}
+	return strings.TrimSpace(first) + " " + strings.TrimSpace(last)
+}
+
+// FilterInjuries returns the entries of a team with a status, an empty team or status matches all
+func FilterInjuries(entries []types.InjuryReportEntry, team, status string) []types.InjuryReportEntry {
+	var filtered []types.InjuryReportEntry
+	for _, e := range entries {
+		if team != "" && e.Team != team {
+			continue
+		}
+		if status != "" && !strings.EqualFold(e.Status, status) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered
+}
+
+// InjuryTeams returns the teams on the injury report in alphabetical order
+func InjuryTeams(entries []types.InjuryReportEntry) []string {
+	seen := make(map[string]bool)
+	var teams []string
+	for _, e := range entries {
+		if e.Team != "" && !seen[e.Team] {
+			seen[e.Team] = true
+			teams = append(teams, e.Team)
+		}
+	}
+	sort.Strings(teams)
+	return teams
+}
@@ -0,0 +1,136 @@
+package converters
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// injuryReportPDF builds a minimal PDF with a compressed content stream drawing the given lines of text.
+// Each line is a y position and the x positions and texts of its cells.
+func injuryReportPDF(t *testing.T, lines []pdfTestLine) []byte {
+	t.Helper()
+
+	var content strings.Builder
+	for _, line := range lines {
+		for _, cell := range line.cells {
+			fmt.Fprintf(&content, "BT /F1 8 Tf 1 0 0 1 %g %g Tm (%s) Tj ET\n", cell.x, line.y,
+				strings.NewReplacer("(", `\(`, ")", `\)`).Replace(cell.text))
+		}
+	}
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	if _, err := w.Write([]byte(content.String())); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n")
+	fmt.Fprintf(&pdf, "4 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", compressed.Len())
+	pdf.Write(compressed.Bytes())
+	pdf.WriteString("\nendstream\nendobj\n%%EOF\n")
+	return pdf.Bytes()
+}
+
+type pdfTestCell struct {
+	x    float64
+	text string
+}
+
+type pdfTestLine struct {
+	y     float64
+	cells []pdfTestCell
+}
+
+func TestPopulateInjuryReport_PDF(t *testing.T) {
+	header := []pdfTestCell{{20, "Game Date"}, {80, "Game Time"}, {130, "Matchup"}, {180, "Team"},
+		{300, "Player Name"}, {420, "Current Status"}, {500, "Reason"}}
+	data := injuryReportPDF(t, []pdfTestLine{
+		{y: 580, cells: []pdfTestCell{{20, "Injury Report: 01/15/25 05:00 PM"}}},
+		{y: 560, cells: header},
+		{y: 540, cells: []pdfTestCell{{20, "01/15/2025"}, {80, "07:00 (ET)"}, {130, "BOS@MIA"}, {180, "Boston Celtics"},
+			{300, "Tatum, Jayson"}, {420, "Questionable"}, {500, "Injury/Illness - Right Knee;"}}},
+		{y: 530, cells: []pdfTestCell{{500, "Soreness"}}},
+		{y: 520, cells: []pdfTestCell{{300, "Holiday, Jrue"}, {420, "Out"}, {500, "Injury/Illness - Left Ankle; Sprain"}}},
+		{y: 510, cells: []pdfTestCell{{180, "Miami Heat"}, {300, "Jovic, Nikola"}, {420, "Out"}, {500, "G League - Two-Way"}}},
+		{y: 500, cells: []pdfTestCell{{130, "LAL@DEN"}, {180, "Los Angeles Lakers"}, {500, "NOT YET SUBMITTED"}}},
+		{y: 20, cells: []pdfTestCell{{280, "Page 1 of 1"}}},
+	})
+
+	report, headers, err := PopulateInjuryReport(data)
+	if err != nil {
+		t.Fatalf("PopulateInjuryReport() error: %v", err)
+	}
+	if len(headers) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if report.Published != "01/15/25 05:00 PM" {
+		t.Errorf("Published = %q", report.Published)
+	}
+	if len(report.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d: %+v", len(report.Entries), report.Entries)
+	}
+
+	tatum := report.Entries[0]
+	if tatum.Player != "Jayson Tatum" || tatum.Status != types.InjuryStatusQuestionable ||
+		tatum.Reason != "Injury/Illness - Right Knee; Soreness" || tatum.GameTime != "07:00 (ET)" {
+		t.Errorf("first entry = %+v", tatum)
+	}
+	if holiday := report.Entries[1]; holiday.Team != "Boston Celtics" || holiday.Matchup != "BOS@MIA" {
+		t.Errorf("the team and matchup were not carried to the next row: %+v", holiday)
+	}
+	if jovic := report.Entries[2]; jovic.Team != "Miami Heat" || jovic.GameDate != "01/15/2025" {
+		t.Errorf("third entry = %+v", jovic)
+	}
+}
+
+func TestPopulateInjuryReport_JSON(t *testing.T) {
+	data := []byte(`[
+		{"Game Date": "01/15/2025", "Game Time": "07:00 (ET)", "Matchup": "BOS@MIA", "Team": "Boston Celtics",
+		 "Player Name": "Jackson Jr., Jaren", "Current Status": "Probable", "Reason": "Rest"},
+		{"Game Date": "01/15/2025", "Game Time": "07:00 (ET)", "Matchup": "BOS@MIA", "Team": "Miami Heat",
+		 "Player Name": null, "Current Status": null, "Reason": "NOT YET SUBMITTED"}
+	]`)
+
+	report, _, err := PopulateInjuryReport(data)
+	if err != nil {
+		t.Fatalf("PopulateInjuryReport() error: %v", err)
+	}
+	if len(report.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(report.Entries))
+	}
+	if e, ok := report.Lookup("Jaren", "Jackson Jr."); !ok || e.Status != types.InjuryStatusProbable {
+		t.Errorf("Lookup() = %+v, %v", e, ok)
+	}
+	if got := len(report.ForMatchup("BOS", "MIA")); got != 1 {
+		t.Errorf("ForMatchup() returned %d entries, want 1", got)
+	}
+
+	if _, _, err = PopulateInjuryReport([]byte("not a report")); err == nil {
+		t.Error("expected an error for an unreadable report")
+	}
+}
+
+func TestFilterInjuries(t *testing.T) {
+	entries := []types.InjuryReportEntry{
+		{Team: "Miami Heat", Player: "A", Status: "Out"},
+		{Team: "Boston Celtics", Player: "B", Status: "Questionable"},
+		{Team: "Boston Celtics", Player: "C", Status: "Out"},
+	}
+
+	if got := FilterInjuries(entries, "Boston Celtics", "out"); len(got) != 1 || got[0].Player != "C" {
+		t.Errorf("FilterInjuries(Boston Celtics, out) = %+v", got)
+	}
+	if got := FilterInjuries(entries, "", ""); len(got) != 3 {
+		t.Errorf("FilterInjuries() without filters returned %d entries", len(got))
+	}
+	if teams := InjuryTeams(entries); len(teams) != 2 || teams[0] != "Boston Celtics" {
+		t.Errorf("InjuryTeams() = %v", teams)
+	}
+}
@@ -0,0 +1,189 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// allTimeLeaderSets are the result sets of the alltimeleadersgrids endpoint in display order.
+// Each set has the stat itself, its rank and IS_ACTIVE_FLAG next to the player.
+var allTimeLeaderSets = []struct {
+	set, stat, label string
+	percentage       bool
+}{
+	{"PTSLeaders", "PTS", "Points", false},
+	{"REBLeaders", "REB", "Rebounds", false},
+	{"ASTLeaders", "AST", "Assists", false},
+	{"STLLeaders", "STL", "Steals", false},
+	{"BLKLeaders", "BLK", "Blocks", false},
+	{"GPLeaders", "GP", "Games Played", false},
+	{"FGMLeaders", "FGM", "Field Goals", false},
+	{"FG3MLeaders", "FG3M", "3-Pointers", false},
+	{"FTMLeaders", "FTM", "Free Throws", false},
+	{"OREBLeaders", "OREB", "Off. Rebounds", false},
+	{"DREBLeaders", "DREB", "Def. Rebounds", false},
+	{"TOVLeaders", "TOV", "Turnovers", false},
+	{"PFLeaders", "PF", "Fouls", false},
+	{"FG_PCTLeaders", "FG_PCT", "FG%", true},
+	{"FG3_PCTLeaders", "FG3_PCT", "3P%", true},
+	{"FT_PCTLeaders", "FT_PCT", "FT%", true},
+}
+
+// PopulateAllTimeLeaders extracts the leaderboard of every stat category from the alltimeleadersgrids response.
+// Categories missing from the response are skipped.
+func PopulateAllTimeLeaders(rs types.ResponseSet) ([]types.AllTimeLeaderCategory, []string, error) {
+	var categories []types.AllTimeLeaderCategory
+	for _, spec := range allTimeLeaderSets {
+		set, ok := findResultSet(rs, spec.set)
+		if !ok || len(set.RowSet) == 0 {
+			continue
+		}
+
+		idx := make(map[string]int, len(set.Headers))
+		for i, h := range set.Headers {
+			idx[h] = i
+		}
+		for _, required := range []string{"PLAYER_ID", "PLAYER_NAME", spec.stat} {
+			if _, ok := idx[required]; !ok {
+				return nil, nil, fmt.Errorf("%s: missing column %s", spec.set, required)
+			}
+		}
+
+		category := types.AllTimeLeaderCategory{Stat: spec.stat, Label: spec.label}
+		for i, row := range set.RowSet {
+			if len(row) != len(set.Headers) {
+				return nil, nil, fmt.Errorf("%s: row length mismatch: %d vs %d", spec.set, len(row), len(set.Headers))
+			}
+			leader := types.AllTimeLeader{
+				PlayerID:   int(rowInt64(row[idx["PLAYER_ID"]])),
+				Rank:       i + 1,
+				PlayerName: cellString(row[idx["PLAYER_NAME"]]),
+				Value:      formatLeaderValue(rowFloat(row[idx[spec.stat]]), spec.percentage),
+			}
+			if r, ok := idx[spec.stat+"_RANK"]; ok {
+				leader.Rank = int(rowInt64(row[r]))
+			}
+			if a, ok := idx["IS_ACTIVE_FLAG"]; ok && cellString(row[a]) == "Y" {
+				leader.Active = "✓"
+			}
+			category.Leaders = append(category.Leaders, leader)
+		}
+		categories = append(categories, category)
+	}
+
+	if len(categories) == 0 {
+		return nil, nil, fmt.Errorf("no all-time leaders found")
+	}
+	return categories, structJSONHeaders(types.AllTimeLeader{}), nil
+}
+
+// formatLeaderValue formats totals without decimals, averages with one and percentages as percent
+func formatLeaderValue(v float64, percentage bool) string {
+	switch {
+	case percentage:
+		return fmt.Sprintf("%.1f%%", v*100)
+	case v == math.Trunc(v):
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+func rowFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int64:
+		return float64(n)
+	case int:
+		return float64(n)
+	}
+	return 0
+}
+
+// franchiseLeaderStats are the categories of the franchiseleaders endpoint, each has the total,
+// the id and the name of the record holder: PTS, PTS_PERSON_ID and PTS_PLAYER
+var franchiseLeaderStats = []struct{ stat, label string }{
+	{"PTS", "Points"},
+	{"REB", "Rebounds"},
+	{"AST", "Assists"},
+	{"STL", "Steals"},
+	{"BLK", "Blocks"},
+}
+
+// PopulateFranchiseLeaders extracts the franchise record holder of every category from the franchiseleaders response
+func PopulateFranchiseLeaders(rs types.ResponseSet) ([]types.FranchiseLeader, []string, error) {
+	if len(rs.ResultSets) == 0 || len(rs.ResultSets[0].RowSet) == 0 {
+		return nil, nil, fmt.Errorf("no franchise leaders found")
+	}
+	set := rs.ResultSets[0]
+	row := set.RowSet[0]
+	if len(row) != len(set.Headers) {
+		return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+	}
+
+	idx := make(map[string]int, len(set.Headers))
+	for i, h := range set.Headers {
+		idx[h] = i
+	}
+
+	var leaders []types.FranchiseLeader
+	for _, s := range franchiseLeaderStats {
+		value, okValue := idx[s.stat]
+		player, okPlayer := idx[s.stat+"_PLAYER"]
+		if !okValue || !okPlayer || row[player] == nil {
+			continue
+		}
+		leader := types.FranchiseLeader{
+			Category: s.label,
+			Player:   cellString(row[player]),
+			Value:    int(rowInt64(row[value])),
+		}
+		if id, ok := idx[s.stat+"_PERSON_ID"]; ok {
+			leader.PlayerID = int(rowInt64(row[id]))
+		}
+		leaders = append(leaders, leader)
+	}
+	return leaders, structJSONHeaders(types.FranchiseLeader{}), nil
+}
+
+// PopulateFranchisePlayers extracts everyone who played for a franchise from the franchiseplayers response,
+// ordered by the points they scored for the franchise
+func PopulateFranchisePlayers(rs types.ResponseSet) ([]types.FranchisePlayer, []string, error) {
+	set, ok := findResultSet(rs, "FranchisePlayers")
+	if !ok {
+		if len(rs.ResultSets) == 0 {
+			return nil, nil, fmt.Errorf("no franchise players found")
+		}
+		set = rs.ResultSets[0]
+	}
+
+	var players []types.FranchisePlayer
+	for _, row := range set.RowSet {
+		if len(row) != len(set.Headers) {
+			return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+		}
+
+		data := make(map[string]interface{})
+		for i, value := range row {
+			data[set.Headers[i]] = value
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal franchise player: %v", err)
+		}
+
+		var player types.FranchisePlayer
+		if err = json.Unmarshal(jsonData, &player); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal franchise player: %v", err)
+		}
+		players = append(players, player)
+	}
+
+	sort.SliceStable(players, func(i, j int) bool { return players[i].PTS > players[j].PTS })
+	return players, structJSONHeaders(types.FranchisePlayer{}), nil
+}
@@ -0,0 +1,115 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestPopulateAllTimeLeaders(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{
+		{Name: "ASTLeaders", Headers: []string{"PLAYER_ID", "PLAYER_NAME", "AST", "AST_RANK", "IS_ACTIVE_FLAG"},
+			RowSet: [][]interface{}{
+				{1717.0, "John Stockton", 15806.0, 1.0, "N"},
+				{467.0, "Chris Paul", 12345.0, 2.0, "Y"},
+			}},
+		{Name: "PTSLeaders", Headers: []string{"PLAYER_ID", "PLAYER_NAME", "PTS", "PTS_RANK", "IS_ACTIVE_FLAG"},
+			RowSet: [][]interface{}{
+				{2544.0, "LeBron James", 41000.0, 1.0, "Y"},
+			}},
+		{Name: "FT_PCTLeaders", Headers: []string{"PLAYER_ID", "PLAYER_NAME", "FT_PCT", "FT_PCT_RANK", "IS_ACTIVE_FLAG"},
+			RowSet: [][]interface{}{
+				{201939.0, "Stephen Curry", 0.9105, 1.0, "Y"},
+			}},
+		{Name: "BLKLeaders", Headers: []string{"PLAYER_ID", "PLAYER_NAME", "BLK", "BLK_RANK", "IS_ACTIVE_FLAG"}},
+	}}
+
+	categories, headers, err := PopulateAllTimeLeaders(rs)
+	if err != nil {
+		t.Fatalf("PopulateAllTimeLeaders() error: %v", err)
+	}
+	if len(headers) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if len(categories) != 3 {
+		t.Fatalf("expected 3 categories (empty blocks skipped), got %d", len(categories))
+	}
+	if categories[0].Stat != "PTS" || categories[1].Stat != "AST" || categories[2].Stat != "FT_PCT" {
+		t.Errorf("categories are not in display order: %s, %s, %s",
+			categories[0].Stat, categories[1].Stat, categories[2].Stat)
+	}
+
+	assists := categories[1].Leaders
+	if len(assists) != 2 || assists[0].PlayerName != "John Stockton" || assists[0].Value != "15806" {
+		t.Errorf("assist leaders = %+v", assists)
+	}
+	if assists[0].Active != "" || assists[1].Active == "" || assists[1].Rank != 2 {
+		t.Errorf("active flags or ranks are wrong: %+v", assists)
+	}
+	if got := categories[2].Leaders[0].Value; got != "91.0%" {
+		t.Errorf("FT%% value = %s, want 91.0%%", got)
+	}
+
+	if _, _, err = PopulateAllTimeLeaders(types.ResponseSet{}); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+}
+
+func TestPopulateFranchiseLeaders(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{
+		Name: "FranchiseLeaders",
+		Headers: []string{"TEAM_ID", "PTS", "PTS_PERSON_ID", "PTS_PLAYER", "AST", "AST_PERSON_ID", "AST_PLAYER",
+			"REB", "REB_PERSON_ID", "REB_PLAYER", "BLK", "BLK_PERSON_ID", "BLK_PLAYER", "STL", "STL_PERSON_ID", "STL_PLAYER"},
+		RowSet: [][]interface{}{{1610612748.0, 21556.0, 2548.0, "Dwyane Wade", 5310.0, 2548.0, "Dwyane Wade",
+			7350.0, 2617.0, "Udonis Haslem", 1629.0, 297.0, "Alonzo Mourning", 1492.0, 2548.0, "Dwyane Wade"}},
+	}}}
+
+	leaders, headers, err := PopulateFranchiseLeaders(rs)
+	if err != nil {
+		t.Fatalf("PopulateFranchiseLeaders() error: %v", err)
+	}
+	if len(headers) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if len(leaders) != 5 {
+		t.Fatalf("expected 5 categories, got %d", len(leaders))
+	}
+	if leaders[0] != (types.FranchiseLeader{PlayerID: 2548, Category: "Points", Player: "Dwyane Wade", Value: 21556}) {
+		t.Errorf("points leader = %+v", leaders[0])
+	}
+	if leaders[1].Category != "Rebounds" || leaders[1].Player != "Udonis Haslem" {
+		t.Errorf("rebounds leader = %+v", leaders[1])
+	}
+}
+
+func TestPopulateFranchisePlayers(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{
+		Name:    "FranchisePlayers",
+		Headers: []string{"PERSON_ID", "PLAYER", "ACTIVE_WITH_TEAM", "GP", "PTS", "REB", "AST", "FG_PCT"},
+		RowSet: [][]interface{}{
+			{297.0, "Alonzo Mourning", 0.0, 593.0, 9459.0, 4807.0, 655.0, 0.53},
+			{1628389.0, "Bam Adebayo", 1.0, 600.0, 9800.0, 5400.0, 2000.0, 0.55},
+			{2548.0, "Dwyane Wade", 0.0, 948.0, 21556.0, 4482.0, 5310.0, 0.48},
+		},
+	}}}
+
+	players, _, err := PopulateFranchisePlayers(rs)
+	if err != nil {
+		t.Fatalf("PopulateFranchisePlayers() error: %v", err)
+	}
+	if len(players) != 3 || players[0].Player != "Dwyane Wade" || players[2].Player != "Alonzo Mourning" {
+		t.Errorf("players are not ordered by points: %+v", players)
+	}
+	if players[1].Active != 1 || players[1].GP != 600 {
+		t.Errorf("second player = %+v", players[1])
+	}
+}
+
+func TestFranchiseHistory_Seasons(t *testing.T) {
+	if got := (types.FranchiseHistory{FirstSeason: "1988", LastSeason: "2025"}).Seasons(); got != 38 {
+		t.Errorf("Seasons() = %d, want 38", got)
+	}
+	if got := (types.FranchiseHistory{}).Seasons(); got != 0 {
+		t.Errorf("Seasons() of an unknown history = %d, want 0", got)
+	}
+}
@@ -0,0 +1,366 @@
+package converters
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// pdfTextRun is a piece of text drawn on a PDF page, at the position its text matrix put it
+type pdfTextRun struct {
+	page int
+	x, y float64
+	text string
+}
+
+// extractPDFText returns the text runs of every content stream of a PDF document. It understands just enough
+// of the format for machine generated reports: uncompressed or FlateDecode streams, literal and hex strings in
+// a single byte encoding, and the text positioning operators. Every content stream is counted as a page.
+func extractPDFText(data []byte) []pdfTextRun {
+	var runs []pdfTextRun
+	page := 0
+
+	for pos := 0; ; {
+		start := bytes.Index(data[pos:], []byte("stream"))
+		if start < 0 {
+			break
+		}
+		start += pos
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			break
+		}
+		end += start
+		pos = end + len("endstream")
+
+		// "endstream" contains "stream" too, skip the keyword when it is the end of the previous stream
+		if start >= 3 && string(data[start-3:start]) == "end" {
+			pos = start + len("stream")
+			continue
+		}
+
+		dict := pdfStreamDict(data[:start])
+		if strings.Contains(dict, "/Image") || strings.Contains(dict, "/XRef") || strings.Contains(dict, "/ObjStm") {
+			continue
+		}
+
+		body := data[start+len("stream") : end]
+		body = bytes.TrimLeft(body, "\r\n")
+		if strings.Contains(dict, "/FlateDecode") {
+			r, err := zlib.NewReader(bytes.NewReader(body))
+			if err != nil {
+				continue
+			}
+			// a truncated stream still yields the text inflated so far
+			inflated, _ := io.ReadAll(r)
+			body = inflated
+		}
+		if !bytes.Contains(body, []byte("BT")) {
+			continue
+		}
+
+		pageRuns := pdfContentText(body, page)
+		if len(pageRuns) > 0 {
+			runs = append(runs, pageRuns...)
+			page++
+		}
+	}
+	return runs
+}
+
+// pdfStreamDict returns the dictionary of the object a stream belongs to, the text between "obj" and the stream
+func pdfStreamDict(before []byte) string {
+	objStart := bytes.LastIndex(before, []byte("obj"))
+	if objStart < 0 {
+		return ""
+	}
+	return string(before[objStart:])
+}
+
+// pdfContentText interprets the text operators of a content stream
+func pdfContentText(content []byte, page int) []pdfTextRun {
+	var runs []pdfTextRun
+	var operands []interface{}
+
+	// a, b, c, d, e, f of the text matrix and of the text line matrix
+	tm := [6]float64{1, 0, 0, 1, 0, 0}
+	tlm := tm
+	leading := 0.0
+	moved := true
+
+	number := func(i int) float64 {
+		if i < 0 || i >= len(operands) {
+			return 0
+		}
+		if f, ok := operands[i].(float64); ok {
+			return f
+		}
+		return 0
+	}
+	nextLine := func(tx, ty float64) {
+		tlm[4] += tx*tlm[0] + ty*tlm[2]
+		tlm[5] += tx*tlm[1] + ty*tlm[3]
+		tm = tlm
+		moved = true
+	}
+	show := func(text string) {
+		if text == "" {
+			return
+		}
+		// text drawn without repositioning continues the previous run
+		if !moved && len(runs) > 0 {
+			runs[len(runs)-1].text += text
+			return
+		}
+		runs = append(runs, pdfTextRun{page: page, x: tm[4], y: tm[5], text: text})
+		moved = false
+	}
+
+	lex := pdfLexer{data: content}
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			break
+		}
+		op, isOp := tok.(pdfOperator)
+		if !isOp {
+			operands = append(operands, tok)
+			continue
+		}
+
+		n := len(operands)
+		switch op {
+		case "BT":
+			tm = [6]float64{1, 0, 0, 1, 0, 0}
+			tlm = tm
+			moved = true
+		case "Tm":
+			if n >= 6 {
+				for i := 0; i < 6; i++ {
+					tm[i] = number(n - 6 + i)
+				}
+				tlm = tm
+				moved = true
+			}
+		case "Td":
+			nextLine(number(n-2), number(n-1))
+		case "TD":
+			leading = -number(n - 1)
+			nextLine(number(n-2), number(n-1))
+		case "TL":
+			leading = number(n - 1)
+		case "T*":
+			nextLine(0, -leading)
+		case "Tj":
+			if n > 0 {
+				s, _ := operands[n-1].(string)
+				show(s)
+			}
+		case "'", "\"":
+			nextLine(0, -leading)
+			if n > 0 {
+				s, _ := operands[n-1].(string)
+				show(s)
+			}
+		case "TJ":
+			if n > 0 {
+				if arr, ok := operands[n-1].([]interface{}); ok {
+					var b strings.Builder
+					for _, el := range arr {
+						switch v := el.(type) {
+						case string:
+							b.WriteString(v)
+						case float64:
+							// a large negative kerning is a word gap
+							if v < -200 {
+								b.WriteString(" ")
+							}
+						}
+					}
+					show(b.String())
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+	return runs
+}
+
+// pdfOperator is a content stream operator, operands are float64, string, pdf names and []interface{}
+type pdfOperator string
+
+type pdfName string
+
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func (l *pdfLexer) next() (interface{}, bool) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, false
+	}
+
+	c := l.data[l.pos]
+	switch {
+	case c == '(':
+		return l.literalString(), true
+	case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+		l.pos += 2
+		return pdfName("<<"), true
+	case c == '>' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '>':
+		l.pos += 2
+		return pdfName(">>"), true
+	case c == '<':
+		return l.hexString(), true
+	case c == '[':
+		l.pos++
+		var arr []interface{}
+		for {
+			l.skipSpace()
+			if l.pos >= len(l.data) {
+				return arr, true
+			}
+			if l.data[l.pos] == ']' {
+				l.pos++
+				return arr, true
+			}
+			tok, ok := l.next()
+			if !ok {
+				return arr, true
+			}
+			arr = append(arr, tok)
+		}
+	case c == ']' || c == '{' || c == '}' || c == ')' || c == '>':
+		l.pos++
+		return l.next()
+	case c == '/':
+		start := l.pos
+		l.pos++
+		for l.pos < len(l.data) && !pdfDelimiter(l.data[l.pos]) {
+			l.pos++
+		}
+		return pdfName(l.data[start:l.pos]), true
+	}
+
+	start := l.pos
+	for l.pos < len(l.data) && !pdfDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	if l.pos == start {
+		l.pos++
+	}
+	word := string(l.data[start:l.pos])
+	if f, err := strconv.ParseFloat(word, 64); err == nil {
+		return f, true
+	}
+	return pdfOperator(word), true
+}
+
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if c != ' ' && c != '\n' && c != '\r' && c != '\t' && c != '\f' && c != 0 {
+			return
+		}
+		l.pos++
+	}
+}
+
+func (l *pdfLexer) literalString() string {
+	var b []byte
+	depth := 0
+	l.pos++ // (
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+			b = append(b, c)
+		case ')':
+			if depth == 0 {
+				return latin1(b)
+			}
+			depth--
+			b = append(b, c)
+		case '\\':
+			if l.pos >= len(l.data) {
+				return latin1(b)
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				b = append(b, '\n')
+			case 'r':
+				b = append(b, '\r')
+			case 't':
+				b = append(b, '\t')
+			case 'b':
+				b = append(b, '\b')
+			case 'f':
+				b = append(b, '\f')
+			case '\r', '\n':
+				// line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					b = append(b, byte(v))
+				} else {
+					b = append(b, e)
+				}
+			}
+		default:
+			b = append(b, c)
+		}
+	}
+	return latin1(b)
+}
+
+func (l *pdfLexer) hexString() string {
+	l.pos++ // <
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; strings.IndexByte("0123456789abcdefABCDEF", c) >= 0 {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++ // >
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	b := make([]byte, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		v, _ := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		b = append(b, byte(v))
+	}
+	return latin1(b)
+}
+
+func pdfDelimiter(c byte) bool {
+	return strings.IndexByte(" \t\r\n\f\x00()<>[]{}/%", c) >= 0
+}
+
+// latin1 decodes the bytes of a single byte encoded PDF string
+func latin1(b []byte) string {
+	r := make([]rune, len(b))
+	for i, c := range b {
+		r[i] = rune(c)
+	}
+	return string(r)
+}
@@ -0,0 +1,132 @@
+package converters
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// PopulatePlayerAwards extracts the awards of a player from the playerawards API response, most recent first.
+// A player without awards yields an empty list, not an error.
+func PopulatePlayerAwards(rs types.ResponseSet) ([]types.PlayerAward, []string, error) {
+	set, ok := findResultSet(rs, "PlayerAwards")
+	if !ok {
+		if len(rs.ResultSets) == 0 {
+			return nil, nil, fmt.Errorf("no player awards data found")
+		}
+		set = rs.ResultSets[0]
+	}
+
+	idx := make(map[string]int, len(set.Headers))
+	for i, h := range set.Headers {
+		idx[h] = i
+	}
+	field := func(row []interface{}, name string) string {
+		i, ok := idx[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return cellString(row[i])
+	}
+
+	var awards []types.PlayerAward
+	for _, row := range set.RowSet {
+		if len(row) != len(set.Headers) {
+			return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+		}
+		award := types.PlayerAward{
+			Season:      field(row, "SEASON"),
+			Description: field(row, "DESCRIPTION"),
+			Team:        field(row, "TEAM"),
+			TeamNumber:  field(row, "ALL_NBA_TEAM_NUMBER"),
+			Month:       field(row, "MONTH"),
+			Week:        field(row, "WEEK"),
+			Conference:  field(row, "CONFERENCE"),
+		}
+		award.Detail = awardDetail(award)
+		awards = append(awards, award)
+	}
+
+	// the API groups the awards by award, list them by season instead
+	sort.SliceStable(awards, func(i, j int) bool { return awards[i].Season > awards[j].Season })
+
+	return awards, structJSONHeaders(types.PlayerAward{}), nil
+}
+
+// awardDetail describes which selection an award was: the team of an All-NBA style award, or the week or month of
+// a player of the week or month award, with the conference when the award is given per conference
+func awardDetail(a types.PlayerAward) string {
+	var parts []string
+	if n, err := strconv.Atoi(a.TeamNumber); err == nil && n > 0 {
+		parts = append(parts, ordinal(n)+" Team")
+	}
+	if d, ok := parseAwardDate(a.Week); ok {
+		parts = append(parts, "Week of "+d.Format("Jan 2"))
+	} else if d, ok := parseAwardDate(a.Month); ok {
+		parts = append(parts, d.Format("January"))
+	}
+	if a.Conference != "" {
+		parts = append(parts, a.Conference)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func parseAwardDate(s string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02"} {
+		if d, err := time.Parse(layout, s); err == nil {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	}
+	return strconv.Itoa(n) + "th"
+}
+
+// cellString converts a response cell to a string, the API returns null for missing values
+func cellString(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return strings.TrimSpace(s)
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(s)
+	}
+	return ""
+}
+
+// SummarizePlayerAwards counts the headline awards shown as badges on the player profile
+func SummarizePlayerAwards(awards []types.PlayerAward) types.PlayerAwardSummary {
+	var s types.PlayerAwardSummary
+	for _, a := range awards {
+		switch a.Description {
+		case "NBA Most Valuable Player":
+			s.MVP++
+		case "NBA Finals Most Valuable Player":
+			s.FinalsMVP++
+		case "NBA Champion":
+			s.Championships++
+		case "All-NBA":
+			s.AllNBA++
+		case "NBA All-Star":
+			s.AllStar++
+		case "NBA Player of the Week":
+			s.PlayerOfTheWeek++
+		}
+	}
+	return s
+}
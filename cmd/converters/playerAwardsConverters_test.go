@@ -0,0 +1,87 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestPopulatePlayerAwards(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{
+		Name: "PlayerAwards",
+		Headers: []string{"PERSON_ID", "TEAM", "DESCRIPTION", "ALL_NBA_TEAM_NUMBER", "SEASON", "MONTH", "WEEK",
+			"CONFERENCE", "TYPE"},
+		RowSet: [][]interface{}{
+			{203999.0, "Denver Nuggets", "All-NBA", "2", "2020-21", nil, nil, nil, "Award"},
+			{203999.0, "Denver Nuggets", "All-NBA", "1", "2022-23", nil, nil, nil, "Award"},
+			{203999.0, "Denver Nuggets", "NBA Most Valuable Player", nil, "2021-22", nil, nil, nil, "Award"},
+			{203999.0, "Denver Nuggets", "NBA Champion", nil, "2022-23", nil, nil, nil, "Award"},
+			{203999.0, "Denver Nuggets", "NBA Player of the Week", nil, "2022-23", nil, "2023-01-09T00:00:00", "West", "Award"},
+			{203999.0, "Denver Nuggets", "NBA Player of the Month", nil, "2022-23", "2022-12-01T00:00:00", nil, "West", "Award"},
+		},
+	}}}
+
+	awards, headers, err := PopulatePlayerAwards(rs)
+	if err != nil {
+		t.Fatalf("PopulatePlayerAwards() error: %v", err)
+	}
+	if len(headers) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if len(awards) != 6 {
+		t.Fatalf("expected 6 awards, got %d", len(awards))
+	}
+	if awards[0].Season != "2022-23" || awards[len(awards)-1].Season != "2020-21" {
+		t.Errorf("awards are not ordered by season: %+v", awards)
+	}
+
+	details := make(map[string]string)
+	for _, a := range awards {
+		details[a.Description+" "+a.Season] = a.Detail
+	}
+	for name, want := range map[string]string{
+		"All-NBA 2020-21":                  "2nd Team",
+		"All-NBA 2022-23":                  "1st Team",
+		"NBA Most Valuable Player 2021-22": "",
+		"NBA Player of the Week 2022-23":   "Week of Jan 9, West",
+		"NBA Player of the Month 2022-23":  "December, West",
+	} {
+		if details[name] != want {
+			t.Errorf("detail of %s = %q, want %q", name, details[name], want)
+		}
+	}
+}
+
+func TestPopulatePlayerAwards_NoAwards(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{Name: "PlayerAwards", Headers: []string{"SEASON"}}}}
+	awards, _, err := PopulatePlayerAwards(rs)
+	if err != nil {
+		t.Fatalf("a player without awards should not be an error: %v", err)
+	}
+	if len(awards) != 0 {
+		t.Errorf("expected no awards, got %+v", awards)
+	}
+
+	if _, _, err = PopulatePlayerAwards(types.ResponseSet{}); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+}
+
+func TestSummarizePlayerAwards(t *testing.T) {
+	awards := []types.PlayerAward{
+		{Description: "NBA Most Valuable Player"},
+		{Description: "NBA Most Valuable Player"},
+		{Description: "NBA Finals Most Valuable Player"},
+		{Description: "NBA Champion"},
+		{Description: "All-NBA"},
+		{Description: "NBA All-Star"},
+		{Description: "NBA All-Star"},
+		{Description: "NBA All-Star Most Valuable Player"},
+		{Description: "NBA Player of the Week"},
+	}
+	got := SummarizePlayerAwards(awards)
+	want := types.PlayerAwardSummary{MVP: 2, FinalsMVP: 1, Championships: 1, AllNBA: 1, AllStar: 2, PlayerOfTheWeek: 1}
+	if got != want {
+		t.Errorf("SummarizePlayerAwards() = %+v, want %+v", got, want)
+	}
+}
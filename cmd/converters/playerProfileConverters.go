@@ -59,16 +59,64 @@ func PopulatePlayerBio(rs types.ResponseSet) (types.PlayerBio, error) {
 // PopulateSeasonStats extracts career season stats from the playercareerstats API response.
 // It looks for the result set named "SeasonTotalsRegularSeason".
 func PopulateSeasonStats(rs types.ResponseSet) ([]types.SeasonStats, []string, error) {
+	stats, err := seasonStatsFromSet(rs, "SeasonTotalsRegularSeason", true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structHeaders := structJSONHeaders(types.SeasonStats{})
+	return stats, structHeaders, nil
+}
+
+// careerTotalSets are the career total result sets of playercareerstats and the label of their row
+var careerTotalSets = []struct{ name, label string }{
+	{"CareerTotalsRegularSeason", "Regular Season"},
+	{"CareerTotalsPostSeason", "Playoffs"},
+	{"CareerTotalsAllStarSeason", "All-Star"},
+}
+
+// PopulateCareerStats extracts the regular season and playoff seasons, most recent first, and the career total rows
+// from the playercareerstats API response. Players without playoff or All-Star games simply have no such rows.
+func PopulateCareerStats(rs types.ResponseSet) (types.CareerStats, error) {
+	var career types.CareerStats
+	var err error
+	if career.RegularSeason, err = seasonStatsFromSet(rs, "SeasonTotalsRegularSeason", true); err != nil {
+		return types.CareerStats{}, err
+	}
+	if career.Playoffs, err = seasonStatsFromSet(rs, "SeasonTotalsPostSeason", false); err != nil {
+		return types.CareerStats{}, err
+	}
+
+	for _, set := range careerTotalSets {
+		rows, err := seasonStatsFromSet(rs, set.name, false)
+		if err != nil {
+			return types.CareerStats{}, err
+		}
+		for _, row := range rows {
+			row.SeasonID = set.label
+			row.TeamAbbr = ""
+			career.Career = append(career.Career, row)
+		}
+	}
+	return career, nil
+}
+
+// seasonStatsFromSet converts the rows of a playercareerstats result set, which lists the oldest season first,
+// into SeasonStats with the most recent season first
+func seasonStatsFromSet(rs types.ResponseSet, name string, required bool) ([]types.SeasonStats, error) {
 	var targetSet *types.ResultSet
 	for i := range rs.ResultSets {
-		if rs.ResultSets[i].Name == "SeasonTotalsRegularSeason" {
+		if rs.ResultSets[i].Name == name {
 			targetSet = &rs.ResultSets[i]
 			break
 		}
 	}
 
 	if targetSet == nil {
-		return nil, nil, fmt.Errorf("SeasonTotalsRegularSeason result set not found")
+		if required {
+			return nil, fmt.Errorf("%s result set not found", name)
+		}
+		return nil, nil
 	}
 
 	apiHeaders := targetSet.Headers
@@ -76,7 +124,7 @@ func PopulateSeasonStats(rs types.ResponseSet) ([]types.SeasonStats, []string, e
 
 	for _, row := range targetSet.RowSet {
 		if len(row) != len(apiHeaders) {
-			return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(apiHeaders))
+			return nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(apiHeaders))
 		}
 
 		data := make(map[string]interface{})
@@ -86,12 +134,12 @@ func PopulateSeasonStats(rs types.ResponseSet) ([]types.SeasonStats, []string, e
 
 		jsonData, err := json.Marshal(data)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to marshal season stats: %v", err)
+			return nil, fmt.Errorf("failed to marshal season stats: %v", err)
 		}
 
 		var season types.SeasonStats
 		if err = json.Unmarshal(jsonData, &season); err != nil {
-			return nil, nil, fmt.Errorf("failed to unmarshal season stats: %v", err)
+			return nil, fmt.Errorf("failed to unmarshal season stats: %v", err)
 		}
 
 		stats = append(stats, season)
@@ -100,14 +148,29 @@ func PopulateSeasonStats(rs types.ResponseSet) ([]types.SeasonStats, []string, e
 	for i, j := 0, len(stats)-1; i < j; i, j = i+1, j-1 {
 		stats[i], stats[j] = stats[j], stats[i]
 	}
+	return stats, nil
+}
 
-	structHeaders := structJSONHeaders(types.SeasonStats{})
-	return stats, structHeaders, nil
+// SeasonTotalsHeaders returns the table headers matching the fields of types.SeasonTotals
+func SeasonTotalsHeaders() []string {
+	return structJSONHeaders(types.SeasonTotals{})
 }
 
 // PopulateGameLog extracts the most recent games from the playergamelog API response.
 // Returns at most 5 entries (the API returns games in reverse chronological order).
 func PopulateGameLog(rs types.ResponseSet) ([]types.GameLogEntry, []string, error) {
+	entries, headers, err := PopulateSeasonGameLog(rs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(entries) > 5 {
+		entries = entries[:5]
+	}
+	return entries, headers, nil
+}
+
+// PopulateSeasonGameLog extracts every game of the playergamelog API response, most recent first
+func PopulateSeasonGameLog(rs types.ResponseSet) ([]types.GameLogEntry, []string, error) {
 	if len(rs.ResultSets) == 0 {
 		return nil, nil, fmt.Errorf("no game log data found")
 	}
@@ -115,13 +178,8 @@ func PopulateGameLog(rs types.ResponseSet) ([]types.GameLogEntry, []string, erro
 	apiHeaders := rs.ResultSets[0].Headers
 	rows := rs.ResultSets[0].RowSet
 
-	limit := len(rows)
-	if limit > 5 {
-		limit = 5
-	}
-
 	var entries []types.GameLogEntry
-	for _, row := range rows[:limit] {
+	for _, row := range rows {
 		if len(row) != len(apiHeaders) {
 			return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(apiHeaders))
 		}
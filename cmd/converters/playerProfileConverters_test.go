@@ -141,6 +141,36 @@ func TestPopulateGameLog(t *testing.T) {
 	}
 }
 
+func TestPopulateSeasonGameLog(t *testing.T) {
+	rs := types.ResponseSet{
+		ResultSets: []types.ResultSet{
+			{
+				Name:    "PlayerGameLog",
+				Headers: []string{"GAME_DATE", "MATCHUP", "PTS"},
+				RowSet: [][]interface{}{
+					{"FEB 10, 2025", "MIA vs. BOS", 24.0},
+					{"FEB 08, 2025", "MIA @ NYK", 18.0},
+					{"FEB 06, 2025", "MIA vs. LAL", 28.0},
+					{"FEB 04, 2025", "MIA @ CHI", 22.0},
+					{"FEB 02, 2025", "MIA vs. ATL", 16.0},
+					{"JAN 31, 2025", "MIA @ PHI", 20.0},
+				},
+			},
+		},
+	}
+
+	entries, _, err := PopulateSeasonGameLog(rs)
+	if err != nil {
+		t.Fatalf("PopulateSeasonGameLog() error: %v", err)
+	}
+	if len(entries) != 6 {
+		t.Fatalf("expected all 6 games, got %d", len(entries))
+	}
+	if entries[5].GameDate != "JAN 31, 2025" || entries[5].PTS != 20 {
+		t.Errorf("unexpected last entry: %+v", entries[5])
+	}
+}
+
 func TestPopulateGameLog_EmptyResultSets(t *testing.T) {
 	rs := types.ResponseSet{}
 	_, _, err := PopulateGameLog(rs)
@@ -172,3 +202,50 @@ func TestPopulateGameLog_FewerThan5Games(t *testing.T) {
 		t.Errorf("expected 2 entries, got %d", len(entries))
 	}
 }
+
+func TestPopulateCareerStats(t *testing.T) {
+	headers := []string{"SEASON_ID", "TEAM_ABBREVIATION", "GP", "PTS"}
+	totalHeaders := []string{"PLAYER_ID", "GP", "PTS"}
+	rs := types.ResponseSet{
+		ResultSets: []types.ResultSet{
+			{Name: "SeasonTotalsRegularSeason", Headers: headers, RowSet: [][]interface{}{
+				{"2023-24", "MIA", 70.0, 1500.0},
+				{"2024-25", "MIA", 60.0, 1400.0},
+			}},
+			{Name: "CareerTotalsRegularSeason", Headers: totalHeaders, RowSet: [][]interface{}{
+				{1628389.0, 130.0, 2900.0},
+			}},
+			{Name: "SeasonTotalsPostSeason", Headers: headers, RowSet: [][]interface{}{
+				{"2023-24", "MIA", 5.0, 110.0},
+			}},
+			{Name: "CareerTotalsPostSeason", Headers: totalHeaders, RowSet: [][]interface{}{
+				{1628389.0, 5.0, 110.0},
+			}},
+			{Name: "CareerTotalsAllStarSeason", Headers: totalHeaders, RowSet: [][]interface{}{}},
+		},
+	}
+
+	career, err := PopulateCareerStats(rs)
+	if err != nil {
+		t.Fatalf("PopulateCareerStats() error: %v", err)
+	}
+	if len(career.RegularSeason) != 2 || career.RegularSeason[0].SeasonID != "2024-25" {
+		t.Errorf("regular season should list the most recent season first: %+v", career.RegularSeason)
+	}
+	if len(career.Playoffs) != 1 || career.Playoffs[0].PTS != 110 {
+		t.Errorf("unexpected playoffs: %+v", career.Playoffs)
+	}
+	if len(career.Career) != 2 {
+		t.Fatalf("expected regular season and playoff career rows, got %+v", career.Career)
+	}
+	if career.Career[0].SeasonID != "Regular Season" || career.Career[0].GP != 130 ||
+		career.Career[1].SeasonID != "Playoffs" {
+		t.Errorf("unexpected career rows: %+v", career.Career)
+	}
+}
+
+func TestPopulateCareerStats_MissingRegularSeason(t *testing.T) {
+	if _, err := PopulateCareerStats(types.ResponseSet{}); err == nil {
+		t.Error("expected error for missing SeasonTotalsRegularSeason")
+	}
+}
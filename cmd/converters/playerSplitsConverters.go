@@ -0,0 +1,105 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// playerSplitSets maps the result sets of the player dashboards to the split tables, in display order.
+// Result sets sharing a table name are merged into one table, label replaces their GROUP_VALUE when set.
+var playerSplitSets = []struct {
+	dashboard, resultSet, name, label string
+}{
+	{"generalsplits", "LocationPlayerDashboard", "HOME/ROAD", ""},
+	{"generalsplits", "WinsLossesPlayerDashboard", "WINS/LOSSES", ""},
+	{"generalsplits", "MonthPlayerDashboard", "BY MONTH", ""},
+	{"generalsplits", "PrePostAllStarPlayerDashboard", "ALL-STAR BREAK", ""},
+	{"generalsplits", "DaysRestPlayerDashboard", "DAYS REST", ""},
+	{"lastngames", "OverallPlayerDashboard", "LAST N GAMES", "Season"},
+	{"lastngames", "Last5PlayerDashboard", "LAST N GAMES", "Last 5 Games"},
+	{"lastngames", "Last10PlayerDashboard", "LAST N GAMES", "Last 10 Games"},
+	{"lastngames", "Last15PlayerDashboard", "LAST N GAMES", "Last 15 Games"},
+	{"lastngames", "Last20PlayerDashboard", "LAST N GAMES", "Last 20 Games"},
+	{"opponent", "ConferencePlayerDashboard", "BY CONFERENCE", ""},
+	{"opponent", "DivisionPlayerDashboard", "BY DIVISION", ""},
+	{"opponent", "OpponentPlayerDashboard", "BY OPPONENT", ""},
+}
+
+// PopulatePlayerSplits groups the result sets of the player dashboards (keyed by dashboard name) into split tables.
+// Result sets missing from a response are skipped, as are tables without any rows.
+func PopulatePlayerSplits(dashboards map[string]types.ResponseSet) ([]types.PlayerSplitGroup, []string, error) {
+	var groups []types.PlayerSplitGroup
+	groupIdx := make(map[string]int)
+
+	for _, set := range playerSplitSets {
+		rs, ok := findResultSet(dashboards[set.dashboard], set.resultSet)
+		if !ok {
+			continue
+		}
+		splits, err := playerSplitsFromResultSet(rs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", set.resultSet, err)
+		}
+		if set.label != "" {
+			for i := range splits {
+				splits[i].Split = set.label
+			}
+		}
+
+		idx, ok := groupIdx[set.name]
+		if !ok {
+			idx = len(groups)
+			groupIdx[set.name] = idx
+			groups = append(groups, types.PlayerSplitGroup{Name: set.name})
+		}
+		groups[idx].Splits = append(groups[idx].Splits, splits...)
+	}
+
+	var nonEmpty []types.PlayerSplitGroup
+	for _, group := range groups {
+		if len(group.Splits) > 0 {
+			nonEmpty = append(nonEmpty, group)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return nil, nil, fmt.Errorf("no player splits found")
+	}
+	return nonEmpty, structJSONHeaders(types.PlayerSplit{}), nil
+}
+
+func findResultSet(rs types.ResponseSet, name string) (types.ResultSet, bool) {
+	for _, set := range rs.ResultSets {
+		if set.Name == name {
+			return set, true
+		}
+	}
+	return types.ResultSet{}, false
+}
+
+func playerSplitsFromResultSet(set types.ResultSet) ([]types.PlayerSplit, error) {
+	var splits []types.PlayerSplit
+	for _, row := range set.RowSet {
+		if len(row) != len(set.Headers) {
+			return nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+		}
+
+		data := make(map[string]interface{})
+		for i, value := range row {
+			data[set.Headers[i]] = value
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal split: %v", err)
+		}
+
+		var split types.PlayerSplit
+		if err = json.Unmarshal(jsonData, &split); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal split: %v", err)
+		}
+		splits = append(splits, split)
+	}
+	return splits, nil
+}
@@ -0,0 +1,63 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestPopulatePlayerSplits(t *testing.T) {
+	headers := []string{"GROUP_SET", "GROUP_VALUE", "GP", "W", "L", "MIN", "PTS", "FG_PCT"}
+	dashboards := map[string]types.ResponseSet{
+		"generalsplits": {ResultSets: []types.ResultSet{
+			{Name: "OverallPlayerDashboard", Headers: headers, RowSet: [][]interface{}{
+				{"Overall", "2024-25", 50.0, 30.0, 20.0, 34.1, 26.4, 0.512},
+			}},
+			{Name: "LocationPlayerDashboard", Headers: headers, RowSet: [][]interface{}{
+				{"Location", "Home", 25.0, 17.0, 8.0, 34.5, 28.0, 0.530},
+				{"Location", "Road", 25.0, 13.0, 12.0, 33.7, 24.8, 0.494},
+			}},
+			{Name: "MonthPlayerDashboard", Headers: headers, RowSet: [][]interface{}{}},
+		}},
+		"lastngames": {ResultSets: []types.ResultSet{
+			{Name: "OverallPlayerDashboard", Headers: headers, RowSet: [][]interface{}{
+				{"Overall", "2024-25", 50.0, 30.0, 20.0, 34.1, 26.4, 0.512},
+			}},
+			{Name: "Last5PlayerDashboard", Headers: headers, RowSet: [][]interface{}{
+				{"Last 5", "2024-25", 5.0, 4.0, 1.0, 35.0, 31.2, 0.560},
+			}},
+		}},
+	}
+
+	groups, headerNames, err := PopulatePlayerSplits(dashboards)
+	if err != nil {
+		t.Fatalf("PopulatePlayerSplits() error: %v", err)
+	}
+	if len(headerNames) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if len(groups) != 2 {
+		t.Fatalf("expected HOME/ROAD and LAST N GAMES (empty months skipped), got %+v", groups)
+	}
+
+	if groups[0].Name != "HOME/ROAD" || len(groups[0].Splits) != 2 || groups[0].Splits[1].Split != "Road" {
+		t.Errorf("unexpected home/road group: %+v", groups[0])
+	}
+	if groups[0].Splits[0].PTS != 28.0 || groups[0].Splits[0].GP != 25 {
+		t.Errorf("home split = %+v", groups[0].Splits[0])
+	}
+
+	lastN := groups[1]
+	if lastN.Name != "LAST N GAMES" || len(lastN.Splits) != 2 {
+		t.Fatalf("unexpected last n group: %+v", lastN)
+	}
+	if lastN.Splits[0].Split != "Season" || lastN.Splits[1].Split != "Last 5 Games" {
+		t.Errorf("last n splits are not relabelled: %q, %q", lastN.Splits[0].Split, lastN.Splits[1].Split)
+	}
+}
+
+func TestPopulatePlayerSplits_NoData(t *testing.T) {
+	if _, _, err := PopulatePlayerSplits(map[string]types.ResponseSet{}); err == nil {
+		t.Error("expected error when no dashboard has splits")
+	}
+}
@@ -0,0 +1,143 @@
+package converters
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// nbaFinalsMVPs maps seasons to the Finals MVP. Neither the playoff series nor the schedule carry awards; past
+// seasons never change, so a static table is enough. Seasons missing from the table are shown without a Finals MVP.
+var nbaFinalsMVPs = map[string]string{
+	"2000-01": "Shaquille O'Neal", "2001-02": "Shaquille O'Neal", "2002-03": "Tim Duncan",
+	"2003-04": "Chauncey Billups", "2004-05": "Tim Duncan", "2005-06": "Dwyane Wade",
+	"2006-07": "Tony Parker", "2007-08": "Paul Pierce", "2008-09": "Kobe Bryant",
+	"2009-10": "Kobe Bryant", "2010-11": "Dirk Nowitzki", "2011-12": "LeBron James",
+	"2012-13": "LeBron James", "2013-14": "Kawhi Leonard", "2014-15": "Andre Iguodala",
+	"2015-16": "LeBron James", "2016-17": "Kevin Durant", "2017-18": "Kevin Durant",
+	"2018-19": "Kawhi Leonard", "2019-20": "LeBron James", "2020-21": "Giannis Antetokounmpo",
+	"2021-22": "Stephen Curry", "2022-23": "Nikola Jokic", "2023-24": "Jaylen Brown",
+	"2024-25": "Shai Gilgeous-Alexander",
+}
+
+// PopulatePlayoffArchiveEntry summarizes a season from its commonPlayoffSeries response (one row per game) and
+// the season schedule. The bracket only tells which teams advanced, so the wins of every series are counted
+// from the final scores of its games in the schedule; that is the only way to know who won the Finals.
+func PopulatePlayoffArchiveEntry(rs types.ResponseSet, schedule []types.ScheduledGame, season string) (types.PlayoffArchiveEntry, error) {
+	bracket, err := PopulatePlayoffBracket(rs, season)
+	if err != nil {
+		return types.PlayoffArchiveEntry{}, err
+	}
+	wins, err := playoffSeriesWins(rs, schedule)
+	if err != nil {
+		return types.PlayoffArchiveEntry{}, err
+	}
+
+	entry := types.PlayoffArchiveEntry{Season: season, FinalsMVP: nbaFinalsMVPs[season]}
+	if entry.FinalsMVP == "" {
+		entry.FinalsMVP = "-"
+	}
+
+	finalsFound := false
+	for _, s := range bracket.Series {
+		if counted, ok := wins[s.SeriesID]; ok {
+			s.TopTeam.Wins = counted[s.TopTeam.TeamID]
+			s.BottomTeam.Wins = counted[s.BottomTeam.TeamID]
+		}
+		winner, ok := s.Winner()
+		if !ok {
+			continue
+		}
+		switch s.Round {
+		case 4:
+			loser, _ := s.Loser()
+			entry.Champion = winner.Tricode
+			entry.RunnerUp = loser.Tricode
+			entry.FinalsResult = fmt.Sprintf("%d-%d", winner.Wins, loser.Wins)
+			finalsFound = true
+		case 3:
+			switch nbaTeamConferences[atoiOrZero(winner.TeamID)] {
+			case "East":
+				entry.EastChampion = winner.Tricode
+			case "West":
+				entry.WestChampion = winner.Tricode
+			}
+		}
+	}
+	if !finalsFound {
+		return entry, fmt.Errorf("no completed Finals series for season %s", season)
+	}
+	return entry, nil
+}
+
+// playoffSeriesWins counts the wins of each team per series (SERIES_ID -> TEAM_ID -> wins) by matching the
+// games of the commonPlayoffSeries response with the finished games of the schedule
+func playoffSeriesWins(rs types.ResponseSet, schedule []types.ScheduledGame) (map[string]map[string]int, error) {
+	rs0 := rs.ResultSets[0]
+	headerIdx := make(map[string]int, len(rs0.Headers))
+	for i, h := range rs0.Headers {
+		headerIdx[h] = i
+	}
+	gameIdx, ok := headerIdx["GAME_ID"]
+	if !ok {
+		return nil, fmt.Errorf("missing required header: GAME_ID")
+	}
+	sidIdx, ok := headerIdx["SERIES_ID"]
+	if !ok {
+		return nil, fmt.Errorf("missing required header: SERIES_ID")
+	}
+
+	results := make(map[string]types.ScheduledGame, len(schedule))
+	for _, g := range schedule {
+		if g.IsFinal() {
+			results[g.GameID] = g
+		}
+	}
+
+	wins := make(map[string]map[string]int)
+	for _, row := range rs0.RowSet {
+		if gameIdx >= len(row) || sidIdx >= len(row) {
+			continue
+		}
+		game, ok := results[playoffGameID(row[gameIdx])]
+		if !ok {
+			continue
+		}
+		sid := rowString(row[sidIdx])
+		if wins[sid] == nil {
+			wins[sid] = make(map[string]int)
+		}
+		winnerID := game.HomeTeamID
+		if game.AwayScore > game.HomeScore {
+			winnerID = game.AwayTeamID
+		}
+		wins[sid][strconv.Itoa(winnerID)]++
+	}
+	return wins, nil
+}
+
+// playoffGameID formats a GAME_ID cell as the 10 digit game id used by the schedule, e.g. "0042300401"
+func playoffGameID(v interface{}) string {
+	switch id := v.(type) {
+	case string:
+		return id
+	case float64:
+		return fmt.Sprintf("%010d", int64(id))
+	case int64:
+		return fmt.Sprintf("%010d", id)
+	case int:
+		return fmt.Sprintf("%010d", id)
+	}
+	return ""
+}
+
+func atoiOrZero(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// PlayoffArchiveHeaders returns the table headers matching the fields of types.PlayoffArchiveEntry
+func PlayoffArchiveHeaders() []string {
+	return structJSONHeaders(types.PlayoffArchiveEntry{})
+}
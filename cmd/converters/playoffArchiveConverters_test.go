@@ -0,0 +1,153 @@
+package converters
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+const (
+	testBOS = 1610612738
+	testDAL = 1610612742
+	testMIN = 1610612750
+	testIND = 1610612754
+)
+
+// archiveSeries returns the 2023-24 conference finals and Finals in the commonPlayoffSeries layout (one row per game):
+// BOS swept IND, DAL beat MIN 4-1, BOS beat DAL 4-1
+func archiveSeries() types.ResponseSet {
+	var rows [][]interface{}
+	addSeries := func(seriesID string, games int, homeID, visitID float64) {
+		for g := 1; g <= games; g++ {
+			home, visit := homeID, visitID
+			if g == 3 || g == 4 || g == 6 {
+				home, visit = visitID, homeID
+			}
+			gameID := fmt.Sprintf("00%s%d", seriesID[2:], g)
+			rows = append(rows, []interface{}{gameID, home, visit, seriesID, float64(g)})
+		}
+	}
+	addSeries("004230030", 4, testBOS, testIND)
+	addSeries("004230031", 5, testMIN, testDAL)
+	addSeries("004230040", 5, testBOS, testDAL)
+
+	return types.ResponseSet{ResultSets: []types.ResultSet{{
+		Headers: []string{"GAME_ID", "HOME_TEAM_ID", "VISITOR_TEAM_ID", "SERIES_ID", "GAME_NUM"},
+		RowSet:  rows,
+	}}}
+}
+
+// archiveSchedule returns the results of the games of archiveSeries
+func archiveSchedule() []types.ScheduledGame {
+	game := func(id string, homeID, awayID, homeScore, awayScore int) types.ScheduledGame {
+		return types.ScheduledGame{GameID: id, GameStatus: 3, HomeTeamID: homeID, AwayTeamID: awayID,
+			HomeScore: homeScore, AwayScore: awayScore}
+	}
+	return []types.ScheduledGame{
+		game("0042300301", testBOS, testIND, 133, 128),
+		game("0042300302", testBOS, testIND, 126, 110),
+		game("0042300303", testIND, testBOS, 111, 114),
+		game("0042300304", testIND, testBOS, 102, 105),
+		game("0042300311", testMIN, testDAL, 105, 108),
+		game("0042300312", testMIN, testDAL, 108, 109),
+		game("0042300313", testDAL, testMIN, 116, 107),
+		game("0042300314", testDAL, testMIN, 105, 115),
+		game("0042300315", testMIN, testDAL, 103, 124),
+		game("0042300401", testBOS, testDAL, 107, 89),
+		game("0042300402", testBOS, testDAL, 105, 98),
+		game("0042300403", testDAL, testBOS, 99, 106),
+		game("0042300404", testDAL, testBOS, 122, 84),
+		game("0042300405", testBOS, testDAL, 106, 88),
+	}
+}
+
+func TestPopulatePlayoffArchiveEntry(t *testing.T) {
+	entry, err := PopulatePlayoffArchiveEntry(archiveSeries(), archiveSchedule(), "2023-24")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := types.PlayoffArchiveEntry{
+		Season:       "2023-24",
+		Champion:     "BOS",
+		FinalsResult: "4-1",
+		RunnerUp:     "DAL",
+		FinalsMVP:    "Jaylen Brown",
+		EastChampion: "BOS",
+		WestChampion: "DAL",
+	}
+	if entry != want {
+		t.Errorf("entry = %+v, want %+v", entry, want)
+	}
+}
+
+func TestPopulatePlayoffArchiveEntry_NumericGameIDs(t *testing.T) {
+	rs := archiveSeries()
+	for _, row := range rs.ResultSets[0].RowSet {
+		var id float64
+		fmt.Sscan(row[0].(string), &id)
+		row[0] = id
+	}
+
+	entry, err := PopulatePlayoffArchiveEntry(rs, archiveSchedule(), "2023-24")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Champion != "BOS" || entry.FinalsResult != "4-1" {
+		t.Errorf("Finals = %s %s, want BOS 4-1", entry.Champion, entry.FinalsResult)
+	}
+}
+
+func TestPopulatePlayoffArchiveEntry_ErrorWhileFinalsOngoing(t *testing.T) {
+	schedule := archiveSchedule()
+	schedule[len(schedule)-1].GameStatus = 1
+
+	if _, err := PopulatePlayoffArchiveEntry(archiveSeries(), schedule, "2023-24"); err == nil {
+		t.Error("expected error for a season without a completed Finals")
+	}
+}
+
+func TestPopulatePlayoffArchiveEntry_ErrorWithoutGameID(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{
+		Headers: []string{"HOME_TEAM_ID", "VISITOR_TEAM_ID", "SERIES_ID", "GAME_NUM"},
+		RowSet:  [][]interface{}{{float64(testBOS), float64(testDAL), "004230040", float64(1)}},
+	}}}
+
+	if _, err := PopulatePlayoffArchiveEntry(rs, archiveSchedule(), "2023-24"); err == nil {
+		t.Error("expected error without GAME_ID")
+	}
+}
+
+func TestPopulatePlayoffArchiveEntry_UnknownFinalsMVP(t *testing.T) {
+	entry, err := PopulatePlayoffArchiveEntry(archiveSeries(), archiveSchedule(), "1999-00")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.FinalsMVP != "-" {
+		t.Errorf("FinalsMVP = %q, want - for a season missing from the table", entry.FinalsMVP)
+	}
+}
+
+func TestPlayoffSeriesWins_ErrorWithoutSeriesID(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{
+		Headers: []string{"GAME_ID", "HOME_TEAM_ID", "VISITOR_TEAM_ID", "GAME_NUM"},
+		RowSet:  [][]interface{}{{"0042300401", float64(testBOS), float64(testDAL), float64(1)}},
+	}}}
+
+	if _, err := playoffSeriesWins(rs, archiveSchedule()); err == nil {
+		t.Error("expected error without SERIES_ID")
+	}
+}
+
+func TestPlayoffSeriesWins_SkipsShortRows(t *testing.T) {
+	rs := archiveSeries()
+	rs.ResultSets[0].RowSet = append(rs.ResultSets[0].RowSet, []interface{}{"0042300406"})
+
+	wins, err := playoffSeriesWins(rs, archiveSchedule())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := wins["004230040"][fmt.Sprint(testBOS)]; got != 4 {
+		t.Errorf("BOS Finals wins = %d, want 4", got)
+	}
+}
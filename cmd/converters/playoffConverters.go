@@ -16,9 +16,13 @@ import (
 // 8:    West Finals
 // 9-10: West Semis
 // 11-14: West R1 (1v8, 4v5, 3v6, 2v7)
+//
+// Play-in games live in PlayoffBracket.PlayIn: 0-2 East, 3-5 West (7v8, 9v10, final).
+// The 7v8 winner takes the 7 seed (2v7 series), the final's winner the 8 seed (1v8 series).
 
 // ProjectedBracketFromStandings builds a pre-playoff bracket from current standings.
 // All R2/CF/Finals series are TBD. First-round matchups follow 1v8, 4v5, 3v6, 2v7 seeding.
+// Seeds 7-10 are also placed in the play-in games; the play-in final stays TBD.
 func ProjectedBracketFromStandings(east, west []types.Team, season string) types.PlayoffBracket {
 	sort.Slice(east, func(i, j int) bool { return east[i].PlayoffSeeding < east[j].PlayoffSeeding })
 	sort.Slice(west, func(i, j int) bool { return west[i].PlayoffSeeding < west[j].PlayoffSeeding })
@@ -33,6 +37,7 @@ func ProjectedBracketFromStandings(east, west []types.Team, season string) types
 
 	teamFromStandings := func(t types.Team) types.PlayoffTeam {
 		return types.PlayoffTeam{
+			TeamID:  strconv.Itoa(t.TeamID),
 			Name:    t.TeamName,
 			Tricode: teamTricode(t),
 			Seed:    t.PlayoffSeeding,
@@ -74,7 +79,196 @@ func ProjectedBracketFromStandings(east, west []types.Team, season string) types
 	series[10] = tbdSeries(2, "West")
 	copy(series[11:15], westR1)
 
-	return types.PlayoffBracket{Season: season, Series: series}
+	playIn := make([]types.PlayoffSeries, 0, 2*types.PlayInGamesPerConference)
+	for _, conf := range []struct {
+		name  string
+		teams []types.Team
+	}{{"East", east}, {"West", west}} {
+		games := make([]types.PlayoffSeries, types.PlayInGamesPerConference)
+		for i := range games {
+			games[i] = tbdSeries(0, conf.name)
+			games[i].PlayIn = true
+		}
+		if len(conf.teams) >= 10 {
+			games[types.PlayInSevenEight].TopTeam = teamFromStandings(conf.teams[6])
+			games[types.PlayInSevenEight].BottomTeam = teamFromStandings(conf.teams[7])
+			games[types.PlayInNineTen].TopTeam = teamFromStandings(conf.teams[8])
+			games[types.PlayInNineTen].BottomTeam = teamFromStandings(conf.teams[9])
+		}
+		playIn = append(playIn, games...)
+	}
+
+	return types.PlayoffBracket{Season: season, Series: series, PlayIn: playIn}
+}
+
+// PopulatePlayInGames extracts the play-in tournament games from the season schedule.
+// The result holds 6 games (see PlayoffBracket.PlayIn), games not yet scheduled are left TBD.
+// Nil is returned when the schedule contains no play-in games, e.g. for seasons before 2020-21.
+func PopulatePlayInGames(schedule []types.ScheduledGame) []types.PlayoffSeries {
+	byConference := make(map[string][]types.ScheduledGame)
+	for _, game := range schedule {
+		if !game.IsPlayIn() {
+			continue
+		}
+		// the teams of the play-in final are unknown until the first games are played, so either one will do
+		conf := nbaTeamConferences[game.HomeTeamID]
+		if conf == "" {
+			conf = nbaTeamConferences[game.AwayTeamID]
+		}
+		if conf == "" {
+			continue
+		}
+		byConference[conf] = append(byConference[conf], game)
+	}
+	if len(byConference) == 0 {
+		return nil
+	}
+
+	tbd := types.PlayoffTeam{Tricode: "TBD"}
+	playIn := make([]types.PlayoffSeries, 0, 2*types.PlayInGamesPerConference)
+	for _, conf := range []string{"East", "West"} {
+		block := make([]types.PlayoffSeries, types.PlayInGamesPerConference)
+		for i := range block {
+			block[i] = types.PlayoffSeries{Conference: conf, Status: "pre", PlayIn: true, TopTeam: tbd, BottomTeam: tbd}
+		}
+
+		games := byConference[conf]
+		sort.SliceStable(games, func(i, j int) bool {
+			if games[i].GameDate != games[j].GameDate {
+				return games[i].GameDate < games[j].GameDate
+			}
+			return games[i].GameID < games[j].GameID
+		})
+		for i, game := range games {
+			if slot := playInSlot(game, i); slot >= 0 {
+				block[slot] = playInSeries(game, conf)
+			}
+		}
+		playIn = append(playIn, block...)
+	}
+	return playIn
+}
+
+// playInSlot decides which play-in game a scheduled game is, primarily by the seeds of the teams.
+// Without seeds the chronological order of the conference's games is used instead.
+func playInSlot(game types.ScheduledGame, order int) int {
+	if game.HomeSeed > 0 && game.AwaySeed > 0 {
+		switch game.HomeSeed + game.AwaySeed {
+		case 7 + 8:
+			if game.HomeSeed == 7 || game.HomeSeed == 8 {
+				return types.PlayInSevenEight
+			}
+		case 9 + 10:
+			if game.HomeSeed == 9 || game.HomeSeed == 10 {
+				return types.PlayInNineTen
+			}
+		}
+		return types.PlayInFinal
+	}
+	if order < types.PlayInGamesPerConference {
+		return order
+	}
+	return -1
+}
+
+// playInSeries converts a scheduled play-in game into a single game series. The home team is the higher seed.
+func playInSeries(game types.ScheduledGame, conf string) types.PlayoffSeries {
+	playInTeam := func(teamID, seed int) types.PlayoffTeam {
+		if teamID == 0 {
+			return types.PlayoffTeam{Tricode: "TBD"}
+		}
+		return types.PlayoffTeam{TeamID: strconv.Itoa(teamID), Tricode: nbaTeamTricodes[teamID], Seed: seed}
+	}
+
+	series := types.PlayoffSeries{
+		SeriesID:   game.GameID,
+		Conference: conf,
+		PlayIn:     true,
+		TopTeam:    playInTeam(game.HomeTeamID, game.HomeSeed),
+		BottomTeam: playInTeam(game.AwayTeamID, game.AwaySeed),
+		Status:     "pre",
+	}
+	switch {
+	case game.IsFinal():
+		series.Status = "complete"
+		if game.HomeScore > game.AwayScore {
+			series.TopTeam.Wins = 1
+		} else {
+			series.BottomTeam.Wins = 1
+		}
+	case game.GameStatus == 2:
+		series.Status = "active"
+	}
+	return series
+}
+
+// ApplyPlayIn merges play-in games into the bracket and moves the play-in winners into the 7 and 8 seeds
+// of the first round. Games in playIn which are still TBD do not overwrite the (projected) games already in the bracket.
+func ApplyPlayIn(bracket types.PlayoffBracket, playIn []types.PlayoffSeries) types.PlayoffBracket {
+	if len(bracket.Series) < 15 || (len(playIn) == 0 && len(bracket.PlayIn) == 0) {
+		return bracket
+	}
+
+	merged := make([]types.PlayoffSeries, 2*types.PlayInGamesPerConference)
+	copy(merged, bracket.PlayIn)
+	for i, game := range playIn {
+		if i >= len(merged) {
+			break
+		}
+		if game.SeriesID != "" || merged[i].TopTeam.Tricode == "" {
+			merged[i] = game
+		}
+	}
+
+	series := make([]types.PlayoffSeries, len(bracket.Series))
+	copy(series, bracket.Series)
+
+	// cursor indexes of the 1v8 and 2v7 series of each conference
+	firstRound := [2][2]int{{0, 3}, {11, 14}}
+	for c := range firstRound {
+		block := merged[c*types.PlayInGamesPerConference : (c+1)*types.PlayInGamesPerConference]
+
+		final := &block[types.PlayInFinal]
+		if final.Status == "pre" && final.SeriesID == "" {
+			if loser, ok := block[types.PlayInSevenEight].Loser(); ok {
+				final.TopTeam = advancedTeam(loser, loser.Seed)
+			}
+			if winner, ok := block[types.PlayInNineTen].Winner(); ok {
+				final.BottomTeam = advancedTeam(winner, winner.Seed)
+			}
+		}
+
+		if winner, ok := block[types.PlayInSevenEight].Winner(); ok {
+			seatPlayInWinner(&series[firstRound[c][1]], winner, 7)
+		}
+		if winner, ok := block[types.PlayInFinal].Winner(); ok {
+			seatPlayInWinner(&series[firstRound[c][0]], winner, 8)
+		}
+	}
+
+	bracket.Series = series
+	bracket.PlayIn = merged
+	return bracket
+}
+
+// seatPlayInWinner puts a play-in winner into the bottom slot of a first round series. Series which already
+// have their teams from the playoff data only get the seed filled in.
+func seatPlayInWinner(series *types.PlayoffSeries, winner types.PlayoffTeam, seed int) {
+	switch {
+	case series.BottomTeam.TeamID != "" && series.BottomTeam.TeamID == winner.TeamID:
+		series.BottomTeam.Seed = seed
+	case series.TopTeam.TeamID != "" && series.TopTeam.TeamID == winner.TeamID:
+		series.TopTeam.Seed = seed
+	case series.Status == "pre":
+		series.BottomTeam = advancedTeam(winner, seed)
+	}
+}
+
+// advancedTeam returns the team as it enters its next series: with the given seed and no wins yet
+func advancedTeam(team types.PlayoffTeam, seed int) types.PlayoffTeam {
+	team.Wins = 0
+	team.Seed = seed
+	return team
 }
 
 // nbaTeamTricodes maps stable NBA team IDs to official 2-3 letter tricodes.
@@ -90,6 +284,18 @@ var nbaTeamTricodes = map[int]string{
 	1610612765: "DET", 1610612766: "CHA",
 }
 
+// nbaTeamConferences maps stable NBA team IDs to their conference.
+var nbaTeamConferences = map[int]string{
+	1610612737: "East", 1610612738: "East", 1610612739: "East", 1610612740: "West",
+	1610612741: "East", 1610612742: "West", 1610612743: "West", 1610612744: "West",
+	1610612745: "West", 1610612746: "West", 1610612747: "West", 1610612748: "East",
+	1610612749: "East", 1610612750: "West", 1610612751: "East", 1610612752: "East",
+	1610612753: "East", 1610612754: "East", 1610612755: "East", 1610612756: "West",
+	1610612757: "West", 1610612758: "West", 1610612759: "West", 1610612760: "West",
+	1610612761: "East", 1610612762: "West", 1610612763: "West", 1610612764: "East",
+	1610612765: "East", 1610612766: "East",
+}
+
 func teamTricode(t types.Team) string {
 	if tri, ok := nbaTeamTricodes[t.TeamID]; ok {
 		return tri
@@ -162,3 +162,119 @@ func TestPopulatePlayoffSeriesGames_ReturnsErrorOnEmptyResultSet(t *testing.T) {
 		t.Error("expected error for empty ResultSet")
 	}
 }
+
+func TestProjectedBracketFromStandings_PlayIn(t *testing.T) {
+	east := make([]types.Team, 15)
+	west := make([]types.Team, 15)
+	for i := 0; i < 15; i++ {
+		east[i] = types.Team{TeamID: 1000 + i, TeamName: "ETeam", PlayoffSeeding: i + 1, Conference: "East"}
+		west[i] = types.Team{TeamID: 2000 + i, TeamName: "WTeam", PlayoffSeeding: i + 1, Conference: "West"}
+	}
+
+	bracket := ProjectedBracketFromStandings(east, west, "2024-25")
+
+	if len(bracket.PlayIn) != 6 {
+		t.Fatalf("len(PlayIn) = %d, want 6", len(bracket.PlayIn))
+	}
+	sevenEight := bracket.PlayIn[types.PlayInSevenEight]
+	if sevenEight.TopTeam.Seed != 7 || sevenEight.BottomTeam.Seed != 8 || !sevenEight.PlayIn {
+		t.Errorf("East 7v8: seeds %d vs %d (play-in %v), want 7 vs 8 (play-in)",
+			sevenEight.TopTeam.Seed, sevenEight.BottomTeam.Seed, sevenEight.PlayIn)
+	}
+	nineTen := bracket.PlayIn[types.PlayInGamesPerConference+types.PlayInNineTen]
+	if nineTen.TopTeam.Seed != 9 || nineTen.BottomTeam.Seed != 10 || nineTen.Conference != "West" {
+		t.Errorf("West 9v10: seeds %d vs %d (%s), want 9 vs 10 (West)",
+			nineTen.TopTeam.Seed, nineTen.BottomTeam.Seed, nineTen.Conference)
+	}
+	if final := bracket.PlayIn[types.PlayInFinal]; final.TopTeam.Tricode != "TBD" {
+		t.Errorf("East play-in final TopTeam = %s, want TBD", final.TopTeam.Tricode)
+	}
+}
+
+// playInSchedule returns the East play-in of 2024-25: ORL(7) beat ATL(8), MIA(10) beat CHI(9), MIA beat ATL
+func playInSchedule() []types.ScheduledGame {
+	return []types.ScheduledGame{
+		{GameID: "0022401230", GameDate: "2025-04-13", GameStatus: 3, HomeTeamID: 1610612753, AwayTeamID: 1610612737, HomeScore: 100, AwayScore: 90},
+		{GameID: "0052400101", GameDate: "2025-04-15", GameStatus: 3, HomeTeamID: 1610612753, AwayTeamID: 1610612737, HomeScore: 120, AwayScore: 95, HomeSeed: 7, AwaySeed: 8},
+		{GameID: "0052400111", GameDate: "2025-04-16", GameStatus: 3, HomeTeamID: 1610612741, AwayTeamID: 1610612748, HomeScore: 90, AwayScore: 109, HomeSeed: 9, AwaySeed: 10},
+		{GameID: "0052400201", GameDate: "2025-04-18", GameStatus: 3, HomeTeamID: 1610612737, AwayTeamID: 1610612748, HomeScore: 114, AwayScore: 123, HomeSeed: 8, AwaySeed: 10},
+	}
+}
+
+func TestPopulatePlayInGames(t *testing.T) {
+	playIn := PopulatePlayInGames(playInSchedule())
+	if len(playIn) != 6 {
+		t.Fatalf("len(playIn) = %d, want 6", len(playIn))
+	}
+
+	sevenEight := playIn[types.PlayInSevenEight]
+	if winner, ok := sevenEight.Winner(); !ok || winner.Tricode != "ORL" || winner.Seed != 7 {
+		t.Errorf("East 7v8 winner = %+v (%v), want ORL (7)", winner, ok)
+	}
+	nineTen := playIn[types.PlayInNineTen]
+	if winner, ok := nineTen.Winner(); !ok || winner.Tricode != "MIA" {
+		t.Errorf("East 9v10 winner = %+v (%v), want MIA", winner, ok)
+	}
+	final := playIn[types.PlayInFinal]
+	if final.TopTeam.Tricode != "ATL" || final.Status != "complete" {
+		t.Errorf("East final = %s (%s), want ATL hosting (complete)", final.TopTeam.Tricode, final.Status)
+	}
+	if west := playIn[types.PlayInGamesPerConference]; west.TopTeam.Tricode != "TBD" {
+		t.Errorf("West 7v8 TopTeam = %s, want TBD", west.TopTeam.Tricode)
+	}
+}
+
+func TestPopulatePlayInGames_NoPlayIn(t *testing.T) {
+	schedule := playInSchedule()[:1]
+	if playIn := PopulatePlayInGames(schedule); playIn != nil {
+		t.Errorf("PopulatePlayInGames() = %v, want nil without play-in games", playIn)
+	}
+}
+
+func TestApplyPlayIn_WinnersTakeSevenAndEightSeeds(t *testing.T) {
+	east := make([]types.Team, 10)
+	west := make([]types.Team, 10)
+	eastIDs := []int{1610612739, 1610612738, 1610612752, 1610612754, 1610612749, 1610612765, 1610612753, 1610612737, 1610612741, 1610612748}
+	for i := 0; i < 10; i++ {
+		east[i] = types.Team{TeamID: eastIDs[i], PlayoffSeeding: i + 1, Conference: "East"}
+		west[i] = types.Team{TeamID: 2000 + i, TeamName: "WTeam", PlayoffSeeding: i + 1, Conference: "West"}
+	}
+	bracket := ProjectedBracketFromStandings(east, west, "2024-25")
+
+	bracket = ApplyPlayIn(bracket, PopulatePlayInGames(playInSchedule()))
+
+	// 2v7 (cursor 3): ORL won the 7v8 game and keeps the 7 seed
+	if bot := bracket.Series[3].BottomTeam; bot.Tricode != "ORL" || bot.Seed != 7 {
+		t.Errorf("East 2v7 bottom = %s (%d), want ORL (7)", bot.Tricode, bot.Seed)
+	}
+	// 1v8 (cursor 0): MIA won the play-in final and takes the 8 seed from ATL
+	if bot := bracket.Series[0].BottomTeam; bot.Tricode != "MIA" || bot.Seed != 8 || bot.Wins != 0 {
+		t.Errorf("East 1v8 bottom = %s (%d, %d wins), want MIA (8, 0 wins)", bot.Tricode, bot.Seed, bot.Wins)
+	}
+	// West play-in was not in the schedule, the projection from the standings is kept
+	if top := bracket.PlayIn[types.PlayInGamesPerConference].TopTeam; top.Seed != 7 {
+		t.Errorf("West 7v8 TopTeam seed = %d, want projected 7", top.Seed)
+	}
+}
+
+func TestApplyPlayIn_FillsSeedOfActualSeries(t *testing.T) {
+	bracket := types.PlayoffBracket{Series: make([]types.PlayoffSeries, 15)}
+	bracket.Series[0] = types.PlayoffSeries{
+		Round: 1, Status: "active",
+		TopTeam:    types.PlayoffTeam{TeamID: "1610612739", Tricode: "CLE", Wins: 2},
+		BottomTeam: types.PlayoffTeam{TeamID: "1610612748", Tricode: "MIA", Wins: 1},
+	}
+
+	bracket = ApplyPlayIn(bracket, PopulatePlayInGames(playInSchedule()))
+
+	if bot := bracket.Series[0].BottomTeam; bot.Seed != 8 || bot.Wins != 1 {
+		t.Errorf("East 1v8 bottom = seed %d with %d wins, want seed 8 with 1 win", bot.Seed, bot.Wins)
+	}
+}
+
+func TestApplyPlayIn_NoPlayInLeavesBracketUntouched(t *testing.T) {
+	bracket := types.PlayoffBracket{Series: make([]types.PlayoffSeries, 15)}
+	if got := ApplyPlayIn(bracket, nil); got.PlayIn != nil {
+		t.Errorf("ApplyPlayIn() PlayIn = %v, want nil", got.PlayIn)
+	}
+}
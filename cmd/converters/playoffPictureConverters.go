@@ -0,0 +1,281 @@
+package converters
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+const (
+	// PlayoffSimulations is the default number of seasons simulated for the playoff picture
+	PlayoffSimulations = 5000
+	// homeCourtEdge is added to the home team's win probability in every simulated game
+	homeCourtEdge = 0.03
+)
+
+// SimulatePlayoffPicture projects the final regular season standings with a simple Monte Carlo simulation.
+// Every remaining regular season game in the schedule is decided by a coin flip weighted by both teams' current
+// win percentage (log5 with a small home court edge). Ties in the final standings are broken by the record between
+// the tied teams, then by conference record and finally by a random draw, just like the league does.
+// The odds are returned per conference, ordered by projected seed.
+func SimulatePlayoffPicture(teams types.Teams, schedule []types.ScheduledGame, iterations int, rng *rand.Rand) []types.PlayoffOdds {
+	n := len(teams)
+	if n == 0 {
+		return nil
+	}
+	if iterations < 1 {
+		iterations = 1
+	}
+
+	teamIdx := make(map[int]int, n)
+	for i, team := range teams {
+		teamIdx[team.TeamID] = i
+	}
+
+	baseH2H := make([]int, n*n)
+	baseConfWins := make([]int, n)
+	baseConfLosses := make([]int, n)
+	for i, team := range teams {
+		baseConfWins[i], baseConfLosses[i], _ = parseRecord(team.ConferenceRecord)
+	}
+
+	var remaining [][2]int
+	for _, game := range schedule {
+		if !game.IsRegularSeason() {
+			continue
+		}
+		home, homeOk := teamIdx[game.HomeTeamID]
+		away, awayOk := teamIdx[game.AwayTeamID]
+		if !homeOk || !awayOk {
+			continue
+		}
+		if game.IsFinal() {
+			switch {
+			case game.HomeScore > game.AwayScore:
+				baseH2H[home*n+away]++
+			case game.AwayScore > game.HomeScore:
+				baseH2H[away*n+home]++
+			}
+			continue
+		}
+		remaining = append(remaining, [2]int{home, away})
+	}
+
+	homeWinProb := make([]float64, len(remaining))
+	gamesLeft := make([]int, n)
+	for k, game := range remaining {
+		home, away := teams[game[0]], teams[game[1]]
+		homeWinProb[k] = log5(regressedWinPct(home), regressedWinPct(away)) + homeCourtEdge
+		gamesLeft[game[0]]++
+		gamesLeft[game[1]]++
+	}
+
+	conferences := map[string][]int{}
+	for i, team := range teams {
+		conferences[team.Conference] = append(conferences[team.Conference], i)
+	}
+
+	seedCounts := make([][]int, n)
+	seedTotals := make([]int, n)
+	winTotals := make([]int, n)
+	for i := range seedCounts {
+		seedCounts[i] = make([]int, n+1)
+	}
+
+	wins := make([]int, n)
+	h2h := make([]int, n*n)
+	confWins := make([]int, n)
+	confLosses := make([]int, n)
+	coinFlips := make([]float64, n)
+
+	for it := 0; it < iterations; it++ {
+		for i, team := range teams {
+			wins[i] = team.Wins
+		}
+		copy(h2h, baseH2H)
+		copy(confWins, baseConfWins)
+		copy(confLosses, baseConfLosses)
+
+		for k, game := range remaining {
+			winner, loser := game[0], game[1]
+			if rng.Float64() >= homeWinProb[k] {
+				winner, loser = loser, winner
+			}
+			wins[winner]++
+			h2h[winner*n+loser]++
+			if teams[winner].Conference == teams[loser].Conference {
+				confWins[winner]++
+				confLosses[loser]++
+			}
+		}
+
+		for i := range coinFlips {
+			coinFlips[i] = rng.Float64()
+		}
+
+		for _, members := range conferences {
+			order := rankConference(members, n, wins, h2h, confWins, confLosses, coinFlips)
+			for seed, i := range order {
+				seedCounts[i][seed+1]++
+				seedTotals[i] += seed + 1
+			}
+		}
+
+		for i := range wins {
+			winTotals[i] += wins[i]
+		}
+	}
+
+	odds := make([]types.PlayoffOdds, n)
+	for i, team := range teams {
+		totalGames := team.Wins + team.Losses + gamesLeft[i]
+		projectedWins := float64(winTotals[i]) / float64(iterations)
+
+		var topSix, playIn, miss int
+		for seed, count := range seedCounts[i] {
+			switch {
+			case seed == 0:
+				continue
+			case seed <= 6:
+				topSix += count
+			case seed <= 10:
+				playIn += count
+			default:
+				miss += count
+			}
+		}
+
+		odds[i] = types.PlayoffOdds{
+			TeamID:          team.TeamID,
+			TeamName:        team.TeamName,
+			Conference:      team.Conference,
+			Wins:            team.Wins,
+			Losses:          team.Losses,
+			GamesLeft:       gamesLeft[i],
+			ProjectedWins:   projectedWins,
+			ProjectedLosses: float64(totalGames) - projectedWins,
+			CurrentSeed:     team.PlayoffRank,
+			TopSixPct:       float64(topSix) / float64(iterations),
+			PlayInPct:       float64(playIn) / float64(iterations),
+			MissPct:         float64(miss) / float64(iterations),
+		}
+	}
+
+	// The projected seeding orders teams by their average simulated seed, which always yields a valid 1-N seeding
+	for _, members := range conferences {
+		sorted := make([]int, len(members))
+		copy(sorted, members)
+		sort.SliceStable(sorted, func(a, b int) bool {
+			if seedTotals[sorted[a]] != seedTotals[sorted[b]] {
+				return seedTotals[sorted[a]] < seedTotals[sorted[b]]
+			}
+			return winTotals[sorted[a]] > winTotals[sorted[b]]
+		})
+		for seed, i := range sorted {
+			odds[i].ProjectedSeed = seed + 1
+		}
+	}
+
+	sort.SliceStable(odds, func(a, b int) bool {
+		if odds[a].Conference != odds[b].Conference {
+			return odds[a].Conference < odds[b].Conference
+		}
+		return odds[a].ProjectedSeed < odds[b].ProjectedSeed
+	})
+
+	return odds
+}
+
+// PlayoffOddsHeaders returns the table headers matching the fields of types.PlayoffOdds
+func PlayoffOddsHeaders() []string {
+	return structJSONHeaders(types.PlayoffOdds{})
+}
+
+// ApplyProjectedSeeding returns a copy of the standings in which PlayoffSeeding is replaced by the projected seed,
+// so the result can be fed into ProjectedBracketFromStandings
+func ApplyProjectedSeeding(teams types.Teams, odds []types.PlayoffOdds) types.Teams {
+	projected := make(map[int]int, len(odds))
+	for _, o := range odds {
+		projected[o.TeamID] = o.ProjectedSeed
+	}
+
+	seeded := make(types.Teams, len(teams))
+	copy(seeded, teams)
+	for i := range seeded {
+		if seed, ok := projected[seeded[i].TeamID]; ok {
+			seeded[i].PlayoffSeeding = seed
+		}
+	}
+	return seeded
+}
+
+// rankConference orders the teams of a conference by wins and applies the tiebreakers to groups of tied teams
+func rankConference(members []int, n int, wins, h2h, confWins, confLosses []int, coinFlips []float64) []int {
+	order := make([]int, len(members))
+	copy(order, members)
+	sort.SliceStable(order, func(a, b int) bool { return wins[order[a]] > wins[order[b]] })
+
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && wins[order[end]] == wins[order[start]] {
+			end++
+		}
+		if end-start > 1 {
+			breakTie(order[start:end], n, h2h, confWins, confLosses, coinFlips)
+		}
+		start = end
+	}
+	return order
+}
+
+// breakTie sorts a group of teams with the same number of wins in place. The criteria, in order, are:
+// win percentage in games between the tied teams, conference win percentage and a random draw.
+func breakTie(tied []int, n int, h2h, confWins, confLosses []int, coinFlips []float64) {
+	groupPct := make(map[int]float64, len(tied))
+	for _, i := range tied {
+		var won, lost int
+		for _, j := range tied {
+			if i == j {
+				continue
+			}
+			won += h2h[i*n+j]
+			lost += h2h[j*n+i]
+		}
+		groupPct[i] = winPct(won, lost)
+	}
+
+	sort.SliceStable(tied, func(a, b int) bool {
+		i, j := tied[a], tied[b]
+		if groupPct[i] != groupPct[j] {
+			return groupPct[i] > groupPct[j]
+		}
+		confI, confJ := winPct(confWins[i], confLosses[i]), winPct(confWins[j], confLosses[j])
+		if confI != confJ {
+			return confI > confJ
+		}
+		return coinFlips[i] > coinFlips[j]
+	})
+}
+
+// regressedWinPct is the win percentage of a team with one extra win and loss added, which keeps
+// teams with perfect (or winless) records early in the season from having a 100% (0%) win probability
+func regressedWinPct(team types.Team) float64 {
+	return float64(team.Wins+1) / float64(team.Wins+team.Losses+2)
+}
+
+// log5 returns the probability of a team with win percentage a beating a team with win percentage b
+func log5(a, b float64) float64 {
+	denominator := a + b - 2*a*b
+	if denominator == 0 {
+		return 0.5
+	}
+	return (a - a*b) / denominator
+}
+
+func winPct(wins, losses int) float64 {
+	if wins+losses == 0 {
+		return 0.5
+	}
+	return float64(wins) / float64(wins+losses)
+}
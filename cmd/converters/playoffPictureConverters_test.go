@@ -0,0 +1,108 @@
+package converters
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func makeConference(conf string, firstID int, wins []int) types.Teams {
+	var teams types.Teams
+	for i, w := range wins {
+		teams = append(teams, types.Team{
+			TeamID:     firstID + i,
+			TeamName:   conf + string(rune('A'+i)),
+			Conference: conf,
+			Wins:       w,
+			Losses:     82 - w,
+		})
+	}
+	return teams
+}
+
+func TestSimulatePlayoffPicture_CompletedSeasonIsDeterministic(t *testing.T) {
+	wins := []int{60, 58, 55, 50, 48, 47, 45, 44, 43, 42, 40, 35, 30, 25, 20}
+	teams := makeConference("East", 1, wins)
+
+	odds := SimulatePlayoffPicture(teams, nil, 100, rand.New(rand.NewSource(1)))
+	if len(odds) != 15 {
+		t.Fatalf("expected 15 odds rows, got %d", len(odds))
+	}
+
+	for i, o := range odds {
+		if o.ProjectedSeed != i+1 {
+			t.Errorf("team %s: projected seed %d, want %d", o.TeamName, o.ProjectedSeed, i+1)
+		}
+		if o.ProjectedWins != float64(wins[i]) {
+			t.Errorf("team %s: projected wins %v, want %d", o.TeamName, o.ProjectedWins, wins[i])
+		}
+		switch {
+		case i < 6 && o.TopSixPct != 1:
+			t.Errorf("seed %d: TopSixPct = %v, want 1", i+1, o.TopSixPct)
+		case i >= 6 && i < 10 && o.PlayInPct != 1:
+			t.Errorf("seed %d: PlayInPct = %v, want 1", i+1, o.PlayInPct)
+		case i >= 10 && o.MissPct != 1:
+			t.Errorf("seed %d: MissPct = %v, want 1", i+1, o.MissPct)
+		}
+	}
+}
+
+func TestSimulatePlayoffPicture_HeadToHeadBreaksTie(t *testing.T) {
+	teams := makeConference("West", 10, []int{50, 50})
+	schedule := []types.ScheduledGame{
+		{GameID: "0022400001", GameStatus: 3, HomeTeamID: 10, AwayTeamID: 11, HomeScore: 99, AwayScore: 101},
+		{GameID: "0022400002", GameStatus: 3, HomeTeamID: 11, AwayTeamID: 10, HomeScore: 110, AwayScore: 100},
+	}
+
+	odds := SimulatePlayoffPicture(teams, schedule, 50, rand.New(rand.NewSource(1)))
+	if odds[0].TeamID != 11 || odds[0].ProjectedSeed != 1 {
+		t.Errorf("expected team 11 (2-0 head to head) to be seeded first, got team %d", odds[0].TeamID)
+	}
+}
+
+func TestSimulatePlayoffPicture_RemainingGames(t *testing.T) {
+	teams := makeConference("East", 1, []int{40, 30})
+	teams[0].Losses, teams[1].Losses = 30, 40
+	schedule := []types.ScheduledGame{
+		{GameID: "0022400100", GameStatus: 1, HomeTeamID: 1, AwayTeamID: 2},
+		{GameID: "0022400101", GameStatus: 1, HomeTeamID: 2, AwayTeamID: 1},
+		{GameID: "0042400101", GameStatus: 1, HomeTeamID: 2, AwayTeamID: 1}, // playoffs, ignored
+	}
+
+	odds := SimulatePlayoffPicture(teams, schedule, 1000, rand.New(rand.NewSource(7)))
+	for _, o := range odds {
+		if o.GamesLeft != 2 {
+			t.Errorf("team %d: GamesLeft = %d, want 2", o.TeamID, o.GamesLeft)
+		}
+		if total := o.ProjectedWins + o.ProjectedLosses; total < 71.99 || total > 72.01 {
+			t.Errorf("team %d: projected record adds up to %v games, want 72", o.TeamID, total)
+		}
+	}
+	if odds[0].TeamID != 1 {
+		t.Errorf("expected the 40 win team to be projected first, got %d", odds[0].TeamID)
+	}
+}
+
+func TestApplyProjectedSeeding(t *testing.T) {
+	teams := makeConference("East", 1, []int{50, 40})
+	teams[0].PlayoffSeeding, teams[1].PlayoffSeeding = 1, 2
+	odds := []types.PlayoffOdds{{TeamID: 1, ProjectedSeed: 2}, {TeamID: 2, ProjectedSeed: 1}}
+
+	seeded := ApplyProjectedSeeding(teams, odds)
+	if seeded[0].PlayoffSeeding != 2 || seeded[1].PlayoffSeeding != 1 {
+		t.Errorf("expected swapped seeding, got %d and %d", seeded[0].PlayoffSeeding, seeded[1].PlayoffSeeding)
+	}
+	if teams[0].PlayoffSeeding != 1 {
+		t.Error("ApplyProjectedSeeding() must not modify the input standings")
+	}
+}
+
+func TestLog5(t *testing.T) {
+	if got := log5(0.5, 0.5); got != 0.5 {
+		t.Errorf("log5(0.5, 0.5) = %v, want 0.5", got)
+	}
+	if got := log5(0.75, 0.25); got < 0.89 || got > 0.91 {
+		t.Errorf("log5(0.75, 0.25) = %v, want 0.9", got)
+	}
+}
@@ -0,0 +1,207 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// ShotZones lists the basic court zones of the shotchartdetail endpoint, from the basket outwards
+var ShotZones = []string{
+	"Restricted Area",
+	"In The Paint (Non-RA)",
+	"Mid-Range",
+	"Left Corner 3",
+	"Right Corner 3",
+	"Above the Break 3",
+	"Backcourt",
+}
+
+// PopulateShotChart converts a shotchartdetail API response into the list of shots and their per zone breakdown.
+// The league averages come from the LeagueAverages result set, which is broken down by zone area and range,
+// so they are summed up per basic zone.
+func PopulateShotChart(rs types.ResponseSet) (types.ShotChart, error) {
+	var shotSet, leagueSet *types.ResultSet
+	for i := range rs.ResultSets {
+		switch rs.ResultSets[i].Name {
+		case "Shot_Chart_Detail":
+			shotSet = &rs.ResultSets[i]
+		case "LeagueAverages":
+			leagueSet = &rs.ResultSets[i]
+		}
+	}
+	if shotSet == nil {
+		return types.ShotChart{}, fmt.Errorf("Shot_Chart_Detail result set not found")
+	}
+
+	var chart types.ShotChart
+	for _, row := range shotSet.RowSet {
+		if len(row) != len(shotSet.Headers) {
+			return types.ShotChart{}, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(shotSet.Headers))
+		}
+
+		data := make(map[string]interface{})
+		for i, value := range row {
+			data[shotSet.Headers[i]] = value
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return types.ShotChart{}, fmt.Errorf("failed to marshal shot: %v", err)
+		}
+
+		var shot types.Shot
+		if err = json.Unmarshal(jsonData, &shot); err != nil {
+			return types.ShotChart{}, fmt.Errorf("failed to unmarshal shot: %v", err)
+		}
+		chart.Shots = append(chart.Shots, shot)
+	}
+
+	chart.Zones = shotZoneStats(chart.Shots, leagueZoneAverages(leagueSet))
+	return chart, nil
+}
+
+// shotZoneStats sums up the shots per basic zone, in the order of ShotZones. Zones without attempts are left out.
+func shotZoneStats(shots []types.Shot, league map[string]float64) []types.ShotZoneStats {
+	made := make(map[string]int)
+	attempted := make(map[string]int)
+	for _, shot := range shots {
+		attempted[shot.ZoneBasic]++
+		if shot.Made() {
+			made[shot.ZoneBasic]++
+		}
+	}
+
+	var zones []types.ShotZoneStats
+	for _, zone := range ShotZones {
+		fga := attempted[zone]
+		if fga == 0 {
+			continue
+		}
+		zones = append(zones, types.ShotZoneStats{
+			Zone:        zone,
+			FGM:         made[zone],
+			FGA:         fga,
+			FGPct:       float64(made[zone]) / float64(fga),
+			LeagueFGPct: league[zone],
+			Frequency:   float64(fga) / float64(len(shots)),
+		})
+	}
+	return zones
+}
+
+// leagueZoneAverages returns the league FG% per basic zone
+func leagueZoneAverages(set *types.ResultSet) map[string]float64 {
+	averages := make(map[string]float64)
+	if set == nil {
+		return averages
+	}
+
+	headerIdx := make(map[string]int, len(set.Headers))
+	for i, h := range set.Headers {
+		headerIdx[h] = i
+	}
+	zoneIdx, hasZone := headerIdx["SHOT_ZONE_BASIC"]
+	fgmIdx, hasFGM := headerIdx["FGM"]
+	fgaIdx, hasFGA := headerIdx["FGA"]
+	if !hasZone || !hasFGM || !hasFGA {
+		return averages
+	}
+
+	made := make(map[string]float64)
+	attempted := make(map[string]float64)
+	for _, row := range set.RowSet {
+		zone, _ := row[zoneIdx].(string)
+		fgm, _ := row[fgmIdx].(float64)
+		fga, _ := row[fgaIdx].(float64)
+		made[zone] += fgm
+		attempted[zone] += fga
+	}
+	for zone, fga := range attempted {
+		if fga > 0 {
+			averages[zone] = made[zone] / fga
+		}
+	}
+	return averages
+}
+
+// ShotZoneHeaders returns the table headers matching the fields of types.ShotZoneStats
+func ShotZoneHeaders() []string {
+	return structJSONHeaders(types.ShotZoneStats{})
+}
+
+// PopulateTeamShotZones aggregates the leaguedashteamshotlocations response into the per zone shooting of a team.
+// The league average of every zone is the shooting of all teams in the response combined. "Corner 3" sums up both
+// corners, so it is left out to keep the frequencies adding up to 100%.
+func PopulateTeamShotZones(locations types.ShotLocations, teamID string) ([]types.ShotZoneStats, error) {
+	headers := locations.ResultSets.Headers
+	if len(headers) < 2 {
+		return nil, fmt.Errorf("shot locations response has %d header rows, want 2", len(headers))
+	}
+	zoneHeader, columns := headers[0], headers[1].ColumnNames
+	if zoneHeader.ColumnSpan < 2 {
+		return nil, fmt.Errorf("unexpected shot zone column span %d", zoneHeader.ColumnSpan)
+	}
+
+	teamIdx := -1
+	for i, name := range columns {
+		if name == "TEAM_ID" {
+			teamIdx = i
+			break
+		}
+	}
+	if teamIdx < 0 {
+		return nil, fmt.Errorf("missing required column: TEAM_ID")
+	}
+
+	type zoneTotals struct{ fgm, fga float64 }
+	team := make(map[string]zoneTotals)
+	league := make(map[string]zoneTotals)
+	found := false
+	for _, row := range locations.ResultSets.RowSet {
+		isTeam := fmt.Sprintf("%d", rowInt64(row[teamIdx])) == teamID
+		found = found || isTeam
+		for i, zone := range zoneHeader.ColumnNames {
+			base := zoneHeader.ColumnsToSkip + i*zoneHeader.ColumnSpan
+			if base+1 >= len(row) {
+				break
+			}
+			fgm, _ := row[base].(float64)
+			fga, _ := row[base+1].(float64)
+			lg := league[zone]
+			league[zone] = zoneTotals{lg.fgm + fgm, lg.fga + fga}
+			if isTeam {
+				team[zone] = zoneTotals{fgm, fga}
+			}
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("team %s not found in shot locations", teamID)
+	}
+
+	var totalFGA float64
+	for _, zone := range ShotZones {
+		totalFGA += team[zone].fga
+	}
+
+	var zones []types.ShotZoneStats
+	for _, zone := range ShotZones {
+		t := team[zone]
+		if t.fga == 0 {
+			continue
+		}
+		stats := types.ShotZoneStats{
+			Zone:      zone,
+			FGM:       int(t.fgm),
+			FGA:       int(t.fga),
+			FGPct:     t.fgm / t.fga,
+			Frequency: t.fga / totalFGA,
+		}
+		if lg := league[zone]; lg.fga > 0 {
+			stats.LeagueFGPct = lg.fgm / lg.fga
+		}
+		zones = append(zones, stats)
+	}
+	return zones, nil
+}
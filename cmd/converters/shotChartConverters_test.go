@@ -0,0 +1,123 @@
+package converters
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func shotChartResponse() types.ResponseSet {
+	return types.ResponseSet{
+		ResultSets: []types.ResultSet{
+			{
+				Name: "Shot_Chart_Detail",
+				Headers: []string{"GRID_TYPE", "GAME_ID", "GAME_DATE", "PERIOD", "ACTION_TYPE", "SHOT_TYPE",
+					"SHOT_ZONE_BASIC", "SHOT_ZONE_AREA", "SHOT_ZONE_RANGE", "SHOT_DISTANCE", "LOC_X", "LOC_Y",
+					"SHOT_ATTEMPTED_FLAG", "SHOT_MADE_FLAG"},
+				RowSet: [][]interface{}{
+					{"Shot Chart Detail", "0022400100", "20241105", 1.0, "Layup Shot", "2PT Field Goal", "Restricted Area", "Center(C)", "Less Than 8 ft.", 1.0, 5.0, 10.0, 1.0, 1.0},
+					{"Shot Chart Detail", "0022400100", "20241105", 1.0, "Dunk Shot", "2PT Field Goal", "Restricted Area", "Center(C)", "Less Than 8 ft.", 0.0, 0.0, 2.0, 1.0, 1.0},
+					{"Shot Chart Detail", "0022400100", "20241105", 2.0, "Jump Shot", "3PT Field Goal", "Left Corner 3", "Left Side(L)", "24+ ft.", 22.0, -225.0, 30.0, 1.0, 0.0},
+					{"Shot Chart Detail", "0022400100", "20241105", 3.0, "Pullup Jump shot", "2PT Field Goal", "Mid-Range", "Right Side Center(RC)", "16-24 ft.", 18.0, 120.0, 140.0, 1.0, 0.0},
+				},
+			},
+			{
+				Name:    "LeagueAverages",
+				Headers: []string{"GRID_TYPE", "SHOT_ZONE_BASIC", "SHOT_ZONE_AREA", "SHOT_ZONE_RANGE", "FGA", "FGM", "FG_PCT"},
+				RowSet: [][]interface{}{
+					{"League Averages", "Restricted Area", "Center(C)", "Less Than 8 ft.", 100.0, 65.0, 0.65},
+					{"League Averages", "Mid-Range", "Left Side(L)", "8-16 ft.", 100.0, 40.0, 0.40},
+					{"League Averages", "Mid-Range", "Right Side(R)", "16-24 ft.", 100.0, 42.0, 0.42},
+				},
+			},
+		},
+	}
+}
+
+func TestPopulateShotChart(t *testing.T) {
+	chart, err := PopulateShotChart(shotChartResponse())
+	if err != nil {
+		t.Fatalf("PopulateShotChart() error: %v", err)
+	}
+
+	if len(chart.Shots) != 4 {
+		t.Fatalf("expected 4 shots, got %d", len(chart.Shots))
+	}
+	if shot := chart.Shots[2]; shot.LocX != -225 || shot.LocY != 30 || shot.Made() {
+		t.Errorf("unexpected corner three: %+v", shot)
+	}
+
+	wantZones := []string{"Restricted Area", "Mid-Range", "Left Corner 3"}
+	if len(chart.Zones) != len(wantZones) {
+		t.Fatalf("expected %d zones, got %d", len(wantZones), len(chart.Zones))
+	}
+	for i, zone := range wantZones {
+		if chart.Zones[i].Zone != zone {
+			t.Errorf("zone %d = %s, want %s", i, chart.Zones[i].Zone, zone)
+		}
+	}
+
+	ra, _ := chart.Zone("Restricted Area")
+	if ra.FGM != 2 || ra.FGA != 2 || ra.FGPct != 1 || ra.Frequency != 0.5 {
+		t.Errorf("unexpected restricted area stats: %+v", ra)
+	}
+	mid, _ := chart.Zone("Mid-Range")
+	if math.Abs(mid.LeagueFGPct-0.41) > 1e-9 {
+		t.Errorf("mid-range league FG%% = %f, want 0.41", mid.LeagueFGPct)
+	}
+}
+
+func TestPopulateShotChart_MissingResultSet(t *testing.T) {
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{{Name: "LeagueAverages"}}}
+	if _, err := PopulateShotChart(rs); err == nil {
+		t.Error("expected error for missing Shot_Chart_Detail result set")
+	}
+}
+
+func teamShotLocations() types.ShotLocations {
+	var loc types.ShotLocations
+	loc.ResultSets.Name = "ShotLocations"
+	loc.ResultSets.Headers = []types.ShotLocationsHeader{
+		{Name: "SHOT_CATEGORY", ColumnsToSkip: 2, ColumnSpan: 3,
+			ColumnNames: []string{"Restricted Area", "Mid-Range", "Corner 3"}},
+		{Name: "columns", ColumnSpan: 1,
+			ColumnNames: []string{"TEAM_ID", "TEAM_NAME", "FGM", "FGA", "FG_PCT", "FGM", "FGA", "FG_PCT", "FGM", "FGA", "FG_PCT"}},
+	}
+	loc.ResultSets.RowSet = [][]interface{}{
+		{1610612748.0, "Miami Heat", 60.0, 100.0, 0.6, 20.0, 50.0, 0.4, 15.0, 40.0, 0.375},
+		{1610612738.0, "Boston Celtics", 70.0, 100.0, 0.7, 30.0, 50.0, 0.6, 20.0, 50.0, 0.4},
+	}
+	return loc
+}
+
+func TestPopulateTeamShotZones(t *testing.T) {
+	zones, err := PopulateTeamShotZones(teamShotLocations(), "1610612748")
+	if err != nil {
+		t.Fatalf("PopulateTeamShotZones() error: %v", err)
+	}
+	// Corner 3 double counts the corners and is skipped
+	if len(zones) != 2 {
+		t.Fatalf("expected 2 zones, got %d: %+v", len(zones), zones)
+	}
+
+	ra := zones[0]
+	if ra.Zone != "Restricted Area" || ra.FGM != 60 || ra.FGA != 100 {
+		t.Errorf("unexpected restricted area: %+v", ra)
+	}
+	if math.Abs(ra.LeagueFGPct-0.65) > 1e-9 {
+		t.Errorf("restricted area league FG%% = %f, want 0.65", ra.LeagueFGPct)
+	}
+	if math.Abs(ra.Frequency-100.0/150.0) > 1e-9 {
+		t.Errorf("restricted area frequency = %f, want %f", ra.Frequency, 100.0/150.0)
+	}
+	if mid := zones[1]; math.Abs(mid.FGPct-0.4) > 1e-9 || math.Abs(mid.LeagueFGPct-0.5) > 1e-9 {
+		t.Errorf("unexpected mid-range: %+v", mid)
+	}
+}
+
+func TestPopulateTeamShotZones_UnknownTeam(t *testing.T) {
+	if _, err := PopulateTeamShotZones(teamShotLocations(), "1"); err == nil {
+		t.Error("expected error for a team missing from the response")
+	}
+}
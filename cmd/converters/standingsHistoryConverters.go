@@ -0,0 +1,127 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// PopulateStandingsHistory replays the leaguegamelog team results date by date into the cumulative record and
+// conference rank of every team. Ranks are by win%, then wins; the tiebreakers of the league are not applied.
+func PopulateStandingsHistory(rs types.ResponseSet) ([]types.TeamStandingsHistory, error) {
+	if len(rs.ResultSets) == 0 {
+		return nil, fmt.Errorf("no game log data found")
+	}
+	set := rs.ResultSets[0]
+
+	var results []types.TeamGameResult
+	for _, row := range set.RowSet {
+		if len(row) != len(set.Headers) {
+			return nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+		}
+
+		data := make(map[string]interface{})
+		for i, value := range row {
+			data[set.Headers[i]] = value
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal game result: %v", err)
+		}
+
+		var result types.TeamGameResult
+		if err = json.Unmarshal(jsonData, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal game result: %v", err)
+		}
+		results = append(results, result)
+	}
+
+	byDate := make(map[string][]types.TeamGameResult)
+	teams := make(map[int]*types.TeamStandingsHistory)
+	var teamIDs []int
+	for _, r := range results {
+		byDate[r.GameDate] = append(byDate[r.GameDate], r)
+		if _, ok := teams[r.TeamID]; !ok {
+			teams[r.TeamID] = &types.TeamStandingsHistory{
+				TeamID:     r.TeamID,
+				Tricode:    r.TeamAbbreviation,
+				Nickname:   teamNickname(r.TeamName),
+				Conference: nbaTeamConferences[r.TeamID],
+			}
+			teamIDs = append(teamIDs, r.TeamID)
+		}
+	}
+	sort.Ints(teamIDs)
+
+	dates := make([]string, 0, len(byDate))
+	for date := range byDate {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	records := make(map[int]types.StandingsPoint, len(teams))
+	for _, date := range dates {
+		for _, r := range byDate[date] {
+			rec := records[r.TeamID]
+			switch r.WL {
+			case "W":
+				rec.Wins++
+			case "L":
+				rec.Losses++
+			}
+			records[r.TeamID] = rec
+		}
+
+		ranks := conferenceRanks(teamIDs, records, teams)
+		for _, id := range teamIDs {
+			point := records[id]
+			point.Date = date
+			point.ConfRank = ranks[id]
+			teams[id].Points = append(teams[id].Points, point)
+		}
+	}
+
+	histories := make([]types.TeamStandingsHistory, 0, len(teamIDs))
+	for _, id := range teamIDs {
+		histories = append(histories, *teams[id])
+	}
+	return histories, nil
+}
+
+// conferenceRanks ranks the teams within their conference by win%, then wins, then team ID to keep the order stable
+func conferenceRanks(teamIDs []int, records map[int]types.StandingsPoint,
+	teams map[int]*types.TeamStandingsHistory) map[int]int {
+	ordered := append([]int(nil), teamIDs...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := records[ordered[i]], records[ordered[j]]
+		if a.WinPct() != b.WinPct() {
+			return a.WinPct() > b.WinPct()
+		}
+		return a.Wins > b.Wins
+	})
+
+	ranks := make(map[int]int, len(ordered))
+	next := make(map[string]int)
+	for _, id := range ordered {
+		conf := teams[id].Conference
+		next[conf]++
+		ranks[id] = next[conf]
+	}
+	return ranks
+}
+
+// teamNickname strips the city from a full team name, e.g. "Portland Trail Blazers" becomes "Trail Blazers"
+func teamNickname(fullName string) string {
+	words := strings.Fields(fullName)
+	switch {
+	case len(words) == 0:
+		return ""
+	case len(words) > 2 && words[len(words)-2] == "Trail":
+		return strings.Join(words[len(words)-2:], " ")
+	}
+	return words[len(words)-1]
+}
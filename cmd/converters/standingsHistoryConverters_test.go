@@ -0,0 +1,59 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestPopulateStandingsHistory(t *testing.T) {
+	rs := types.ResponseSet{
+		ResultSets: []types.ResultSet{
+			{
+				Name:    "LeagueGameLog",
+				Headers: []string{"TEAM_ID", "TEAM_ABBREVIATION", "TEAM_NAME", "GAME_ID", "GAME_DATE", "WL"},
+				RowSet: [][]interface{}{
+					{1610612738.0, "BOS", "Boston Celtics", "0022400001", "2024-10-22", "W"},
+					{1610612752.0, "NYK", "New York Knicks", "0022400001", "2024-10-22", "L"},
+					{1610612757.0, "POR", "Portland Trail Blazers", "0022400002", "2024-10-23", "W"},
+					{1610612752.0, "NYK", "New York Knicks", "0022400003", "2024-10-24", "W"},
+					{1610612738.0, "BOS", "Boston Celtics", "0022400004", "2024-10-24", "L"},
+				},
+			},
+		},
+	}
+
+	histories, err := PopulateStandingsHistory(rs)
+	if err != nil {
+		t.Fatalf("PopulateStandingsHistory() error: %v", err)
+	}
+	if len(histories) != 3 {
+		t.Fatalf("expected 3 teams, got %d", len(histories))
+	}
+
+	bos, nyk, por := histories[0], histories[1], histories[2]
+	if bos.Tricode != "BOS" || bos.Conference != "East" || por.Nickname != "Trail Blazers" {
+		t.Errorf("unexpected team data: %+v %+v", bos, por)
+	}
+	for _, h := range histories {
+		if len(h.Points) != 3 {
+			t.Errorf("%s has %d points, want one per game date", h.Tricode, len(h.Points))
+		}
+	}
+
+	if p := bos.Points[0]; p.Wins != 1 || p.ConfRank != 1 || nyk.Points[0].ConfRank != 2 {
+		t.Errorf("after day 1: BOS %+v, NYK rank %d", p, nyk.Points[0].ConfRank)
+	}
+	if p := por.Points[1]; p.Wins != 1 || p.ConfRank != 1 {
+		t.Errorf("POR should lead the West after its win: %+v", p)
+	}
+	if p := nyk.Latest(); p.Wins != 1 || p.Losses != 1 || p.Date != "2024-10-24" {
+		t.Errorf("NYK latest = %+v, want 1-1 on 2024-10-24", p)
+	}
+}
+
+func TestPopulateStandingsHistory_Empty(t *testing.T) {
+	if _, err := PopulateStandingsHistory(types.ResponseSet{}); err == nil {
+		t.Error("expected error for empty ResultSets")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+	"strconv"
+	"strings"
 )
 
 // PopulateTeamInfo maps the team info request result to the appropriate
@@ -75,3 +77,108 @@ func PopulateTeamStats(rs types.ResponseSet) (types.Teams, []string, error) {
 
 	return teams, headers, nil
 }
+
+// PopulateTeamSplits groups the split records of a team (which are hidden in the standings tables) into
+// tables of related splits. Splits without any games played (e.g. months outside the season) are omitted.
+func PopulateTeamSplits(team types.Team) ([]types.TeamSplitGroup, []string) {
+	groups := []types.TeamSplitGroup{
+		{Name: "VS CONFERENCE", Splits: buildSplits([][2]string{
+			{"vs. East", team.VsEast},
+			{"vs. West", team.VsWest},
+		})},
+		{Name: "VS DIVISION", Splits: buildSplits([][2]string{
+			{"vs. Atlantic", team.VsAtlantic},
+			{"vs. Central", team.VsCentral},
+			{"vs. Southeast", team.VsSoutheast},
+			{"vs. Northwest", team.VsNorthwest},
+			{"vs. Pacific", team.VsPacific},
+			{"vs. Southwest", team.VsSouthwest},
+		})},
+		{Name: "BY MONTH", Splits: buildSplits([][2]string{
+			{"October", team.Oct},
+			{"November", team.Nov},
+			{"December", team.Dec},
+			{"January", team.Jan},
+			{"February", team.Feb},
+			{"March", team.Mar},
+			{"April", team.Apr},
+			{"May", team.May},
+			{"June", team.Jun},
+			{"July", team.Jul},
+			{"August", team.Aug},
+			{"September", team.Sep},
+		})},
+		{Name: "CLOSE GAMES", Splits: buildSplits([][2]string{
+			{"3 points or less", team.ThreePTSOrLess},
+			{"10 points or more", team.TenPTSOrMore},
+			{"Overtime", team.OT},
+		})},
+		{Name: "GAME FLOW", Splits: buildSplits([][2]string{
+			{"Ahead at half", team.AheadAtHalf},
+			{"Behind at half", team.BehindAtHalf},
+			{"Tied at half", team.TiedAtHalf},
+			{"Ahead after 3Q", team.AheadAtThird},
+			{"Behind after 3Q", team.BehindAtThird},
+			{"Tied after 3Q", team.TiedAtThird},
+		})},
+		{Name: "SCORING", Splits: buildSplits([][2]string{
+			{"Scored 100+", team.Score100PTS},
+			{"Opp. scored 100+", team.OppScore100PTS},
+			{"Scored 80+", team.Score80Plus},
+			{"Opp. scored 80+", team.OppScore80Plus},
+			{"Scored below 80", team.ScoreBelow80},
+			{"Opp. scored below 80", team.OppScoreBelow80},
+		})},
+		{Name: "OPPONENT & STATS", Splits: buildSplits([][2]string{
+			{"vs. teams over .500", team.OppOver500},
+			{"Higher FG%", team.LeadInFGPCT},
+			{"More rebounds", team.LeadInReb},
+			{"Fewer turnovers", team.FewerTurnovers},
+		})},
+	}
+
+	var populated []types.TeamSplitGroup
+	for _, group := range groups {
+		if len(group.Splits) > 0 {
+			populated = append(populated, group)
+		}
+	}
+
+	return populated, structJSONHeaders(types.TeamSplit{})
+}
+
+// buildSplits converts (label, "W-L") pairs into TeamSplit objects, skipping empty records
+func buildSplits(records [][2]string) []types.TeamSplit {
+	var splits []types.TeamSplit
+	for _, record := range records {
+		wins, losses, ok := parseRecord(record[1])
+		if !ok || wins+losses == 0 {
+			continue
+		}
+		splits = append(splits, types.TeamSplit{
+			Split:  record[0],
+			Record: fmt.Sprintf("%d-%d", wins, losses),
+			Wins:   wins,
+			Losses: losses,
+			WinPct: float64(wins) / float64(wins+losses),
+		})
+	}
+	return splits
+}
+
+// parseRecord parses a "W-L" record string as returned by the standings API (e.g. "12-3", " 0-1")
+func parseRecord(record string) (int, int, bool) {
+	parts := strings.Split(strings.TrimSpace(record), "-")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	wins, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, false
+	}
+	losses, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, false
+	}
+	return wins, losses, true
+}
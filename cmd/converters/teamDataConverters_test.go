@@ -117,3 +117,68 @@ func TestSplitStandingsPerConference_Empty(t *testing.T) {
 		t.Errorf("Expected 0 West teams, got: %d", len(westTeams))
 	}
 }
+
+func TestPopulateTeamSplits(t *testing.T) {
+	team := types.Team{
+		VsEast:         "20-10",
+		VsWest:         "15-7",
+		Jan:            "10-4",
+		Jul:            "",
+		Oct:            " 0-0",
+		ThreePTSOrLess: "5-6",
+		OT:             "2-1",
+	}
+
+	groups, headers := PopulateTeamSplits(team)
+
+	expectedHeaders := []string{"SPLIT", "RECORD", "W", "L", "WIN_PCT"}
+	if !reflect.DeepEqual(headers, expectedHeaders) {
+		t.Errorf("Expected headers %v, got %v", expectedHeaders, headers)
+	}
+
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 populated groups, got %d", len(groups))
+	}
+
+	conf := groups[0]
+	if conf.Name != "VS CONFERENCE" || len(conf.Splits) != 2 {
+		t.Fatalf("Expected VS CONFERENCE with 2 splits, got %s with %d", conf.Name, len(conf.Splits))
+	}
+	if conf.Splits[0].Wins != 20 || conf.Splits[0].Losses != 10 {
+		t.Errorf("Expected vs. East 20-10, got %d-%d", conf.Splits[0].Wins, conf.Splits[0].Losses)
+	}
+
+	months := groups[1]
+	if months.Name != "BY MONTH" || len(months.Splits) != 1 {
+		t.Fatalf("Expected BY MONTH with only January, got %s with %d splits", months.Name, len(months.Splits))
+	}
+	if months.Splits[0].WinPct < 0.71 || months.Splits[0].WinPct > 0.72 {
+		t.Errorf("Expected January win pct ~0.714, got %v", months.Splits[0].WinPct)
+	}
+
+	if groups[2].Name != "CLOSE GAMES" || len(groups[2].Splits) != 2 {
+		t.Errorf("Expected CLOSE GAMES with 2 splits, got %s with %d", groups[2].Name, len(groups[2].Splits))
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	tests := []struct {
+		input  string
+		wins   int
+		losses int
+		ok     bool
+	}{
+		{"12-3", 12, 3, true},
+		{" 0-1", 0, 1, true},
+		{"", 0, 0, false},
+		{"abc", 0, 0, false},
+		{"1-x", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		wins, losses, ok := parseRecord(tt.input)
+		if wins != tt.wins || losses != tt.losses || ok != tt.ok {
+			t.Errorf("parseRecord(%q) = %d, %d, %v; want %d, %d, %v", tt.input, wins, losses, ok, tt.wins, tt.losses, tt.ok)
+		}
+	}
+}
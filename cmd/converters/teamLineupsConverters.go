@@ -0,0 +1,119 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// maxLineups is the number of lineups of each size that are kept, the ones that played the most minutes
+const maxLineups = 25
+
+// PopulateTeamLineups extracts the lineups from the advanced teamdashlineups response,
+// the maxLineups units with the most minutes, in descending order of minutes
+func PopulateTeamLineups(rs types.ResponseSet) ([]types.TeamLineup, []string, error) {
+	set, ok := findResultSet(rs, "Lineups")
+	if !ok {
+		return nil, nil, fmt.Errorf("no lineups found")
+	}
+
+	var lineups []types.TeamLineup
+	for _, row := range set.RowSet {
+		if len(row) != len(set.Headers) {
+			return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+		}
+
+		data := make(map[string]interface{})
+		for i, value := range row {
+			data[set.Headers[i]] = value
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal lineup: %v", err)
+		}
+
+		var lineup types.TeamLineup
+		if err = json.Unmarshal(jsonData, &lineup); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal lineup: %v", err)
+		}
+		lineups = append(lineups, lineup)
+	}
+
+	sort.SliceStable(lineups, func(i, j int) bool { return lineups[i].MIN > lineups[j].MIN })
+	if len(lineups) > maxLineups {
+		lineups = lineups[:maxLineups]
+	}
+	return lineups, structJSONHeaders(types.TeamLineup{}), nil
+}
+
+// PopulatePlayerOnOff joins the on court and off court rows of the teamplayeronoffsummary response per player.
+// Players are ordered by their minutes on the court.
+func PopulatePlayerOnOff(rs types.ResponseSet) ([]types.PlayerOnOff, []string, error) {
+	on, okOn := findResultSet(rs, "PlayersOnCourtTeamPlayerOnOffSummary")
+	off, okOff := findResultSet(rs, "PlayersOffCourtTeamPlayerOnOffSummary")
+	if !okOn || !okOff {
+		return nil, nil, fmt.Errorf("no on/off summary found")
+	}
+
+	type courtStats struct {
+		name           string
+		min, netRating float64
+	}
+	readSet := func(set types.ResultSet) (map[int]courtStats, []int, error) {
+		idx := make(map[string]int, len(set.Headers))
+		for i, h := range set.Headers {
+			idx[h] = i
+		}
+		for _, required := range []string{"VS_PLAYER_ID", "VS_PLAYER_NAME", "MIN", "NET_RATING"} {
+			if _, ok := idx[required]; !ok {
+				return nil, nil, fmt.Errorf("%s: missing column %s", set.Name, required)
+			}
+		}
+
+		stats := make(map[int]courtStats)
+		var order []int
+		for _, row := range set.RowSet {
+			if len(row) != len(set.Headers) {
+				return nil, nil, fmt.Errorf("row length mismatch: %d vs %d", len(row), len(set.Headers))
+			}
+			id := int(rowInt64(row[idx["VS_PLAYER_ID"]]))
+			stats[id] = courtStats{
+				name:      cellString(row[idx["VS_PLAYER_NAME"]]),
+				min:       rowFloat(row[idx["MIN"]]),
+				netRating: rowFloat(row[idx["NET_RATING"]]),
+			}
+			order = append(order, id)
+		}
+		return stats, order, nil
+	}
+
+	onStats, order, err := readSet(on)
+	if err != nil {
+		return nil, nil, err
+	}
+	offStats, _, err := readSet(off)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var players []types.PlayerOnOff
+	for _, id := range order {
+		onCourt, offCourt := onStats[id], offStats[id]
+		players = append(players, types.PlayerOnOff{
+			PlayerID:     id,
+			Player:       onCourt.name,
+			OnMIN:        onCourt.min,
+			OnNetRating:  onCourt.netRating,
+			OffMIN:       offCourt.min,
+			OffNetRating: offCourt.netRating,
+			OnOffDiff:    math.Round((onCourt.netRating-offCourt.netRating)*10) / 10,
+		})
+	}
+
+	sort.SliceStable(players, func(i, j int) bool { return players[i].OnMIN > players[j].OnMIN })
+	return players, structJSONHeaders(types.PlayerOnOff{}), nil
+}
@@ -0,0 +1,76 @@
+package converters
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestPopulateTeamLineups(t *testing.T) {
+	headers := []string{"GROUP_SET", "GROUP_ID", "GROUP_NAME", "GP", "W", "L", "MIN", "OFF_RATING", "DEF_RATING",
+		"NET_RATING", "TS_PCT", "PACE"}
+	rows := [][]interface{}{
+		{"Lineups", "-1-2-", "A. One - B. Two", 10.0, 6.0, 4.0, 120.5, 115.2, 108.1, 7.1, 0.58, 99.0},
+		{"Lineups", "-1-3-", "A. One - C. Three", 40.0, 25.0, 15.0, 480.0, 112.0, 113.5, -1.5, 0.55, 100.2},
+	}
+	for i := 0; i < maxLineups; i++ {
+		rows = append(rows, []interface{}{"Lineups", fmt.Sprintf("-9-%d-", i), "X - Y", 1.0, 0.0, 1.0, 2.0,
+			100.0, 110.0, -10.0, 0.5, 98.0})
+	}
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{
+		{Name: "Overall", Headers: headers[:4], RowSet: [][]interface{}{{"Overall", "", "", 82.0}}},
+		{Name: "Lineups", Headers: headers, RowSet: rows},
+	}}
+
+	lineups, headerNames, err := PopulateTeamLineups(rs)
+	if err != nil {
+		t.Fatalf("PopulateTeamLineups() error: %v", err)
+	}
+	if len(headerNames) == 0 {
+		t.Error("expected non-empty headers")
+	}
+	if len(lineups) != maxLineups {
+		t.Errorf("expected the top %d lineups, got %d", maxLineups, len(lineups))
+	}
+	if lineups[0].Lineup != "A. One - C. Three" || lineups[0].NetRating != -1.5 || lineups[1].GP != 10 {
+		t.Errorf("lineups are not ordered by minutes: %+v, %+v", lineups[0], lineups[1])
+	}
+
+	if _, _, err = PopulateTeamLineups(types.ResponseSet{}); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+}
+
+func TestPopulatePlayerOnOff(t *testing.T) {
+	headers := []string{"GROUP_SET", "TEAM_ID", "VS_PLAYER_ID", "VS_PLAYER_NAME", "COURT_STATUS", "GP", "MIN",
+		"PLUS_MINUS", "OFF_RATING", "DEF_RATING", "NET_RATING"}
+	rs := types.ResponseSet{ResultSets: []types.ResultSet{
+		{Name: "PlayersOnCourtTeamPlayerOnOffSummary", Headers: headers, RowSet: [][]interface{}{
+			{"On", 1.0, 10.0, "Bench, Ben", "On", 60.0, 900.0, -20.0, 108.0, 112.0, -4.0},
+			{"On", 1.0, 20.0, "Star, Sam", "On", 70.0, 2400.0, 200.0, 118.0, 109.0, 9.0},
+		}},
+		{Name: "PlayersOffCourtTeamPlayerOnOffSummary", Headers: headers, RowSet: [][]interface{}{
+			{"Off", 1.0, 20.0, "Star, Sam", "Off", 70.0, 1000.0, -50.0, 106.0, 110.0, -4.0},
+			{"Off", 1.0, 10.0, "Bench, Ben", "Off", 60.0, 2500.0, 150.0, 114.0, 110.0, 4.0},
+		}},
+	}}
+
+	players, _, err := PopulatePlayerOnOff(rs)
+	if err != nil {
+		t.Fatalf("PopulatePlayerOnOff() error: %v", err)
+	}
+	if len(players) != 2 || players[0].Player != "Star, Sam" {
+		t.Fatalf("players are not ordered by minutes on the court: %+v", players)
+	}
+	if players[0].OffMIN != 1000 || players[0].OnOffDiff != 13 {
+		t.Errorf("Star on/off = %+v", players[0])
+	}
+	if players[1].OnOffDiff != -8 {
+		t.Errorf("Bench on/off diff = %v, want -8", players[1].OnOffDiff)
+	}
+
+	if _, _, err = PopulatePlayerOnOff(types.ResponseSet{}); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+}
@@ -0,0 +1,120 @@
+package converters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// PopulateTransactions extracts the transactions of the player movement feed, most recent first
+func PopulateTransactions(resp types.TransactionsResponse) ([]types.Transaction, []string, error) {
+	rows := resp.PlayerMovement.Rows
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("no transactions found")
+	}
+
+	var transactions []types.Transaction
+	for _, row := range rows {
+		date := cellString(row["TRANSACTION_DATE"])
+		if d, ok := parseAwardDate(date); ok {
+			date = d.Format("2006-01-02")
+		}
+		teamID := int(rowInt64(row["TEAM_ID"]))
+		teamName := slugName(cellString(row["TEAM_SLUG"]))
+		if teamName == "Blazers" {
+			teamName = "Trail Blazers"
+		}
+
+		transactions = append(transactions, types.Transaction{
+			Date:        date,
+			Type:        cellString(row["Transaction_Type"]),
+			TeamID:      teamID,
+			Team:        nbaTeamTricodes[teamID],
+			TeamName:    teamName,
+			PlayerID:    int(rowInt64(row["PLAYER_ID"])),
+			Player:      slugName(cellString(row["PLAYER_SLUG"])),
+			Description: cellString(row["TRANSACTION_DESCRIPTION"]),
+		})
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool { return transactions[i].Date > transactions[j].Date })
+	return transactions, structJSONHeaders(types.Transaction{}), nil
+}
+
+// slugName turns a url slug like "trae-young" into a name
+func slugName(slug string) string {
+	words := strings.FieldsFunc(slug, func(r rune) bool { return r == '-' || r == '_' })
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// FilterTransactions returns the transactions of a type by the given teams, an empty type or no teams match all
+func FilterTransactions(transactions []types.Transaction, teams []string, transactionType string) []types.Transaction {
+	var filtered []types.Transaction
+	for _, t := range transactions {
+		if len(teams) > 0 && !containsString(teams, t.Team) {
+			continue
+		}
+		if transactionType != "" && t.Type != transactionType {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+	return filtered
+}
+
+// TransactionTeams returns the teams that made the transactions in alphabetical order
+func TransactionTeams(transactions []types.Transaction) []string {
+	return distinctSorted(transactions, func(t types.Transaction) string { return t.Team })
+}
+
+// TransactionTypes returns the types of the transactions in alphabetical order
+func TransactionTypes(transactions []types.Transaction) []string {
+	return distinctSorted(transactions, func(t types.Transaction) string { return t.Type })
+}
+
+// RecentPlayerMoves returns the latest transaction of every player moved by one of the teams since the given date
+func RecentPlayerMoves(transactions []types.Transaction, teamIDs []int, since time.Time) map[int]types.Transaction {
+	teams := make(map[int]bool, len(teamIDs))
+	for _, id := range teamIDs {
+		teams[id] = true
+	}
+	moves := make(map[int]types.Transaction)
+	cutoff := since.Format("2006-01-02")
+	for _, t := range transactions {
+		if !teams[t.TeamID] || t.PlayerID == 0 || t.Date < cutoff {
+			continue
+		}
+		if prev, ok := moves[t.PlayerID]; !ok || t.Date > prev.Date {
+			moves[t.PlayerID] = t
+		}
+	}
+	return moves
+}
+
+func distinctSorted(transactions []types.Transaction, value func(types.Transaction) string) []string {
+	seen := make(map[string]bool)
+	var values []string
+	for _, t := range transactions {
+		if v := value(t); v != "" && !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
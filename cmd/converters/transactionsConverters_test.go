@@ -0,0 +1,95 @@
+package converters
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+const transactionsJSON = `{"NBA_Player_Movement":{"rows":[
+{"Transaction_Type":"Signing","TRANSACTION_DATE":"2024-07-06T00:00:00","TRANSACTION_DESCRIPTION":"Boston Celtics signed Jayson Tatum to a contract extension.","TEAM_ID":1610612738.0,"TEAM_SLUG":"celtics","PLAYER_ID":1628369.0,"PLAYER_SLUG":"jayson-tatum"},
+{"Transaction_Type":"Trade","TRANSACTION_DATE":"2025-02-02T00:00:00","TRANSACTION_DESCRIPTION":"In a 3-team trade, Dallas traded Luka Doncic to the Los Angeles Lakers.","TEAM_ID":1610612747.0,"TEAM_SLUG":"lakers","PLAYER_ID":1629029.0,"PLAYER_SLUG":"luka-doncic"},
+{"Transaction_Type":"Waive","TRANSACTION_DATE":"2025-01-07T00:00:00","TRANSACTION_DESCRIPTION":"Portland Trail Blazers waived Dalano Banton.","TEAM_ID":1610612757.0,"TEAM_SLUG":"blazers","PLAYER_ID":1630625.0,"PLAYER_SLUG":"dalano-banton"}
+]}}`
+
+func testTransactions(t *testing.T) []types.Transaction {
+	t.Helper()
+	var resp types.TransactionsResponse
+	if err := json.Unmarshal([]byte(transactionsJSON), &resp); err != nil {
+		t.Fatal(err)
+	}
+	transactions, _, err := PopulateTransactions(resp)
+	if err != nil {
+		t.Fatalf("PopulateTransactions() error: %v", err)
+	}
+	return transactions
+}
+
+func TestPopulateTransactions(t *testing.T) {
+	transactions := testTransactions(t)
+	if len(transactions) != 3 {
+		t.Fatalf("expected 3 transactions, got %d", len(transactions))
+	}
+
+	first := transactions[0]
+	if first.Date != "2025-02-02" || first.Type != "Trade" || first.Team != "LAL" || first.Player != "Luka Doncic" ||
+		first.PlayerID != 1629029 || first.TeamID != 1610612747 || first.TeamName != "Lakers" {
+		t.Errorf("unexpected latest transaction %+v", first)
+	}
+	if transactions[1].TeamName != "Trail Blazers" {
+		t.Errorf("expected Trail Blazers, got %s", transactions[1].TeamName)
+	}
+	if transactions[2].Date != "2024-07-06" {
+		t.Errorf("expected the oldest transaction last, got %s", transactions[2].Date)
+	}
+}
+
+func TestPopulateTransactions_Empty(t *testing.T) {
+	if _, _, err := PopulateTransactions(types.TransactionsResponse{}); err == nil {
+		t.Error("expected an error for an empty feed")
+	}
+}
+
+func TestFilterTransactions(t *testing.T) {
+	transactions := testTransactions(t)
+
+	if got := FilterTransactions(transactions, nil, ""); len(got) != 3 {
+		t.Errorf("expected all transactions without filters, got %d", len(got))
+	}
+	if got := FilterTransactions(transactions, []string{"BOS", "POR"}, ""); len(got) != 2 {
+		t.Errorf("expected 2 transactions of BOS and POR, got %d", len(got))
+	}
+	if got := FilterTransactions(transactions, []string{"BOS", "POR"}, "Waive"); len(got) != 1 || got[0].Team != "POR" {
+		t.Errorf("expected the POR waiver, got %+v", got)
+	}
+}
+
+func TestTransactionTeamsAndTypes(t *testing.T) {
+	transactions := testTransactions(t)
+	teams := TransactionTeams(transactions)
+	if len(teams) != 3 || teams[0] != "BOS" || teams[2] != "POR" {
+		t.Errorf("unexpected teams %v", teams)
+	}
+	kinds := TransactionTypes(transactions)
+	if len(kinds) != 3 || kinds[0] != "Signing" {
+		t.Errorf("unexpected types %v", kinds)
+	}
+}
+
+func TestRecentPlayerMoves(t *testing.T) {
+	transactions := testTransactions(t)
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	moves := RecentPlayerMoves(transactions, []int{1610612738, 1610612747}, since)
+	if m, ok := moves[1629029]; !ok || m.Type != "Trade" {
+		t.Errorf("expected the Doncic trade, got %v", moves)
+	}
+	if len(moves) != 1 {
+		t.Errorf("expected only the moves since the cutoff, got %v", moves)
+	}
+	if moves := RecentPlayerMoves(transactions, []int{1610612738}, since); len(moves) != 0 {
+		t.Errorf("expected no moves before the cutoff, got %v", moves)
+	}
+}
@@ -0,0 +1,161 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// DefaultInterval is how often the cache is refreshed
+const DefaultInterval = 10 * time.Minute
+
+// finishedGameStatus is the status of a game which is final
+const finishedGameStatus = 3
+
+// Fetcher fetches the API responses the daemon keeps in the cache, it is implemented by nbaAPI.Client
+type Fetcher interface {
+	MakeDefaultRequests() error
+	FetchBoxScore(gameID string) error
+	FetchPlayerProfile(playerID string) error
+}
+
+// Daemon pre-fetches the scoreboard, standings, leaders, the box scores of finished games and the favorite
+// players' profiles, so the TUI finds them in the cache
+type Daemon struct {
+	fetcher   Fetcher
+	games     func() (types.ResponseSet, error)
+	favorites func() (types.Favorites, error)
+	lockPath  string
+}
+
+// New instantiates a Daemon. games loads the cached scoreboard, favorites the favorite players, and lockPath
+// is the lockfile shared with the TUI.
+func New(fetcher Fetcher, games func() (types.ResponseSet, error), favorites func() (types.Favorites, error),
+	lockPath string) *Daemon {
+	return &Daemon{fetcher: fetcher, games: games, favorites: favorites, lockPath: lockPath}
+}
+
+// NewFromClient instantiates a Daemon fetching with the default client into the cache directory
+func NewFromClient(cl *nbaAPI.Client) *Daemon {
+	store := filesystemops.NewFavoritesStore(cl.FileSystem, cl.Paths)
+	return New(cl, cl.Loader.LoadDailyScoreboard, store.Load, cl.Paths.GetFullPath("cacheLock", ""))
+}
+
+// Refresh fetches everything once. It returns ErrLocked without fetching anything while the TUI fetches its
+// startup requests, as the TUI is writing the same files.
+func (d *Daemon) Refresh() error {
+	lock, err := filesystemops.TryLock(d.lockPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	var errs []error
+	if err = d.fetcher.MakeDefaultRequests(); err != nil {
+		errs = append(errs, fmt.Errorf("default requests: %w", err))
+	}
+
+	rs, err := d.games()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not load the scoreboard: %w", err))
+	} else if rs.Scoreboard != nil {
+		for _, g := range rs.Scoreboard.Games {
+			if g.GameStatus != finishedGameStatus {
+				continue
+			}
+			if err = d.fetcher.FetchBoxScore(g.GameID); err != nil {
+				errs = append(errs, fmt.Errorf("box score %s: %w", g.GameID, err))
+			}
+		}
+	}
+
+	favorites, err := d.favorites()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not load favorites: %w", err))
+	}
+	for _, playerID := range favorites.Players {
+		if err = d.fetcher.FetchPlayerProfile(playerID); err != nil {
+			errs = append(errs, fmt.Errorf("player profile %s: %w", playerID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run refreshes the cache every interval until the context is done
+func (d *Daemon) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		switch err := d.Refresh(); {
+		case errors.Is(err, filesystemops.ErrLocked):
+			log.Println("daemon: the TUI is fetching, skipping this refresh")
+		case err != nil:
+			log.Println("daemon: refresh finished with errors:", err)
+		default:
+			log.Printf("daemon: refreshed the cache in %s", time.Since(start).Round(time.Millisecond))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Main runs the daemon mode of the command line, `nba-now daemon [-interval 10m] [-once]`, and returns the
+// exit code
+func Main(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("daemon", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	interval := flags.Duration("interval", DefaultInterval, "how often the cache is refreshed")
+	once := flags.Bool("once", false, "refresh the cache once and exit")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *interval < time.Minute {
+		fmt.Fprintln(stderr, "the interval has to be at least 1m")
+		return 2
+	}
+
+	cl := nbaAPI.NewClient()
+	for _, dir := range cl.Paths.GetBasePaths() {
+		if err := cl.FileSystem.EnsureDirectoryExists(dir); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+	d := NewFromClient(cl)
+
+	if *once {
+		if err := d.Refresh(); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return 0
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	log.Printf("daemon: refreshing the cache every %s", *interval)
+	d.Run(ctx, *interval)
+	log.Println("daemon: stopped")
+	return 0
+}
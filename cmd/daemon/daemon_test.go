@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+type mockFetcher struct {
+	defaults  int
+	boxScores []string
+	profiles  []string
+}
+
+func (m *mockFetcher) MakeDefaultRequests() error {
+	m.defaults++
+	return nil
+}
+
+func (m *mockFetcher) FetchBoxScore(gameID string) error {
+	m.boxScores = append(m.boxScores, gameID)
+	return nil
+}
+
+func (m *mockFetcher) FetchPlayerProfile(playerID string) error {
+	m.profiles = append(m.profiles, playerID)
+	if playerID == "0" {
+		return errors.New("no such player")
+	}
+	return nil
+}
+
+func scoreboard() (types.ResponseSet, error) {
+	return types.ResponseSet{Scoreboard: &types.ScoreboardV3Data{Games: []types.ScoreboardV3Game{
+		{GameID: "0022400001", GameStatus: 3},
+		{GameID: "0022400002", GameStatus: 2},
+		{GameID: "0022400003", GameStatus: 1},
+		{GameID: "0022400004", GameStatus: 3},
+	}}}, nil
+}
+
+func TestDaemon_Refresh(t *testing.T) {
+	fetcher := &mockFetcher{}
+	favorites := func() (types.Favorites, error) {
+		return types.Favorites{Teams: []string{"1610612738"}, Players: []string{"1628369", "1627759"}}, nil
+	}
+	d := New(fetcher, scoreboard, favorites, filepath.Join(t.TempDir(), "cache.lock"))
+
+	if err := d.Refresh(); err != nil {
+		t.Fatalf("Refresh() error: %v", err)
+	}
+	if fetcher.defaults != 1 {
+		t.Errorf("expected the default requests once, got %d", fetcher.defaults)
+	}
+	sort.Strings(fetcher.boxScores)
+	if len(fetcher.boxScores) != 2 || fetcher.boxScores[0] != "0022400001" || fetcher.boxScores[1] != "0022400004" {
+		t.Errorf("expected the box scores of the finished games, got %v", fetcher.boxScores)
+	}
+	if len(fetcher.profiles) != 2 {
+		t.Errorf("expected the profiles of the favorite players, got %v", fetcher.profiles)
+	}
+}
+
+func TestDaemon_RefreshErrors(t *testing.T) {
+	fetcher := &mockFetcher{}
+	favorites := func() (types.Favorites, error) { return types.Favorites{Players: []string{"0", "1628369"}}, nil }
+	d := New(fetcher, scoreboard, favorites, filepath.Join(t.TempDir(), "cache.lock"))
+
+	if err := d.Refresh(); err == nil {
+		t.Error("expected the error of the failed profile")
+	}
+	if len(fetcher.profiles) != 2 {
+		t.Errorf("expected a failed profile not to stop the others, got %v", fetcher.profiles)
+	}
+}
+
+func TestDaemon_RefreshWhileLocked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.lock")
+	lock, err := filesystemops.TryLock(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Unlock()
+
+	fetcher := &mockFetcher{}
+	d := New(fetcher, scoreboard, func() (types.Favorites, error) { return types.Favorites{}, nil }, path)
+	if err = d.Refresh(); !errors.Is(err, filesystemops.ErrLocked) {
+		t.Errorf("expected ErrLocked while the TUI holds the lock, got %v", err)
+	}
+	if fetcher.defaults != 0 {
+		t.Error("expected nothing to be fetched while locked")
+	}
+}
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"os"
+
+	"github.com/sLg00/nba-now-tui/cmd/backfill"
+	"github.com/sLg00/nba-now-tui/cmd/daemon"
 	"github.com/sLg00/nba-now-tui/cmd/internal"
 	"github.com/sLg00/nba-now-tui/tui"
 )
@@ -10,6 +14,15 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "daemon":
+			os.Exit(daemon.Main(os.Args[2:], os.Stderr))
+		case "backfill":
+			os.Exit(backfill.Main(os.Args[2:], os.Stdout, os.Stderr))
+		}
+	}
 	tui.RenderUI()
 
 }
@@ -0,0 +1,43 @@
+package filesystemops
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// FavoritesStore saves and loads the favorite teams and players, a single JSON file which is never cleaned up
+type FavoritesStore struct {
+	fs    FileSystemHandler
+	paths pathManager.PathManager
+}
+
+// NewFavoritesStore is a factory function that returns a FavoritesStore using the given fs handler and paths
+func NewFavoritesStore(fs FileSystemHandler, paths pathManager.PathManager) *FavoritesStore {
+	return &FavoritesStore{fs: fs, paths: paths}
+}
+
+// Load reads the favorites, no favorites have been picked when the file does not exist yet
+func (s *FavoritesStore) Load() (types.Favorites, error) {
+	path := s.paths.GetFullPath("favorites", "")
+	data, err := ReadConfigFile(s.fs, path)
+	if err != nil || data == nil {
+		return types.Favorites{}, err
+	}
+	var favorites types.Favorites
+	if err = json.Unmarshal(data, &favorites); err != nil {
+		return types.Favorites{}, fmt.Errorf("could not unmarshal favorites: %w", err)
+	}
+	return favorites, nil
+}
+
+// Save replaces the favorites
+func (s *FavoritesStore) Save(favorites types.Favorites) error {
+	data, err := json.MarshalIndent(favorites, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal favorites: %w", err)
+	}
+	return s.fs.WriteFile(s.paths.GetFullPath("favorites", ""), data)
+}
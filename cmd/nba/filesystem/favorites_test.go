@@ -0,0 +1,74 @@
+package filesystemops
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// existingFsHandler is a memoryFsHandler which knows which of its files exist
+type existingFsHandler struct {
+	memoryFsHandler
+}
+
+func (m *existingFsHandler) FileExists(path string) bool {
+	_, ok := m.files[path]
+	return ok
+}
+
+func TestFavoritesStore_LoadWithoutFile(t *testing.T) {
+	store := NewFavoritesStore(&existingFsHandler{memoryFsHandler{files: map[string][]byte{}}}, &mockPathManager{})
+	favorites, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(favorites.Teams) != 0 || len(favorites.Players) != 0 {
+		t.Errorf("expected no favorites, got %+v", favorites)
+	}
+}
+
+func TestFavoritesStore_SaveAndLoad(t *testing.T) {
+	fs := &existingFsHandler{memoryFsHandler{files: map[string][]byte{}}}
+	paths := &mockPathManager{
+		fullPathFunc: func(name, param string) string {
+			if name != "favorites" {
+				t.Errorf("unexpected path name %s", name)
+			}
+			return "/tmp/favorites.json"
+		},
+	}
+	store := NewFavoritesStore(fs, paths)
+
+	var favorites types.Favorites
+	favorites.ToggleTeam("1610612738")
+	favorites.TogglePlayer("1628369")
+	if err := store.Save(favorites); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	loaded, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !loaded.HasTeam("1610612738") || !loaded.HasPlayer("1628369") {
+		t.Errorf("expected saved favorites, got %+v", loaded)
+	}
+}
+
+func TestFavoritesStore_LoadSmallFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "favorites.json")
+	if err := os.WriteFile(path, []byte(`{"teams":["1610612738"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	paths := &mockPathManager{fullPathFunc: func(name, param string) string { return path }}
+
+	favorites, err := NewFavoritesStore(&DefaultFsHandler{}, paths).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !favorites.HasTeam("1610612738") {
+		t.Errorf("expected the favorites of a file smaller than a cached response, got %+v", favorites)
+	}
+}
@@ -1,9 +1,12 @@
 package filesystemops
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
 // FileSystemHandler provides capabilities that enable I/O ops within the local fs
@@ -13,6 +16,29 @@ type FileSystemHandler interface {
 	FileExists(file string) bool
 	CleanOldFiles(pc []string) error
 	EnsureDirectoryExists(dir string) error
+	ListFiles(dir string) ([]string, error)
+	RemoveFile(file string) error
+}
+
+// pathLocks serialises the access to each file. Every view instantiates its own client and handler, so the
+// locks are shared by all handlers of the process.
+var pathLocks = &pathLockSet{locks: make(map[string]*sync.RWMutex)}
+
+type pathLockSet struct {
+	mu    sync.Mutex
+	locks map[string]*sync.RWMutex
+}
+
+// get returns the lock of a file, files are written while holding it and read while holding its read lock
+func (s *pathLockSet) get(file string) *sync.RWMutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.locks[file]
+	if !ok {
+		l = &sync.RWMutex{}
+		s.locks[file] = l
+	}
+	return l
 }
 
 // DefaultFsHandler implements the FileSystemHandler interface
@@ -24,22 +50,50 @@ type DefaultFsHandler struct {
 func NewDefaultFsHandler() *DefaultFsHandler {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		fmt.Errorf("could not get home directory: %v", err)
+		log.Printf("could not get home directory: %v", err)
 		return nil
 	}
 	return &DefaultFsHandler{baseDirectory: home}
 }
 
+// WriteFile writes to a temporary file next to file and renames it, so the file is never seen half written.
+// The temporary file carries the name of the file, so a left over one is cleaned up along with it.
 func (fs *DefaultFsHandler) WriteFile(file string, data []byte) error {
 	dir := filepath.Dir(file)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("creating directory failed: %w", err)
 	}
 
-	return os.WriteFile(file, data, 0644)
+	lock := pathLocks.get(file)
+	lock.Lock()
+	defer lock.Unlock()
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(file)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file failed: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), file)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("writing file failed: %w", err)
+	}
+	return nil
 }
 
 func (fs *DefaultFsHandler) ReadFile(file string) ([]byte, error) {
+	lock := pathLocks.get(file)
+	lock.RLock()
+	defer lock.RUnlock()
+
 	workFile, err := os.ReadFile(file)
 	if err != nil {
 		return nil, fmt.Errorf("reading file failed: %w", err)
@@ -57,22 +111,50 @@ func (fs *DefaultFsHandler) FileExists(file string) bool {
 	return false
 }
 
+// ReadConfigFile reads a small settings file like favorites.json. FileExists does not see those, as it only
+// counts files the size of a cached response. No data and no error are returned when the file does not exist.
+func ReadConfigFile(fs FileSystemHandler, path string) ([]byte, error) {
+	data, err := fs.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
 // CleanOldFiles TODO: add file age method
 func (fs *DefaultFsHandler) CleanOldFiles(pc []string) error {
 
 	filesRegex := "^(\\d{4}-\\d{2}-\\d{2})_.*$"
 
+	// directories which were never written to don't exist yet, they are skipped and the others are still swept
+	var errs []error
 	for _, path := range pc {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
 		fileList, err := FindFiles(path, filesRegex)
 		if err != nil {
-			return fmt.Errorf("could not list files in path %s: %v", path, err)
+			errs = append(errs, fmt.Errorf("could not list files in path %s: %v", path, err))
+			continue
 		}
 		err = RemoveFiles(fileList)
 		if err != nil {
-			return fmt.Errorf("could not remove files in path %s: %v", path, err)
+			errs = append(errs, fmt.Errorf("could not remove files in path %s: %v", path, err))
 		}
 	}
 
+	return errors.Join(errs...)
+}
+
+// RemoveFile deletes a file, e.g. a corrupt one so it is fetched again. A missing file is not an error.
+func (fs *DefaultFsHandler) RemoveFile(file string) error {
+	lock := pathLocks.get(file)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing file failed: %w", err)
+	}
 	return nil
 }
 
@@ -83,3 +165,22 @@ func (fs *DefaultFsHandler) EnsureDirectoryExists(dir string) error {
 	}
 	return nil
 }
+
+// ListFiles returns the full paths of all files (not directories) in dir. A missing directory yields no files.
+func (fs *DefaultFsHandler) ListFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing directory failed: %w", err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files, nil
+}
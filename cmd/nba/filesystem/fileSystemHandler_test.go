@@ -0,0 +1,106 @@
+package filesystemops
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDefaultFsHandler_WriteFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "sub", "2025-01-15_dsb")
+	fs := &DefaultFsHandler{}
+
+	if err := fs.WriteFile(file, []byte(`{"old":true}`)); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if err := fs.WriteFile(file, []byte(`{"new":true}`)); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	data, err := fs.ReadFile(file)
+	if err != nil || string(data) != `{"new":true}` {
+		t.Errorf("expected the file to be replaced, got %s, %v", data, err)
+	}
+	entries, _ := os.ReadDir(filepath.Dir(file))
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files to be left behind, got %d files", len(entries))
+	}
+}
+
+func TestDefaultFsHandler_ConcurrentReadWrite(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "2025-01-15_ss")
+	fs := &DefaultFsHandler{}
+	big := func(n int) []byte {
+		data, _ := json.Marshal(map[string]string{"payload": string(bytes.Repeat([]byte{byte('a' + n)}, 1<<16))})
+		return data
+	}
+	if err := fs.WriteFile(file, big(0)); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(n int) {
+			defer wg.Done()
+			if err := fs.WriteFile(file, big(n)); err != nil {
+				t.Errorf("WriteFile() error: %v", err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			data, err := fs.ReadFile(file)
+			if err != nil {
+				t.Errorf("ReadFile() error: %v", err)
+				return
+			}
+			if !json.Valid(data) {
+				t.Error("read a half written file")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDefaultFsHandler_RemoveFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "2025-01-15_ll")
+	fs := &DefaultFsHandler{}
+	if err := fs.WriteFile(file, []byte("{")); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.RemoveFile(file); err != nil {
+		t.Fatalf("RemoveFile() error: %v", err)
+	}
+	if _, err := os.Stat(file); !os.IsNotExist(err) {
+		t.Errorf("expected the file to be removed, got %v", err)
+	}
+	if err := fs.RemoveFile(file); err != nil {
+		t.Errorf("expected removing a missing file to succeed, got %v", err)
+	}
+}
+
+func TestDefaultFsHandler_CleanOldFiles_SkipsMissingDirectories(t *testing.T) {
+	dir := t.TempDir()
+	old := filepath.Join(dir, "boxscores", "2025-01-15_0022400001")
+	fs := &DefaultFsHandler{}
+	if err := fs.WriteFile(old, []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-96 * time.Hour)
+	if err := os.Chtimes(old, past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	paths := []string{filepath.Join(dir, "shotcharts") + "/", filepath.Join(dir, "boxscores") + "/"}
+	if err := fs.CleanOldFiles(paths); err != nil {
+		t.Fatalf("CleanOldFiles() error: %v", err)
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Errorf("expected the old box score to be removed, got %v", err)
+	}
+}
@@ -2,9 +2,11 @@ package filesystemops
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
 	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+	"log"
 )
 
 // DataLoader interface is used to inject the relevant ResponseSets into the converter functions
@@ -15,17 +17,36 @@ type DataLoader interface {
 	LoadBoxScore(gameID string) (types.ResponseSet, error)
 	LoadTeamInfo(teamID string) (types.ResponseSet, error)
 	LoadPlayerIndex(teamID string) (types.ResponseSet, error)
+	LoadLeaguePlayerIndex() (types.ResponseSet, error)
 	LoadPlayerInfo(playerID string) (types.ResponseSet, error)
 	LoadPlayerCareerStats(playerID string) (types.ResponseSet, error)
 	LoadPlayerGameLog(playerID string) (types.ResponseSet, error)
 	LoadPlayoffBracket(season string) (types.ResponseSet, error)
 	LoadCommonPlayoffSeries(season string) (types.ResponseSet, error)
+	LoadSeasonSchedule(season string) (types.ResponseSet, error)
+	LoadShotChart(cacheID string) (types.ResponseSet, error)
+	LoadTeamShotLocations(season, measureType string) (types.ShotLocations, error)
+	LoadLeagueGameLog(season string) (types.ResponseSet, error)
+	LoadPlayerDashboard(playerID, dashboard, season string) (types.ResponseSet, error)
+	LoadPlayerAwards(playerID string) (types.ResponseSet, error)
+	LoadDraftHistory(year string) (types.ResponseSet, error)
+	LoadAllTimeLeaders(perMode string) (types.ResponseSet, error)
+	LoadFranchiseLeaders(teamID string) (types.ResponseSet, error)
+	LoadFranchisePlayers(teamID string) (types.ResponseSet, error)
+	LoadTeamLineups(teamID string, groupQuantity int, season string) (types.ResponseSet, error)
+	LoadTeamOnOff(teamID, season string) (types.ResponseSet, error)
+	LoadInjuryReport() ([]byte, error)
+	LoadTransactions() (types.TransactionsResponse, error)
 }
 
+// ErrCorruptCache is returned for a cached file which is not valid JSON, e.g. one cut short by a crash
+var ErrCorruptCache = errors.New("corrupt cache file")
+
 // nbaDataLoader implements the DataLoader interface
 type nbaDataLoader struct {
-	fs    FileSystemHandler
-	paths pathManager.PathManager
+	fs      FileSystemHandler
+	paths   pathManager.PathManager
+	refetch func(path string) error
 }
 
 // NewDataLoader is a factory function that instantiates a DataLoader
@@ -36,6 +57,16 @@ func NewDataLoader(fs FileSystemHandler, paths pathManager.PathManager) DataLoad
 	}
 }
 
+// NewRefetchingDataLoader instantiates a DataLoader which calls refetch to download a corrupt file again
+func NewRefetchingDataLoader(fs FileSystemHandler, paths pathManager.PathManager,
+	refetch func(path string) error) DataLoader {
+	return &nbaDataLoader{
+		fs:      fs,
+		paths:   paths,
+		refetch: refetch,
+	}
+}
+
 func (dl *nbaDataLoader) LoadDailyScoreboard() (types.ResponseSet, error) {
 	path := dl.paths.GetFullPath("dailyScores", "")
 	return dl.loadAndUnmarshall(path)
@@ -66,11 +97,19 @@ func (dl *nbaDataLoader) LoadPlayerIndex(teamID string) (types.ResponseSet, erro
 	return dl.loadAndUnmarshall(path)
 }
 
+// LoadLeaguePlayerIndex reads the player index of the whole league
+func (dl *nbaDataLoader) LoadLeaguePlayerIndex() (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("leaguePlayerIndex", "")
+	return dl.loadAndUnmarshall(path)
+}
+
 func (dl *nbaDataLoader) LoadPlayerInfo(playerID string) (types.ResponseSet, error) {
 	path := dl.paths.GetFullPath("playerInfo", playerID)
 	return dl.loadAndUnmarshall(path)
 }
 
+// LoadPlayerCareerStats loads the per game career stats of a player, or the ones of another PerMode when passed
+// an id from types.CareerStatsCacheID
 func (dl *nbaDataLoader) LoadPlayerCareerStats(playerID string) (types.ResponseSet, error) {
 	path := dl.paths.GetFullPath("playerCareerStats", playerID)
 	return dl.loadAndUnmarshall(path)
@@ -91,17 +130,143 @@ func (dl *nbaDataLoader) LoadCommonPlayoffSeries(season string) (types.ResponseS
 	return dl.loadAndUnmarshall(path)
 }
 
-// loadAnUnmarshall method loads a file using the ReadFile function and thn unmarshalls it into a types.ResponseSet
-func (dl *nbaDataLoader) loadAndUnmarshall(path string) (types.ResponseSet, error) {
+func (dl *nbaDataLoader) LoadSeasonSchedule(season string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("seasonSchedule", season)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadShotChart(cacheID string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("shotChart", cacheID)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadLeagueGameLog(season string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("leagueGameLog", season)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadPlayerDashboard(playerID, dashboard, season string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("playerDashboard", playerID+"_"+dashboard+"_"+season)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadPlayerAwards(playerID string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("playerAwards", playerID)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadDraftHistory(year string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("draftHistory", year)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadAllTimeLeaders(perMode string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("allTimeLeaders", perMode)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadFranchiseLeaders(teamID string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("franchiseLeaders", teamID)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadFranchisePlayers(teamID string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("franchisePlayers", teamID)
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadTeamLineups(teamID string, groupQuantity int, season string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("teamDashboard", types.TeamLineupsCacheID(teamID, groupQuantity, season))
+	return dl.loadAndUnmarshall(path)
+}
+
+func (dl *nbaDataLoader) LoadTeamOnOff(teamID, season string) (types.ResponseSet, error) {
+	path := dl.paths.GetFullPath("teamDashboard", teamID+"_onoff_"+season)
+	return dl.loadAndUnmarshall(path)
+}
+
+// LoadTeamShotLocations loads the per zone shooting of all teams, which doesn't share the layout of the other responses
+func (dl *nbaDataLoader) LoadTeamShotLocations(season, measureType string) (types.ShotLocations, error) {
+	path := dl.paths.GetFullPath("teamShotLocations", season+"_"+measureType)
+	var locations types.ShotLocations
+	if err := dl.loadJSON(path, &locations); err != nil {
+		return types.ShotLocations{}, err
+	}
+	return locations, nil
+}
+
+// LoadInjuryReport returns the cached injury report as it was published, a PDF or JSON document
+func (dl *nbaDataLoader) LoadInjuryReport() ([]byte, error) {
+	path := dl.paths.GetFullPath("injuryReport", "")
 	data, err := dl.fs.ReadFile(path)
 	if err != nil {
-		return types.ResponseSet{}, fmt.Errorf("failed to load file %s: %w", path, err)
+		return nil, fmt.Errorf("failed to read injury report: %w", err)
 	}
+	return data, nil
+}
 
-	var response types.ResponseSet
-	if err = json.Unmarshal(data, &response); err != nil {
-		return types.ResponseSet{}, fmt.Errorf("failed to unmarshal json: %w", err)
+// LoadTransactions reads the cached player movement feed, which is not shaped like the stats API responses
+func (dl *nbaDataLoader) LoadTransactions() (types.TransactionsResponse, error) {
+	path := dl.paths.GetFullPath("transactions", "")
+	var transactions types.TransactionsResponse
+	if err := dl.loadJSON(path, &transactions); err != nil {
+		return types.TransactionsResponse{}, err
 	}
+	return transactions, nil
+}
 
+// loadAnUnmarshall method loads a file using the ReadFile function and thn unmarshalls it into a types.ResponseSet.
+func (dl *nbaDataLoader) loadAndUnmarshall(path string) (types.ResponseSet, error) {
+	var response types.ResponseSet
+	if err := dl.loadJSON(path, &response); err != nil {
+		return types.ResponseSet{}, err
+	}
 	return response, nil
 }
+
+// loadJSON unmarshalls the cached file at path into v. A file which is not valid JSON is removed and fetched
+// again, so the next fetch does not skip it either.
+func (dl *nbaDataLoader) loadJSON(path string, v interface{}) error {
+	err := ReadCachedJSON(dl.fs, path, v)
+	if !errors.Is(err, ErrCorruptCache) || dl.refetch == nil {
+		return err
+	}
+
+	log.Printf("fetching %s again", path)
+	if rerr := dl.refetch(path); rerr != nil {
+		return fmt.Errorf("%w, fetching it again failed: %w", err, rerr)
+	}
+	return unmarshallFile(dl.fs, path, v)
+}
+
+// ReadCachedJSON unmarshalls the cached file at path into v. A file which is not valid JSON, e.g. one cut short
+// by a crash, is removed and ErrCorruptCache returned, so the caller can fetch it again.
+func ReadCachedJSON(fs FileSystemHandler, path string, v interface{}) error {
+	err := unmarshallFile(fs, path, v)
+	if !errors.Is(err, ErrCorruptCache) {
+		return err
+	}
+
+	log.Printf("%v, removing it", err)
+	if rerr := fs.RemoveFile(path); rerr != nil {
+		log.Println(rerr)
+	}
+	return err
+}
+
+func unmarshallFile(fs FileSystemHandler, path string, v interface{}) error {
+	data, err := fs.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to load file %s: %w", path, err)
+	}
+
+	if err = json.Unmarshal(data, v); err != nil {
+		var syntaxErr *json.SyntaxError
+		if len(data) == 0 || errors.As(err, &syntaxErr) {
+			return fmt.Errorf("%w %s: %w", ErrCorruptCache, path, err)
+		}
+		return fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+
+	return nil
+}
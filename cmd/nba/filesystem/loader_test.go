@@ -1,6 +1,7 @@
 package filesystemops
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -32,6 +33,8 @@ func (m *mockFsHandler) WriteFile(string, []byte) error        { return nil }
 func (m *mockFsHandler) FileExists(string) bool                { return false }
 func (m *mockFsHandler) EnsureDirectoryExists(string) error    { return nil }
 func (m *mockFsHandler) CleanOldFiles([]string) error          { return nil }
+func (m *mockFsHandler) ListFiles(string) ([]string, error)     { return nil, nil }
+func (m *mockFsHandler) RemoveFile(string) error               { return nil }
 
 func TestLoadPlayerInfo(t *testing.T) {
 	json := `{"resultSets":[{"name":"CommonPlayerInfo","headers":["FIRST_NAME"],"rowSet":[["Bam"]]}]}`
@@ -163,3 +166,116 @@ func TestLoadCommonPlayoffSeries_ReturnsResponseSet(t *testing.T) {
 		t.Fatal("LoadCommonPlayoffSeries() returned empty ResultSets")
 	}
 }
+
+func TestLoadTransactions(t *testing.T) {
+	json := `{"NBA_Player_Movement":{"columns":[],"rows":[{"Transaction_Type":"Signing","PLAYER_ID":1629029.0}]}}`
+	paths := &mockPathManager{
+		fullPathFunc: func(name, param string) string {
+			if name != "transactions" {
+				t.Errorf("unexpected path name: %s", name)
+			}
+			return "/tmp/test_transactions"
+		},
+	}
+	fs := &mockFsHandler{
+		readFileFunc: func(path string) ([]byte, error) {
+			return []byte(json), nil
+		},
+	}
+
+	dl := NewDataLoader(fs, paths)
+	resp, err := dl.LoadTransactions()
+	if err != nil {
+		t.Fatalf("LoadTransactions() error: %v", err)
+	}
+	if len(resp.PlayerMovement.Rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(resp.PlayerMovement.Rows))
+	}
+	if resp.PlayerMovement.Rows[0]["Transaction_Type"] != "Signing" {
+		t.Errorf("unexpected row %v", resp.PlayerMovement.Rows[0])
+	}
+}
+
+func TestLoadLeaguePlayerIndex(t *testing.T) {
+	json := `{"resultSets":[{"name":"PlayerIndex","headers":["PERSON_ID"],"rowSet":[[1628369]]}]}`
+	paths := &mockPathManager{
+		fullPathFunc: func(name, param string) string {
+			if name != "leaguePlayerIndex" {
+				t.Errorf("unexpected path name: %s", name)
+			}
+			return "/tmp/test_league"
+		},
+	}
+	fs := &mockFsHandler{
+		readFileFunc: func(path string) ([]byte, error) {
+			return []byte(json), nil
+		},
+	}
+
+	rs, err := NewDataLoader(fs, paths).LoadLeaguePlayerIndex()
+	if err != nil {
+		t.Fatalf("LoadLeaguePlayerIndex() error: %v", err)
+	}
+	if len(rs.ResultSets) == 0 || rs.ResultSets[0].Name != "PlayerIndex" {
+		t.Errorf("unexpected result sets %v", rs.ResultSets)
+	}
+}
+
+func TestLoadAndUnmarshall_CorruptFile(t *testing.T) {
+	valid := `{"resultSets":[{"name":"LeagueStandingsV3","headers":["TeamID"],"rowSet":[[1610612738]]}]}`
+	paths := &mockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/2025-01-15_ss" }}
+
+	fs := &existingFsHandler{memoryFsHandler{files: map[string][]byte{"/tmp/2025-01-15_ss": []byte(valid[:40])}}}
+	refetched := 0
+	loader := NewRefetchingDataLoader(fs, paths, func(path string) error {
+		refetched++
+		return fs.WriteFile(path, []byte(valid))
+	})
+	rs, err := loader.LoadSeasonStandings()
+	if err != nil {
+		t.Fatalf("expected the corrupt file to be fetched again, got %v", err)
+	}
+	if refetched != 1 || len(rs.ResultSets) != 1 {
+		t.Errorf("expected one refetch and the valid response, got %d refetches, %+v", refetched, rs)
+	}
+
+	fs.files["/tmp/2025-01-15_ss"] = []byte("")
+	_, err = NewDataLoader(fs, paths).LoadSeasonStandings()
+	if !errors.Is(err, ErrCorruptCache) {
+		t.Errorf("expected ErrCorruptCache without a refetch, got %v", err)
+	}
+	if _, ok := fs.files["/tmp/2025-01-15_ss"]; ok {
+		t.Error("expected the corrupt file to be removed")
+	}
+}
+
+func TestLoadTransactions_CorruptFile(t *testing.T) {
+	valid := `{"NBA_Player_Movement":{"rows":[{"Transaction_Type":"Signing"}]}}`
+	paths := &mockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/2025-01-15_transactions" }}
+
+	fs := &existingFsHandler{memoryFsHandler{files: map[string][]byte{"/tmp/2025-01-15_transactions": []byte(valid[:30])}}}
+	refetched := 0
+	loader := NewRefetchingDataLoader(fs, paths, func(path string) error {
+		refetched++
+		return fs.WriteFile(path, []byte(valid))
+	})
+	resp, err := loader.LoadTransactions()
+	if err != nil {
+		t.Fatalf("expected the corrupt file to be fetched again, got %v", err)
+	}
+	if refetched != 1 || len(resp.PlayerMovement.Rows) != 1 {
+		t.Errorf("expected one refetch and the valid feed, got %d refetches, %+v", refetched, resp)
+	}
+}
+
+func TestReadCachedJSON_CorruptFile(t *testing.T) {
+	fs := &existingFsHandler{memoryFsHandler{files: map[string][]byte{"/tmp/2025-01-15_news": []byte(`[{"Title": "cut`)}}}
+
+	var articles []map[string]string
+	if err := ReadCachedJSON(fs, "/tmp/2025-01-15_news", &articles); !errors.Is(err, ErrCorruptCache) {
+		t.Errorf("expected ErrCorruptCache, got %v", err)
+	}
+	if _, ok := fs.files["/tmp/2025-01-15_news"]; ok {
+		t.Error("expected the corrupt file to be removed")
+	}
+}
@@ -0,0 +1,102 @@
+package filesystemops
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrLocked is returned when another process, the daemon or the TUI, holds the cache lock
+var ErrLocked = errors.New("the cache is locked by another process")
+
+// lockHeartbeat is how often a held lock is touched, a lock untouched for lockStaleAfter is left behind by a
+// process which did not exit cleanly and is taken over
+const (
+	lockHeartbeat  = 30 * time.Second
+	lockStaleAfter = 2 * time.Minute
+)
+
+// FileLock keeps the daemon and the TUI from writing the cache at the same time. The lockfile holds the
+// PID of its owner and is kept fresh while the lock is held.
+type FileLock struct {
+	path string
+	stop chan struct{}
+	once sync.Once
+}
+
+// TryLock takes the lock at path, or returns ErrLocked when it is held
+func TryLock(path string) (*FileLock, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, err = f.WriteString(strconv.Itoa(os.Getpid()))
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				_ = os.Remove(path)
+				return nil, fmt.Errorf("could not write lockfile %s: %w", path, err)
+			}
+			l := &FileLock{path: path, stop: make(chan struct{})}
+			go l.heartbeat()
+			return l, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("could not create lockfile %s: %w", path, err)
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			// released in the meantime
+			continue
+		}
+		if time.Since(info.ModTime()) < lockStaleAfter {
+			return nil, ErrLocked
+		}
+		if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("could not remove stale lockfile %s: %w", path, err)
+		}
+	}
+	return nil, ErrLocked
+}
+
+// WaitLock takes the lock at path, waiting up to timeout for the other process to release it
+func WaitLock(path string, timeout time.Duration) (*FileLock, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		l, err := TryLock(path)
+		if !errors.Is(err, ErrLocked) || time.Now().After(deadline) {
+			return l, err
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+func (l *FileLock) heartbeat() {
+	ticker := time.NewTicker(lockHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			now := time.Now()
+			_ = os.Chtimes(l.path, now, now)
+		}
+	}
+}
+
+// Unlock releases the lock, releasing it twice is a no-op
+func (l *FileLock) Unlock() error {
+	var err error
+	l.once.Do(func() {
+		close(l.stop)
+		if rerr := os.Remove(l.path); rerr != nil && !errors.Is(rerr, os.ErrNotExist) {
+			err = fmt.Errorf("could not remove lockfile %s: %w", l.path, rerr)
+		}
+	})
+	return err
+}
@@ -0,0 +1,73 @@
+package filesystemops
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTryLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.lock")
+
+	lock, err := TryLock(path)
+	if err != nil {
+		t.Fatalf("TryLock() error: %v", err)
+	}
+	if _, err = TryLock(path); !errors.Is(err, ErrLocked) {
+		t.Errorf("expected ErrLocked while the lock is held, got %v", err)
+	}
+
+	if err = lock.Unlock(); err != nil {
+		t.Fatalf("Unlock() error: %v", err)
+	}
+	if err = lock.Unlock(); err != nil {
+		t.Errorf("expected unlocking twice to be a no-op, got %v", err)
+	}
+
+	lock, err = TryLock(path)
+	if err != nil {
+		t.Fatalf("expected the released lock to be taken again, got %v", err)
+	}
+	_ = lock.Unlock()
+}
+
+func TestTryLock_Stale(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.lock")
+	if err := os.WriteFile(path, []byte("12345"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * lockStaleAfter)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	lock, err := TryLock(path)
+	if err != nil {
+		t.Fatalf("expected a stale lock to be taken over, got %v", err)
+	}
+	_ = lock.Unlock()
+}
+
+func TestWaitLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.lock")
+	held, err := TryLock(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = WaitLock(path, 300*time.Millisecond); !errors.Is(err, ErrLocked) {
+		t.Errorf("expected ErrLocked after the timeout, got %v", err)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = held.Unlock()
+	}()
+	lock, err := WaitLock(path, 5*time.Second)
+	if err != nil {
+		t.Fatalf("expected the lock once released, got %v", err)
+	}
+	_ = lock.Unlock()
+}
@@ -0,0 +1,85 @@
+package filesystemops
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+var predictionNameSanitizer = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// PredictionStore saves and loads bracket challenge predictions. Every prediction is a JSON file named after
+// its owner, in a per-season folder which is never cleaned up.
+type PredictionStore struct {
+	fs    FileSystemHandler
+	paths pathManager.PathManager
+}
+
+// NewPredictionStore is a factory function that returns a PredictionStore using the given fs handler and paths
+func NewPredictionStore(fs FileSystemHandler, paths pathManager.PathManager) *PredictionStore {
+	return &PredictionStore{fs: fs, paths: paths}
+}
+
+// PredictionFileName converts a prediction name into a safe file name
+func PredictionFileName(name string) string {
+	return strings.Trim(predictionNameSanitizer.ReplaceAllString(strings.TrimSpace(name), "_"), "_") + ".json"
+}
+
+// Save writes the prediction to the folder of its season, replacing an earlier version
+func (ps *PredictionStore) Save(prediction types.BracketPrediction) error {
+	if PredictionFileName(prediction.Name) == ".json" {
+		return fmt.Errorf("prediction name %q is not valid", prediction.Name)
+	}
+	data, err := json.MarshalIndent(prediction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal prediction: %w", err)
+	}
+	path := ps.paths.GetFullPath("predictionsPath", prediction.Season) + PredictionFileName(prediction.Name)
+	return ps.fs.WriteFile(path, data)
+}
+
+// Load reads a single prediction of a season by name
+func (ps *PredictionStore) Load(season, name string) (types.BracketPrediction, error) {
+	path := ps.paths.GetFullPath("predictionsPath", season) + PredictionFileName(name)
+	return ps.load(path)
+}
+
+// List returns all predictions saved for a season, ordered by name. Unreadable files are skipped.
+func (ps *PredictionStore) List(season string) ([]types.BracketPrediction, error) {
+	files, err := ps.fs.ListFiles(ps.paths.GetFullPath("predictionsPath", season))
+	if err != nil {
+		return nil, err
+	}
+
+	var predictions []types.BracketPrediction
+	for _, file := range files {
+		if filepath.Ext(file) != ".json" {
+			continue
+		}
+		prediction, err := ps.load(file)
+		if err != nil {
+			continue
+		}
+		predictions = append(predictions, prediction)
+	}
+	sort.Slice(predictions, func(i, j int) bool { return predictions[i].Name < predictions[j].Name })
+	return predictions, nil
+}
+
+func (ps *PredictionStore) load(path string) (types.BracketPrediction, error) {
+	data, err := ps.fs.ReadFile(path)
+	if err != nil {
+		return types.BracketPrediction{}, err
+	}
+	var prediction types.BracketPrediction
+	if err = json.Unmarshal(data, &prediction); err != nil {
+		return types.BracketPrediction{}, fmt.Errorf("could not unmarshal prediction %s: %w", path, err)
+	}
+	return prediction, nil
+}
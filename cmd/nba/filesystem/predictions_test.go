@@ -0,0 +1,95 @@
+package filesystemops
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// memoryFsHandler keeps written files in memory
+type memoryFsHandler struct {
+	mockFsHandler
+	files map[string][]byte
+}
+
+func (m *memoryFsHandler) WriteFile(path string, data []byte) error {
+	m.files[path] = data
+	return nil
+}
+
+func (m *memoryFsHandler) RemoveFile(path string) error {
+	delete(m.files, path)
+	return nil
+}
+
+func (m *memoryFsHandler) ReadFile(path string) ([]byte, error) {
+	data, ok := m.files[path]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found: %w", path, os.ErrNotExist)
+	}
+	return data, nil
+}
+
+func (m *memoryFsHandler) ListFiles(dir string) ([]string, error) {
+	var files []string
+	for path := range m.files {
+		if strings.HasPrefix(path, dir) && !strings.Contains(strings.TrimPrefix(path, dir), "/") {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+func TestPredictionStore_SaveAndList(t *testing.T) {
+	fs := &memoryFsHandler{files: map[string][]byte{}}
+	paths := &mockPathManager{
+		fullPathFunc: func(name, param string) string {
+			if name != "predictionsPath" {
+				t.Errorf("unexpected path name %s", name)
+			}
+			return "/tmp/predictions/" + param + "/"
+		},
+	}
+	store := NewPredictionStore(fs, paths)
+
+	for _, name := range []string{"Zoe", "Ann Smith"} {
+		prediction := types.BracketPrediction{Name: name, Season: "2024-25",
+			Picks: []types.SeriesPick{{Series: 0, Winner: "BOS", Games: 5}}}
+		if err := store.Save(prediction); err != nil {
+			t.Fatalf("Save(%s) error: %v", name, err)
+		}
+	}
+	if err := store.Save(types.BracketPrediction{Name: "Bob", Season: "2023-24"}); err != nil {
+		t.Fatalf("Save(Bob) error: %v", err)
+	}
+
+	if _, ok := fs.files["/tmp/predictions/2024-25/Ann_Smith.json"]; !ok {
+		t.Errorf("expected sanitized file name Ann_Smith.json, got %v", fs.files)
+	}
+
+	predictions, err := store.List("2024-25")
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(predictions) != 2 || predictions[0].Name != "Ann Smith" || predictions[1].Name != "Zoe" {
+		t.Fatalf("List() = %+v, want Ann Smith and Zoe", predictions)
+	}
+	if pick, ok := predictions[1].Pick(0); !ok || pick.Winner != "BOS" || pick.Games != 5 {
+		t.Errorf("loaded pick = %+v, want BOS in 5", pick)
+	}
+
+	loaded, err := store.Load("2023-24", "Bob")
+	if err != nil || loaded.Name != "Bob" {
+		t.Errorf("Load(Bob) = %+v, %v", loaded, err)
+	}
+}
+
+func TestPredictionStore_RejectsEmptyName(t *testing.T) {
+	store := NewPredictionStore(&memoryFsHandler{files: map[string][]byte{}}, &mockPathManager{})
+	if err := store.Save(types.BracketPrediction{Name: " ?! "}); err == nil {
+		t.Error("expected an error for a name without valid characters")
+	}
+}
@@ -0,0 +1,421 @@
+package filesystemops
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+	_ "modernc.org/sqlite"
+)
+
+// sqliteSchema holds the raw responses, keyed by the paths the PathManager gives the files, and the tables
+// normalized from them. Cleaning the responses up keeps the normalized rows, they are the queryable history.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS responses (
+	path       TEXT PRIMARY KEY,
+	written_at INTEGER NOT NULL,
+	body       BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS games (
+	game_id      TEXT PRIMARY KEY,
+	game_date    TEXT,
+	season       TEXT NOT NULL,
+	status       INTEGER,
+	home_team_id INTEGER NOT NULL,
+	away_team_id INTEGER NOT NULL,
+	home_pts     INTEGER NOT NULL,
+	away_pts     INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS box_score_lines (
+	game_id    TEXT NOT NULL,
+	player_id  INTEGER NOT NULL,
+	team_id    INTEGER NOT NULL,
+	season     TEXT NOT NULL,
+	name       TEXT NOT NULL,
+	minutes    TEXT NOT NULL,
+	pts        INTEGER NOT NULL,
+	reb        INTEGER NOT NULL,
+	ast        INTEGER NOT NULL,
+	stl        INTEGER NOT NULL,
+	blk        INTEGER NOT NULL,
+	tov        INTEGER NOT NULL,
+	fgm        INTEGER NOT NULL,
+	fga        INTEGER NOT NULL,
+	fg3m       INTEGER NOT NULL,
+	fg3a       INTEGER NOT NULL,
+	ftm        INTEGER NOT NULL,
+	fta        INTEGER NOT NULL,
+	plus_minus REAL NOT NULL,
+	PRIMARY KEY (game_id, player_id)
+);
+CREATE INDEX IF NOT EXISTS box_score_lines_season_pts ON box_score_lines (season, pts);
+CREATE TABLE IF NOT EXISTS players (
+	player_id  INTEGER PRIMARY KEY,
+	name       TEXT NOT NULL,
+	team_id    INTEGER NOT NULL,
+	position   TEXT NOT NULL,
+	updated_at INTEGER NOT NULL
+);`
+
+// cachedFileRegex matches the date prefixed files CleanOldFiles removes
+var cachedFileRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})_.*$`)
+
+// sqliteHandlers shares one database handle per database file between all clients of the process
+var sqliteHandlers = struct {
+	sync.Mutex
+	open map[string]*SQLiteFsHandler
+}{open: make(map[string]*SQLiteFsHandler)}
+
+// SQLiteFsHandler implements the FileSystemHandler interface on top of an embedded SQLite database. The API
+// responses are stored as rows, and the games, box score lines and players in them are normalized into tables
+// for aggregate queries. The settings (the .json files like favorites.json and the predictions) stay files.
+type SQLiteFsHandler struct {
+	db    *sql.DB
+	files FileSystemHandler
+}
+
+// OpenSQLiteFsHandler opens (and creates) the database at path. Settings files are handled by files.
+// The database is opened once per process, later calls return the same handler.
+func OpenSQLiteFsHandler(path string, files FileSystemHandler) (*SQLiteFsHandler, error) {
+	sqliteHandlers.Lock()
+	defer sqliteHandlers.Unlock()
+	if h, ok := sqliteHandlers.open[path]; ok {
+		return h, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("creating directory failed: %w", err)
+	}
+	// the TUI and the daemon may write at the same time, a writer waits for the other one instead of failing
+	h, err := openSQLite("file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", files)
+	if err != nil {
+		return nil, err
+	}
+	sqliteHandlers.open[path] = h
+	return h, nil
+}
+
+func openSQLite(dsn string, files FileSystemHandler) (*SQLiteFsHandler, error) {
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening database failed: %w", err)
+	}
+	// a single connection serialises the writes of the process, and keeps an in-memory database in one piece
+	db.SetMaxOpenConns(1)
+	if _, err = db.Exec(sqliteSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("creating database schema failed: %w", err)
+	}
+	return &SQLiteFsHandler{db: db, files: files}, nil
+}
+
+// isSettingsFile tells if a path is a settings file, which is kept as a file whatever the storage
+func isSettingsFile(file string) bool {
+	return filepath.Ext(file) == ".json"
+}
+
+// WriteFile stores a response and the rows normalized from it in a single transaction, so a response is never
+// seen half written
+func (s *SQLiteFsHandler) WriteFile(file string, data []byte) error {
+	if isSettingsFile(file) {
+		return s.files.WriteFile(file, data)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("writing file failed: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec(`INSERT INTO responses (path, written_at, body) VALUES (?, ?, ?)
+		ON CONFLICT (path) DO UPDATE SET written_at = excluded.written_at, body = excluded.body`,
+		file, time.Now().Unix(), data)
+	if err != nil {
+		return fmt.Errorf("writing file failed: %w", err)
+	}
+	if err = normalizeResponse(tx, data); err != nil {
+		return fmt.Errorf("normalizing %s failed: %w", file, err)
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("writing file failed: %w", err)
+	}
+	return nil
+}
+
+// ReadFile returns a stored response, a missing one wraps os.ErrNotExist like a missing file
+func (s *SQLiteFsHandler) ReadFile(file string) ([]byte, error) {
+	if isSettingsFile(file) {
+		return s.files.ReadFile(file)
+	}
+
+	var data []byte
+	err := s.db.QueryRow(`SELECT body FROM responses WHERE path = ?`, file).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("reading file failed: %w", os.ErrNotExist)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading file failed: %w", err)
+	}
+	return data, nil
+}
+
+// FileExists counts responses the size of a cached response, like DefaultFsHandler.FileExists
+func (s *SQLiteFsHandler) FileExists(file string) bool {
+	if isSettingsFile(file) {
+		return s.files.FileExists(file)
+	}
+
+	var exists bool
+	err := s.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM responses WHERE path = ? AND length(body) > 1000)`,
+		file).Scan(&exists)
+	return err == nil && exists
+}
+
+// CleanOldFiles removes the date prefixed responses of the directories older than 72 hours. The files left over
+// from the file storage are cleaned up as well.
+func (s *SQLiteFsHandler) CleanOldFiles(pc []string) error {
+	dirs := make(map[string]bool, len(pc))
+	for _, dir := range pc {
+		dirs[filepath.Clean(dir)] = true
+	}
+
+	rows, err := s.db.Query(`SELECT path FROM responses WHERE written_at < ?`,
+		time.Now().Add(-72*time.Hour).Unix())
+	if err != nil {
+		return fmt.Errorf("could not list old responses: %w", err)
+	}
+	var old []string
+	for rows.Next() {
+		var path string
+		if err = rows.Scan(&path); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("could not list old responses: %w", err)
+		}
+		if dirs[filepath.Dir(path)] && cachedFileRegex.MatchString(filepath.Base(path)) {
+			old = append(old, path)
+		}
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("could not list old responses: %w", err)
+	}
+
+	var errs []error
+	for _, path := range old {
+		if _, err = s.db.Exec(`DELETE FROM responses WHERE path = ?`, path); err != nil {
+			errs = append(errs, fmt.Errorf("could not remove %s: %w", path, err))
+		}
+	}
+	if err = s.files.CleanOldFiles(pc); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// EnsureDirectoryExists creates a directory, the settings files and the lockfile still need them
+func (s *SQLiteFsHandler) EnsureDirectoryExists(dir string) error {
+	return s.files.EnsureDirectoryExists(dir)
+}
+
+// ListFiles returns the full paths of the responses and the files in dir
+func (s *SQLiteFsHandler) ListFiles(dir string) ([]string, error) {
+	files, err := s.files.ListFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	dir = filepath.Clean(dir)
+	prefix := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(dir + string(filepath.Separator))
+	rows, err := s.db.Query(`SELECT path FROM responses WHERE path LIKE ? ESCAPE '\' ORDER BY path`, prefix+"%")
+	if err != nil {
+		return nil, fmt.Errorf("listing directory failed: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var path string
+		if err = rows.Scan(&path); err != nil {
+			return nil, fmt.Errorf("listing directory failed: %w", err)
+		}
+		if filepath.Dir(path) == dir {
+			files = append(files, path)
+		}
+	}
+	return files, rows.Err()
+}
+
+// RemoveFile deletes a response, e.g. a corrupt one so it is fetched again. A missing response is not an error.
+func (s *SQLiteFsHandler) RemoveFile(file string) error {
+	if isSettingsFile(file) {
+		return s.files.RemoveFile(file)
+	}
+	if _, err := s.db.Exec(`DELETE FROM responses WHERE path = ?`, file); err != nil {
+		return fmt.Errorf("removing file failed: %w", err)
+	}
+	return nil
+}
+
+// GamesWithPoints returns the box score lines of a season with at least min points, the most points first,
+// e.g. all 40-point games of the season
+func (s *SQLiteFsHandler) GamesWithPoints(season string, min int) ([]types.BoxScoreLine, error) {
+	rows, err := s.db.Query(`SELECT l.game_id, COALESCE(g.game_date, ''), l.season, l.player_id, l.name, l.team_id,
+			l.minutes, l.pts, l.reb, l.ast
+		FROM box_score_lines l LEFT JOIN games g ON g.game_id = l.game_id
+		WHERE l.season = ? AND l.pts >= ?
+		ORDER BY l.pts DESC, g.game_date, l.game_id`, season, min)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the box scores: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []types.BoxScoreLine
+	for rows.Next() {
+		var l types.BoxScoreLine
+		if err = rows.Scan(&l.GameID, &l.GameDate, &l.Season, &l.PlayerID, &l.PlayerName, &l.TeamID, &l.Minutes,
+			&l.Points, &l.Rebounds, &l.Assists); err != nil {
+			return nil, fmt.Errorf("could not query the box scores: %w", err)
+		}
+		lines = append(lines, l)
+	}
+	return lines, rows.Err()
+}
+
+// normalizeResponse fills the normalized tables from a response: the games of a scoreboard, the lines of a box
+// score and the players of a player index. Other responses, and ones which are not valid JSON, are only stored raw.
+func normalizeResponse(tx *sql.Tx, data []byte) error {
+	var rs types.ResponseSet
+	if err := json.Unmarshal(data, &rs); err != nil {
+		return nil
+	}
+
+	if rs.Scoreboard != nil {
+		for _, g := range rs.Scoreboard.Games {
+			if err := upsertGame(tx, g.GameID, rs.Scoreboard.GameDate, g.GameStatus, g.HomeTeam.TeamID,
+				g.AwayTeam.TeamID, g.HomeTeam.Score, g.AwayTeam.Score); err != nil {
+				return err
+			}
+		}
+	}
+
+	boxScore := rs.BoxScore
+	if boxScore.GameID == "" && rs.LiveGame != nil {
+		boxScore = types.BoxScore{GameID: rs.LiveGame.GameID, HomeTeam: rs.LiveGame.HomeTeam,
+			AwayTeam: rs.LiveGame.AwayTeam}
+	}
+	if boxScore.GameID != "" {
+		if err := insertBoxScore(tx, boxScore); err != nil {
+			return err
+		}
+	}
+
+	for _, set := range append(rs.ResultSets, rs.ResultSet) {
+		if err := upsertPlayers(tx, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upsertGame stores a game, an empty date or a zero status keep the ones already stored
+func upsertGame(tx *sql.Tx, gameID, date string, status, homeID, awayID, homePts, awayPts int) error {
+	var gameDate, gameStatus interface{}
+	if date != "" {
+		gameDate = date
+	}
+	if status != 0 {
+		gameStatus = status
+	}
+	_, err := tx.Exec(`INSERT INTO games (game_id, game_date, season, status, home_team_id, away_team_id,
+			home_pts, away_pts)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (game_id) DO UPDATE SET game_date = COALESCE(excluded.game_date, games.game_date),
+			status = COALESCE(excluded.status, games.status), home_team_id = excluded.home_team_id,
+			away_team_id = excluded.away_team_id, home_pts = excluded.home_pts, away_pts = excluded.away_pts`,
+		gameID, gameDate, seasonOfGame(gameID), gameStatus, homeID, awayID, homePts, awayPts)
+	if err != nil {
+		return fmt.Errorf("could not store game %s: %w", gameID, err)
+	}
+	return nil
+}
+
+func insertBoxScore(tx *sql.Tx, b types.BoxScore) error {
+	if err := upsertGame(tx, b.GameID, "", 0, b.HomeTeam.TeamID, b.AwayTeam.TeamID,
+		b.HomeTeam.TeamGameStatistics.Points, b.AwayTeam.TeamGameStatistics.Points); err != nil {
+		return err
+	}
+
+	season := seasonOfGame(b.GameID)
+	for _, team := range []types.BoxScoreTeam{b.HomeTeam, b.AwayTeam} {
+		for _, p := range team.BoxScorePlayers {
+			st := p.Statistics
+			_, err := tx.Exec(`INSERT OR REPLACE INTO box_score_lines (game_id, player_id, team_id, season, name,
+					minutes, pts, reb, ast, stl, blk, tov, fgm, fga, fg3m, fg3a, ftm, fta, plus_minus)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				b.GameID, p.PersonId, team.TeamID, season, strings.TrimSpace(p.FirstName+" "+p.FamilyName),
+				st.Minutes, st.Points, st.ReboundsTotal, st.Assists, st.Steals, st.Blocks, st.Turnovers,
+				st.FieldGoalsMade, st.FieldGoalsAttempted, st.ThreePointersMade, st.ThreePointersAttempted,
+				st.FreeThrowsMade, st.FreeThrowsAttempted, st.PlusMinusPoints)
+			if err != nil {
+				return fmt.Errorf("could not store the box score line of player %d: %w", p.PersonId, err)
+			}
+		}
+	}
+	return nil
+}
+
+// upsertPlayers stores the players of a player index result set, other result sets are skipped
+func upsertPlayers(tx *sql.Tx, set types.ResultSet) error {
+	idx := make(map[string]int, len(set.Headers))
+	for i, h := range set.Headers {
+		idx[h] = i
+	}
+	for _, h := range []string{"PERSON_ID", "PLAYER_FIRST_NAME", "PLAYER_LAST_NAME", "TEAM_ID", "POSITION"} {
+		if _, ok := idx[h]; !ok {
+			return nil
+		}
+	}
+
+	now := time.Now().Unix()
+	for _, row := range set.RowSet {
+		if len(row) < len(set.Headers) {
+			continue
+		}
+		id, ok := row[idx["PERSON_ID"]].(float64)
+		if !ok {
+			continue
+		}
+		first, _ := row[idx["PLAYER_FIRST_NAME"]].(string)
+		last, _ := row[idx["PLAYER_LAST_NAME"]].(string)
+		teamID, _ := row[idx["TEAM_ID"]].(float64)
+		position, _ := row[idx["POSITION"]].(string)
+		_, err := tx.Exec(`INSERT OR REPLACE INTO players (player_id, name, team_id, position, updated_at)
+			VALUES (?, ?, ?, ?, ?)`, int(id), strings.TrimSpace(first+" "+last), int(teamID), position, now)
+		if err != nil {
+			return fmt.Errorf("could not store player %d: %w", int(id), err)
+		}
+	}
+	return nil
+}
+
+// seasonOfGame returns the season of a game from its id, e.g. "2024-25" for "0022400123"
+func seasonOfGame(gameID string) string {
+	if len(gameID) < 5 {
+		return ""
+	}
+	var year int
+	if _, err := fmt.Sscanf(gameID[3:5], "%02d", &year); err != nil {
+		return ""
+	}
+	century := 2000
+	if year >= 46 {
+		century = 1900
+	}
+	return fmt.Sprintf("%d-%02d", century+year, (year+1)%100)
+}
@@ -0,0 +1,215 @@
+package filesystemops
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+)
+
+func newTestSQLite(t *testing.T) *SQLiteFsHandler {
+	t.Helper()
+	h, err := openSQLite("file::memory:", &DefaultFsHandler{})
+	if err != nil {
+		t.Fatalf("openSQLite() error: %v", err)
+	}
+	t.Cleanup(func() { _ = h.db.Close() })
+	return h
+}
+
+const testScoreboard = `{"scoreboard": {"gameDate": "2025-01-15", "games": [
+	{"gameId": "0022400123", "gameStatus": 3,
+	 "homeTeam": {"teamId": 1610612738, "score": 120}, "awayTeam": {"teamId": 1610612742, "score": 115}}]}}`
+
+const testBoxScore = `{"boxScoreTraditional": {"gameId": "0022400123", "homeTeamId": 1610612738, "awayTeamId": 1610612742,
+	"homeTeam": {"teamId": 1610612738, "statistics": {"points": 120}, "players": [
+		{"personId": 1628369, "firstName": "Jayson", "familyName": "Tatum", "statistics": {"minutes": "40:12", "points": 41, "reboundsTotal": 9, "assists": 6}},
+		{"personId": 1627759, "firstName": "Jaylen", "familyName": "Brown", "statistics": {"minutes": "36:01", "points": 22}}]},
+	"awayTeam": {"teamId": 1610612742, "statistics": {"points": 115}, "players": [
+		{"personId": 1629029, "firstName": "Luka", "familyName": "Doncic", "statistics": {"minutes": "38:40", "points": 45, "reboundsTotal": 10, "assists": 11}}]}}}`
+
+func TestSQLiteFsHandler_WriteReadRemove(t *testing.T) {
+	h := newTestSQLite(t)
+	path := "/home/u/.config/nba-tui/boxscores/2025-01-15_0022400123"
+	big := `{"payload": "` + strings.Repeat("a", 2000) + `"}`
+
+	if _, err := h.ReadFile(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadFile() of a missing response = %v, want os.ErrNotExist", err)
+	}
+	if err := h.WriteFile(path, []byte(`{}`)); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if h.FileExists(path) {
+		t.Error("expected a response smaller than a cached one not to count")
+	}
+	if err := h.WriteFile(path, []byte(big)); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if data, err := h.ReadFile(path); err != nil || string(data) != big {
+		t.Errorf("ReadFile() = %d bytes, %v, want the replaced response", len(data), err)
+	}
+	if !h.FileExists(path) {
+		t.Error("expected the response to exist")
+	}
+	if err := h.RemoveFile(path); err != nil {
+		t.Fatalf("RemoveFile() error: %v", err)
+	}
+	if h.FileExists(path) {
+		t.Error("expected the response to be removed")
+	}
+}
+
+func TestSQLiteFsHandler_SettingsStayFiles(t *testing.T) {
+	h := newTestSQLite(t)
+	path := filepath.Join(t.TempDir(), "favorites.json")
+
+	if err := h.WriteFile(path, []byte(`{"teams": ["1610612738"]}`)); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("expected the settings to be written to a file, got %v", err)
+	}
+	var rows int
+	if err := h.db.QueryRow(`SELECT count(*) FROM responses`).Scan(&rows); err != nil || rows != 0 {
+		t.Errorf("responses = %d (%v), want none", rows, err)
+	}
+}
+
+func TestSQLiteFsHandler_GamesWithPoints(t *testing.T) {
+	h := newTestSQLite(t)
+	if err := h.WriteFile("/c/2025-01-15_dsb", []byte(testScoreboard)); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.WriteFile("/c/boxscores/2025-01-16_0022400123", []byte(testBoxScore)); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err := h.GamesWithPoints("2024-25", 40)
+	if err != nil {
+		t.Fatalf("GamesWithPoints() error: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("GamesWithPoints() = %d lines, want 2", len(lines))
+	}
+	luka := lines[0]
+	if luka.PlayerName != "Luka Doncic" || luka.Points != 45 || luka.GameDate != "2025-01-15" || luka.TeamID != 1610612742 {
+		t.Errorf("first line = %+v, want Luka Doncic's 45 points on 2025-01-15", luka)
+	}
+	if lines[1].PlayerName != "Jayson Tatum" || lines[1].Rebounds != 9 || lines[1].Minutes != "40:12" {
+		t.Errorf("second line = %+v, want Jayson Tatum's 41 points", lines[1])
+	}
+
+	if lines, _ = h.GamesWithPoints("2023-24", 40); len(lines) != 0 {
+		t.Errorf("expected no lines of another season, got %d", len(lines))
+	}
+
+	var status, homePts int
+	if err = h.db.QueryRow(`SELECT status, home_pts FROM games WHERE game_id = '0022400123'`).Scan(&status, &homePts); err != nil {
+		t.Fatal(err)
+	}
+	if status != 3 || homePts != 120 {
+		t.Errorf("game status %d home points %d, want 3 and 120", status, homePts)
+	}
+}
+
+func TestSQLiteFsHandler_Players(t *testing.T) {
+	h := newTestSQLite(t)
+	index := `{"resultSets": [{"name": "PlayerIndex",
+		"headers": ["PERSON_ID", "PLAYER_LAST_NAME", "PLAYER_FIRST_NAME", "TEAM_ID", "POSITION"],
+		"rowSet": [[1628369, "Tatum", "Jayson", 1610612738, "F"], [1629029, "Doncic", "Luka", 1610612747, "G"]]}]}`
+	if err := h.WriteFile("/c/teamplayers/2025-01-15_league", []byte(index)); err != nil {
+		t.Fatal(err)
+	}
+
+	var name string
+	var teamID int
+	if err := h.db.QueryRow(`SELECT name, team_id FROM players WHERE player_id = 1629029`).Scan(&name, &teamID); err != nil {
+		t.Fatal(err)
+	}
+	if name != "Luka Doncic" || teamID != 1610612747 {
+		t.Errorf("player = %s of team %d, want Luka Doncic of 1610612747", name, teamID)
+	}
+}
+
+func TestSQLiteFsHandler_CleanOldFilesAndList(t *testing.T) {
+	h := newTestSQLite(t)
+	dir := t.TempDir()
+	for _, path := range []string{
+		dir + "/2025-01-10_dsb", dir + "/2025-01-15_dsb", dir + "/alltimeleaders_Totals",
+		dir + "/boxscores/2025-01-10_0022400001",
+	} {
+		if err := h.WriteFile(path, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-96 * time.Hour).Unix()
+	if _, err := h.db.Exec(`UPDATE responses SET written_at = ? WHERE path LIKE '%2025-01-10%' OR path LIKE '%alltime%'`, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := h.CleanOldFiles([]string{dir + "/", dir + "/shotcharts/"}); err != nil {
+		t.Fatalf("CleanOldFiles() error: %v", err)
+	}
+	files, err := h.ListFiles(dir)
+	if err != nil {
+		t.Fatalf("ListFiles() error: %v", err)
+	}
+	want := []string{dir + "/2025-01-15_dsb", dir + "/alltimeleaders_Totals"}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Errorf("ListFiles() = %v, want %v", files, want)
+	}
+	if !h.fileStored(dir + "/boxscores/2025-01-10_0022400001") {
+		t.Error("expected the responses of directories which were not cleaned to be kept")
+	}
+}
+
+func (s *SQLiteFsHandler) fileStored(path string) bool {
+	_, err := s.ReadFile(path)
+	return err == nil
+}
+
+func TestSQLiteFsHandler_DataLoader(t *testing.T) {
+	h := newTestSQLite(t)
+	paths := pathManager.PathFactoryForDate("2025-01-15")
+	if err := h.WriteFile(paths.GetFullPath("dailyScores", ""), []byte(testScoreboard)); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := NewDataLoader(h, paths).LoadDailyScoreboard()
+	if err != nil {
+		t.Fatalf("LoadDailyScoreboard() error: %v", err)
+	}
+	if rs.Scoreboard == nil || len(rs.Scoreboard.Games) != 1 || rs.Scoreboard.Games[0].GameID != "0022400123" {
+		t.Errorf("unexpected scoreboard %+v", rs.Scoreboard)
+	}
+}
+
+func TestLoadStorageConfig(t *testing.T) {
+	paths := &storageTestPaths{dir: t.TempDir()}
+	fs := &DefaultFsHandler{}
+
+	cfg, err := LoadStorageConfig(fs, paths)
+	if err != nil || cfg.Backend != BackendFiles {
+		t.Errorf("LoadStorageConfig() = %+v, %v, want the files without a config", cfg, err)
+	}
+	if err = os.WriteFile(paths.GetFullPath("storage", ""), []byte(`{"backend": "sqlite"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, err = LoadStorageConfig(fs, paths); err != nil || cfg.Backend != BackendSQLite {
+		t.Errorf("LoadStorageConfig() = %+v, %v, want sqlite", cfg, err)
+	}
+}
+
+// storageTestPaths puts the config files in a temporary directory
+type storageTestPaths struct {
+	pathManager.PathManager
+	dir string
+}
+
+func (p *storageTestPaths) GetFullPath(fileType, id string) string {
+	return filepath.Join(p.dir, fileType+".json")
+}
@@ -0,0 +1,59 @@
+package filesystemops
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+)
+
+// the storage backends of the storage config
+const (
+	BackendFiles  = "files"
+	BackendSQLite = "sqlite"
+)
+
+// StorageConfig selects where the API responses are cached, it is read from storage.json in the config directory
+type StorageConfig struct {
+	Backend string `json:"backend"`
+}
+
+// LoadStorageConfig reads the storage config, the responses are cached in files when there is none
+func LoadStorageConfig(fs FileSystemHandler, paths pathManager.PathManager) (StorageConfig, error) {
+	cfg := StorageConfig{Backend: BackendFiles}
+	path := paths.GetFullPath("storage", "")
+	data, err := ReadConfigFile(fs, path)
+	if err != nil || data == nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return StorageConfig{Backend: BackendFiles}, fmt.Errorf("could not unmarshal storage config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// NewStorage returns the FileSystemHandler of the configured backend. An unknown backend, or a database which
+// cannot be opened, is logged and the files are used instead.
+func NewStorage(paths pathManager.PathManager) FileSystemHandler {
+	files := NewDefaultFsHandler()
+	cfg, err := LoadStorageConfig(files, paths)
+	if err != nil {
+		log.Println(err)
+	}
+
+	switch cfg.Backend {
+	case BackendFiles, "":
+		return files
+	case BackendSQLite:
+		db, err := OpenSQLiteFsHandler(paths.GetFullPath("database", ""), files)
+		if err != nil {
+			log.Printf("could not open the database, caching in files: %v", err)
+			return files
+		}
+		return db
+	default:
+		log.Printf("unknown storage backend %q, caching in files", cfg.Backend)
+		return files
+	}
+}
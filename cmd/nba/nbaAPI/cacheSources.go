@@ -0,0 +1,30 @@
+package nbaAPI
+
+import (
+	"fmt"
+	"sync"
+)
+
+// cacheSources remembers the URL each cached file is fetched from, so a corrupt file can be fetched again
+// by the loader. Files are remembered when they are fetched or found in the cache, by any client.
+var cacheSources sync.Map
+
+func rememberSource(path string, reqURL RequestURL) {
+	cacheSources.Store(path, reqURL)
+}
+
+// Refetch downloads the file at path again from where it was fetched, replacing the cached file
+func (c *Client) Refetch(path string) error {
+	reqURL, ok := cacheSources.Load(path)
+	if !ok {
+		return fmt.Errorf("no known source for %s", path)
+	}
+	data, err := c.http.Get(reqURL.(RequestURL))
+	if err != nil {
+		return fmt.Errorf("api error: %w", err)
+	}
+	if err = c.FileSystem.WriteFile(path, data); err != nil {
+		return fmt.Errorf("write error for %s: %w", path, err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package nbaAPI
 
 import (
+	"encoding/json"
 	"fmt"
 	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
 	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
@@ -27,10 +28,22 @@ type RequestBuilder interface {
 	BuildTeamInfoRequest(teamID string) RequestURL
 	BuildPlayerIndexRequest(teamID string) RequestURL
 	BuildPlayerInfoRequest(playerID string) RequestURL
-	BuildPlayerCareerStatsRequest(playerID string) RequestURL
+	BuildPlayerCareerStatsRequest(playerID string, perMode PerMode) RequestURL
 	BuildPlayerGameLogRequest(playerID string) RequestURL
 	BuildLeagueSeriesStandingsRequest(season string) RequestURL
 	BuildCommonPlayoffSeriesRequest(season string) RequestURL
+	BuildSeasonScheduleRequest(season string) RequestURL
+	BuildShotChartRequest(playerID, teamID string, filter types.ShotChartFilter) RequestURL
+	BuildTeamShotLocationsRequest(season, measureType string) RequestURL
+	BuildLeagueGameLogRequest(season string) RequestURL
+	BuildPlayerDashboardRequest(playerID, dashboard, season string) RequestURL
+	BuildPlayerAwardsRequest(playerID string) RequestURL
+	BuildDraftHistoryRequest(year string) RequestURL
+	BuildAllTimeLeadersRequest(perMode PerMode) RequestURL
+	BuildFranchiseLeadersRequest(teamID string) RequestURL
+	BuildFranchisePlayersRequest(teamID string) RequestURL
+	BuildTeamLineupsRequest(teamID, season string, groupQuantity int) RequestURL
+	BuildTeamOnOffRequest(teamID, season string) RequestURL
 }
 
 type nbaRequestBuilder struct {
@@ -163,10 +176,10 @@ func (rb *nbaRequestBuilder) BuildPlayerInfoRequest(playerID string) RequestURL
 	return rb.buildURL(params)
 }
 
-func (rb *nbaRequestBuilder) BuildPlayerCareerStatsRequest(playerID string) RequestURL {
+func (rb *nbaRequestBuilder) BuildPlayerCareerStatsRequest(playerID string, perMode PerMode) RequestURL {
 	params := PlayerProfileV2Params{
 		PlayerID: playerID,
-		PerMode:  "PerGame",
+		PerMode:  perMode,
 	}
 	return rb.buildURL(params)
 }
@@ -207,15 +220,17 @@ func (h *HTTPClient) Get(url RequestURL) ([]byte, error) {
 // NewClient instantiates a *Client struct with the relevant interface implementations
 func NewClient() *Client {
 	dateProvider := NewDateProvider()
-	return &Client{
-		Dates:      dateProvider,
-		http:       NewHTTPClient(),
-		requests:   NewRequestBuilder(BaseURL, dateProvider),
-		Paths:      pathManager.PathFactory(dateProvider, ""),
-		FileSystem: filesystemops.NewDefaultFsHandler(),
-		Loader: filesystemops.NewDataLoader(filesystemops.NewDefaultFsHandler(),
-			pathManager.PathFactory(dateProvider, "")),
-	}
+	c := &Client{
+		Dates:    dateProvider,
+		http:     NewHTTPClient(),
+		requests: NewRequestBuilder(BaseURL, dateProvider),
+		Paths:    pathManager.PathFactory(dateProvider, ""),
+	}
+	// the loader reads the raw responses through the handler, so it works on either storage backend
+	c.FileSystem = filesystemops.NewStorage(c.Paths)
+	c.Loader = filesystemops.NewRefetchingDataLoader(c.FileSystem,
+		pathManager.PathFactory(dateProvider, ""), c.Refetch)
+	return c
 }
 
 // MakeDefaultRequests is responsible for executing the initial API calls (concurrently) to NBA when the TUI is loaded
@@ -237,6 +252,7 @@ func (c *Client) MakeDefaultRequests() error {
 				defer func() { dChan <- struct{}{} }()
 
 				path := c.Paths.GetFullPath(name, "")
+				rememberSource(path, reqURL)
 
 				if name != "dailyScores" && c.FileSystem.FileExists(path) {
 					return
@@ -282,6 +298,7 @@ func (c *Client) FetchBoxScore(param string) error {
 		switch name {
 		case "boxScore":
 			path := c.Paths.GetFullPath(name, param)
+			rememberSource(path, reqURL)
 			if !c.FileSystem.FileExists(path) {
 				data, err := c.http.Get(reqURL)
 				if err != nil {
@@ -304,6 +321,7 @@ func (c *Client) FetchBoxScore(param string) error {
 func (c *Client) FetchLiveBoxScore(gameID string) error {
 	cdnURL := RequestURL(fmt.Sprintf("https://cdn.nba.com/static/json/liveData/boxscore/boxscore_%s.json", gameID))
 	path := c.Paths.GetFullPath("boxScore", gameID)
+	rememberSource(path, cdnURL)
 	data, err := c.http.Get(cdnURL)
 	if err != nil {
 		return fmt.Errorf("api error: %w", err)
@@ -314,6 +332,26 @@ func (c *Client) FetchLiveBoxScore(gameID string) error {
 	return nil
 }
 
+// liveScoreboardURL is the scoreboard of the day on the NBA CDN, updated while the games are played
+const liveScoreboardURL RequestURL = "https://cdn.nba.com/static/json/liveData/scoreboard/todaysScoreboard_00.json"
+
+// FetchLiveScoreboard returns the games of the day with their live status, score, period and clock.
+// It is polled, so nothing is cached.
+func (c *Client) FetchLiveScoreboard() ([]types.ScoreboardV3Game, error) {
+	data, err := c.http.Get(liveScoreboardURL)
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+	var rs types.ResponseSet
+	if err = json.Unmarshal(data, &rs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal live scoreboard: %w", err)
+	}
+	if rs.Scoreboard == nil {
+		return nil, fmt.Errorf("no scoreboard in the live scoreboard response")
+	}
+	return rs.Scoreboard.Games, nil
+}
+
 func (c *Client) FetchDailyScoresForDate(date string) error {
 	reqURL := c.requests.BuildDailyScoresRequestForDate(date)
 	if reqURL == "" {
@@ -322,6 +360,7 @@ func (c *Client) FetchDailyScoresForDate(date string) error {
 
 	datePaths := pathManager.PathFactoryForDate(date)
 	path := datePaths.GetFullPath("dailyScores", "")
+	rememberSource(path, reqURL)
 
 	data, err := c.http.Get(reqURL)
 	if err != nil {
@@ -338,7 +377,7 @@ func (c *Client) FetchDailyScoresForDate(date string) error {
 // FetchPlayerProfile calls three NBA API endpoints concurrently for a player and writes responses to cache.
 func (c *Client) FetchPlayerProfile(playerID string) error {
 	infoURL := c.requests.BuildPlayerInfoRequest(playerID)
-	careerURL := c.requests.BuildPlayerCareerStatsRequest(playerID)
+	careerURL := c.requests.BuildPlayerCareerStatsRequest(playerID, PerModePerGame)
 	gameLogURL := c.requests.BuildPlayerGameLogRequest(playerID)
 
 	requests := map[string]RequestURL{
@@ -354,6 +393,7 @@ func (c *Client) FetchPlayerProfile(playerID string) error {
 		go func(name string, reqURL RequestURL) {
 			defer func() { dChan <- struct{}{} }()
 			path := c.Paths.GetFullPath(name, playerID)
+			rememberSource(path, reqURL)
 			if c.FileSystem.FileExists(path) {
 				return
 			}
@@ -385,6 +425,7 @@ func (c *Client) FetchPlayoffBracket(season string) error {
 		return fmt.Errorf("failed to build playoff bracket request for season %s", season)
 	}
 	path := c.Paths.GetFullPath("playoffBracket", season)
+	rememberSource(path, reqURL)
 	if c.FileSystem.FileExists(path) {
 		return nil
 	}
@@ -401,6 +442,7 @@ func (c *Client) FetchCommonPlayoffSeries(season string) error {
 		return fmt.Errorf("failed to build playoff series request for season %s", season)
 	}
 	path := c.Paths.GetFullPath("playoffSeriesGames", season)
+	rememberSource(path, reqURL)
 	if c.FileSystem.FileExists(path) {
 		return nil
 	}
@@ -411,6 +453,170 @@ func (c *Client) FetchCommonPlayoffSeries(season string) error {
 	return c.FileSystem.WriteFile(path, data)
 }
 
+// FetchSeasonSchedule downloads the full league schedule (played and upcoming games) of a season.
+// The file is cached for the day, so results and the remaining schedule stay reasonably fresh.
+func (c *Client) FetchSeasonSchedule(season string) error {
+	reqURL := c.requests.BuildSeasonScheduleRequest(season)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build season schedule request for season %s", season)
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("seasonSchedule", season))
+}
+
+// FetchShotChart calls the shotchartdetail endpoint for a player, or for a team when playerID is empty,
+// and caches the response for the day
+func (c *Client) FetchShotChart(playerID, teamID string, filter types.ShotChartFilter) error {
+	reqURL := c.requests.BuildShotChartRequest(playerID, teamID, filter)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build shot chart request for player %s team %s", playerID, teamID)
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("shotChart", filter.CacheID(playerID, teamID)))
+}
+
+// FetchTeamShotLocations calls the leaguedashteamshotlocations endpoint, which covers all teams at once,
+// and caches the response for the day
+func (c *Client) FetchTeamShotLocations(season, measureType string) error {
+	reqURL := c.requests.BuildTeamShotLocationsRequest(season, measureType)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build team shot locations request for season %s", season)
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("teamShotLocations", season+"_"+measureType))
+}
+
+// FetchLeagueGameLog downloads the game log of every team in a season and caches it for the day
+func (c *Client) FetchLeagueGameLog(season string) error {
+	reqURL := c.requests.BuildLeagueGameLogRequest(season)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build league game log request for season %s", season)
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("leagueGameLog", season))
+}
+
+// FetchPlayerCareerStats downloads the career stats of a player in the given PerMode. The per game stats are
+// part of the player profile, so they usually come from the cache.
+func (c *Client) FetchPlayerCareerStats(playerID string, perMode PerMode) error {
+	reqURL := c.requests.BuildPlayerCareerStatsRequest(playerID, perMode)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build %s career stats request for player %s", perMode, playerID)
+	}
+	path := c.Paths.GetFullPath("playerCareerStats", types.CareerStatsCacheID(playerID, string(perMode)))
+	return c.fetchToCache(reqURL, path)
+}
+
+// FetchPlayerAwards downloads the awards of a player, which are cached like the rest of the profile
+func (c *Client) FetchPlayerAwards(playerID string) error {
+	reqURL := c.requests.BuildPlayerAwardsRequest(playerID)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build awards request for player %s", playerID)
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("playerAwards", playerID))
+}
+
+// FetchDraftHistory downloads the picks of the draft of the given year. A draft never changes once it is held,
+// so it is cached permanently.
+func (c *Client) FetchDraftHistory(year string) error {
+	reqURL := c.requests.BuildDraftHistoryRequest(year)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build draft history request for %s", year)
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("draftHistory", year))
+}
+
+// FetchLeaguePlayerIndex downloads the player index of every team at once, news is tagged with its players.
+// It is cached for the day, rosters change with every transaction.
+func (c *Client) FetchLeaguePlayerIndex() error {
+	reqURL := c.requests.BuildPlayerIndexRequest("0")
+	if reqURL == "" {
+		return fmt.Errorf("failed to build league player index request")
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("leaguePlayerIndex", ""))
+}
+
+// FetchAllTimeLeaders downloads the all-time leaders of every category. They are cached permanently,
+// the career totals of the all-time greats move too slowly to be worth refreshing.
+func (c *Client) FetchAllTimeLeaders(perMode PerMode) error {
+	reqURL := c.requests.BuildAllTimeLeadersRequest(perMode)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build all-time leaders request")
+	}
+	return c.fetchToCache(reqURL, c.Paths.GetFullPath("allTimeLeaders", string(perMode)))
+}
+
+// FetchFranchise downloads the franchise leaders and the franchise players of a team, both cached permanently
+func (c *Client) FetchFranchise(teamID string) error {
+	requests := []struct {
+		reqURL   RequestURL
+		fileType string
+	}{
+		{c.requests.BuildFranchiseLeadersRequest(teamID), "franchiseLeaders"},
+		{c.requests.BuildFranchisePlayersRequest(teamID), "franchisePlayers"},
+	}
+	for _, r := range requests {
+		if r.reqURL == "" {
+			return fmt.Errorf("failed to build %s request for team %s", r.fileType, teamID)
+		}
+		if err := c.fetchToCache(r.reqURL, c.Paths.GetFullPath(r.fileType, teamID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FetchTeamLineups downloads the lineups of every size in LineupGroupSizes and the on/off summary of a team,
+// one after another. Like all dashboards they are cached for the day.
+func (c *Client) FetchTeamLineups(teamID, season string) error {
+	for _, size := range LineupGroupSizes {
+		reqURL := c.requests.BuildTeamLineupsRequest(teamID, season, size)
+		if reqURL == "" {
+			return fmt.Errorf("failed to build %d-man lineups request for team %s", size, teamID)
+		}
+		path := c.Paths.GetFullPath("teamDashboard", types.TeamLineupsCacheID(teamID, size, season))
+		if err := c.fetchToCache(reqURL, path); err != nil {
+			return fmt.Errorf("%d-man lineups: %w", size, err)
+		}
+	}
+
+	reqURL := c.requests.BuildTeamOnOffRequest(teamID, season)
+	if reqURL == "" {
+		return fmt.Errorf("failed to build on/off request for team %s", teamID)
+	}
+	if err := c.fetchToCache(reqURL, c.Paths.GetFullPath("teamDashboard", teamID+"_onoff_"+season)); err != nil {
+		return fmt.Errorf("on/off: %w", err)
+	}
+	return nil
+}
+
+// FetchPlayerSplits downloads the split dashboards of a player for a season and caches them for the day
+func (c *Client) FetchPlayerSplits(playerID, season string) error {
+	for _, dashboard := range PlayerDashboards {
+		reqURL := c.requests.BuildPlayerDashboardRequest(playerID, dashboard, season)
+		if reqURL == "" {
+			return fmt.Errorf("failed to build %s dashboard request for player %s", dashboard, playerID)
+		}
+		path := c.Paths.GetFullPath("playerDashboard", playerID+"_"+dashboard+"_"+season)
+		if err := c.fetchToCache(reqURL, path); err != nil {
+			return fmt.Errorf("%s dashboard: %w", dashboard, err)
+		}
+	}
+	return nil
+}
+
+// fetchToCache calls the NBA API and writes the response to path, unless a valid file already exists there
+func (c *Client) fetchToCache(reqURL RequestURL, path string) error {
+	rememberSource(path, reqURL)
+	if c.FileSystem.FileExists(path) {
+		return nil
+	}
+	data, err := c.http.Get(reqURL)
+	if err != nil {
+		return fmt.Errorf("api error: %w", err)
+	}
+	if err = c.FileSystem.WriteFile(path, data); err != nil {
+		return fmt.Errorf("write error for %s: %w", path, err)
+	}
+	return nil
+}
+
 // FetchTeamProfile calls the NBA API with a teamID and writes the response to a file.
 // That file will be used by LoadTeamInfo to feed basic team info into the TUI
 func (c *Client) FetchTeamProfile(param string) error {
@@ -433,6 +639,7 @@ func (c *Client) FetchTeamProfile(param string) error {
 				defer func() { dChan <- struct{}{} }()
 
 				path := c.Paths.GetFullPath(name, param)
+				rememberSource(path, reqURL)
 				data, err := c.http.Get(reqURL)
 				if err != nil {
 					eChan <- fmt.Errorf("api error: %w", err)
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
 )
 
 type MockDateProvider struct {
@@ -114,7 +118,7 @@ func (m *MockRequestBuilder) BuildPlayerInfoRequest(playerID string) RequestURL
 	return RequestURL("https://example.com/playerinfo?PlayerID=" + playerID)
 }
 
-func (m *MockRequestBuilder) BuildPlayerCareerStatsRequest(playerID string) RequestURL {
+func (m *MockRequestBuilder) BuildPlayerCareerStatsRequest(playerID string, perMode PerMode) RequestURL {
 	if m.buildPlayerCareerStatsRequests != nil {
 		return m.buildPlayerCareerStatsRequests(playerID)
 	}
@@ -136,6 +140,54 @@ func (m *MockRequestBuilder) BuildCommonPlayoffSeriesRequest(season string) Requ
 	return RequestURL("https://example.com/commonPlayoffSeries?Season=" + season)
 }
 
+func (m *MockRequestBuilder) BuildSeasonScheduleRequest(season string) RequestURL {
+	return RequestURL("https://example.com/scheduleleaguev2?Season=" + season)
+}
+
+func (m *MockRequestBuilder) BuildShotChartRequest(playerID, teamID string, filter types.ShotChartFilter) RequestURL {
+	return RequestURL("https://example.com/shotchartdetail?PlayerID=" + playerID + "&TeamID=" + teamID + "&Season=" + filter.Season)
+}
+
+func (m *MockRequestBuilder) BuildTeamShotLocationsRequest(season, measureType string) RequestURL {
+	return RequestURL("https://example.com/leaguedashteamshotlocations?Season=" + season + "&MeasureType=" + measureType)
+}
+
+func (m *MockRequestBuilder) BuildLeagueGameLogRequest(season string) RequestURL {
+	return RequestURL("https://example.com/leaguegamelog?Season=" + season)
+}
+
+func (m *MockRequestBuilder) BuildAllTimeLeadersRequest(perMode PerMode) RequestURL {
+	return RequestURL("https://example.com/alltimeleadersgrids?PerMode=" + string(perMode))
+}
+
+func (m *MockRequestBuilder) BuildFranchiseLeadersRequest(teamID string) RequestURL {
+	return RequestURL("https://example.com/franchiseleaders?TeamID=" + teamID)
+}
+
+func (m *MockRequestBuilder) BuildFranchisePlayersRequest(teamID string) RequestURL {
+	return RequestURL("https://example.com/franchiseplayers?TeamID=" + teamID)
+}
+
+func (m *MockRequestBuilder) BuildTeamLineupsRequest(teamID, season string, groupQuantity int) RequestURL {
+	return RequestURL(fmt.Sprintf("https://example.com/teamdashlineups?TeamID=%s&GroupQuantity=%d", teamID, groupQuantity))
+}
+
+func (m *MockRequestBuilder) BuildTeamOnOffRequest(teamID, season string) RequestURL {
+	return RequestURL("https://example.com/teamplayeronoffsummary?TeamID=" + teamID)
+}
+
+func (m *MockRequestBuilder) BuildDraftHistoryRequest(year string) RequestURL {
+	return RequestURL("https://example.com/drafthistory?Season=" + year)
+}
+
+func (m *MockRequestBuilder) BuildPlayerAwardsRequest(playerID string) RequestURL {
+	return RequestURL("https://example.com/playerawards?PlayerID=" + playerID)
+}
+
+func (m *MockRequestBuilder) BuildPlayerDashboardRequest(playerID, dashboard, season string) RequestURL {
+	return RequestURL("https://example.com/playerdashboardby" + dashboard + "?PlayerID=" + playerID + "&Season=" + season)
+}
+
 func (m *MockDateProvider) GetCurrentDate() (string, error) {
 	return m.currentDate, m.dateError
 }
@@ -193,6 +245,14 @@ func (m *MockFileSystem) CleanOldFiles(path []string) error {
 	return nil
 }
 
+func (m *MockFileSystem) ListFiles(dir string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockFileSystem) RemoveFile(path string) error {
+	return nil
+}
+
 func (m *MockPathManager) GetBasePaths() []string {
 	if m.basePathsFunc != nil {
 		return m.basePathsFunc()
@@ -661,12 +721,19 @@ func TestNbaRequestBuilder_BuildPlayerCareerStatsRequest(t *testing.T) {
 	mockDates := &MockDateProvider{currentSeason: "2024-25"}
 	rb := NewRequestBuilder(BaseURL, mockDates)
 
-	got := string(rb.BuildPlayerCareerStatsRequest("1628389"))
+	got := string(rb.BuildPlayerCareerStatsRequest("1628389", PerModePerGame))
 	want := "https://stats.nba.com/stats/playercareerstats?PerMode=PerGame&PlayerID=1628389"
 
 	if !urlsEqual(t, want, got) {
 		t.Errorf("BuildPlayerCareerStatsRequest() got %s, want %s", got, want)
 	}
+
+	got = string(rb.BuildPlayerCareerStatsRequest("1628389", PerModePer36))
+	want = "https://stats.nba.com/stats/playercareerstats?PerMode=Per36&PlayerID=1628389"
+
+	if !urlsEqual(t, want, got) {
+		t.Errorf("BuildPlayerCareerStatsRequest(Per36) got %s, want %s", got, want)
+	}
 }
 
 func TestNbaRequestBuilder_BuildPlayerGameLogRequest(t *testing.T) {
@@ -822,3 +889,173 @@ func TestFetchCommonPlayoffSeries_SkipsIfCached(t *testing.T) {
 		t.Error("expected http.Get NOT to be called when file is cached")
 	}
 }
+
+func TestInjuryReportURL(t *testing.T) {
+	eastern, _ := time.LoadLocation("America/New_York")
+	at := time.Date(2025, 1, 15, 17, 30, 0, 0, eastern)
+	want := RequestURL("https://ak-static.cms.nba.com/referee/injury/Injury-Report_2025-01-15_05PM.pdf")
+	if got := InjuryReportURL(at); got != want {
+		t.Errorf("InjuryReportURL() = %s, want %s", got, want)
+	}
+}
+
+func TestClient_FetchInjuryReport(t *testing.T) {
+	var calls int
+	var written []byte
+	mockHTTP := &MockHTTPClient{
+		getFunc: func(url RequestURL) ([]byte, error) {
+			calls++
+			// the latest two hourly reports are not published yet
+			if calls <= 2 {
+				return nil, errors.New("API returned status 403")
+			}
+			return []byte("%PDF-1.4"), nil
+		},
+	}
+	mockFS := &MockFileSystem{
+		fileExistsFunc: func(path string) bool { return true },
+		writeFileFunc: func(path string, data []byte) error {
+			written = data
+			return nil
+		},
+	}
+	client := &Client{
+		http:       mockHTTP,
+		Paths:      &MockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/" + name }},
+		FileSystem: mockFS,
+	}
+
+	if err := client.FetchInjuryReport(false); err != nil || calls != 0 {
+		t.Fatalf("cached report: err = %v, http calls = %d", err, calls)
+	}
+
+	if err := client.FetchInjuryReport(true); err != nil {
+		t.Fatalf("FetchInjuryReport() unexpected error: %v", err)
+	}
+	if calls != 3 || string(written) != "%PDF-1.4" {
+		t.Errorf("expected the third hourly report to be written, calls = %d, written = %q", calls, written)
+	}
+
+	mockHTTP.getFunc = func(url RequestURL) ([]byte, error) { return nil, errors.New("API returned status 403") }
+	if err := client.FetchInjuryReport(true); err == nil {
+		t.Error("expected an error when no report was published")
+	}
+}
+
+func TestClient_FetchInjuryReport_RemembersMiss(t *testing.T) {
+	var calls int
+	files := make(map[string][]byte)
+	client := &Client{
+		http: &MockHTTPClient{getFunc: func(url RequestURL) ([]byte, error) {
+			calls++
+			return nil, errors.New("API returned status 403")
+		}},
+		Paths: &MockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/" + name }},
+		FileSystem: &MockFileSystem{
+			fileExistsFunc: func(path string) bool { return len(files[path]) > 1000 },
+			readFileFunc: func(path string) ([]byte, error) {
+				if data, ok := files[path]; ok {
+					return data, nil
+				}
+				return nil, os.ErrNotExist
+			},
+			writeFileFunc: func(path string, data []byte) error {
+				files[path] = data
+				return nil
+			},
+		},
+	}
+
+	if err := client.FetchInjuryReport(false); !errors.Is(err, ErrNoInjuryReport) || calls != injuryReportLookback {
+		t.Fatalf("first fetch: err = %v, http calls = %d, want ErrNoInjuryReport after %d calls", err, calls, injuryReportLookback)
+	}
+	calls = 0
+	if err := client.FetchInjuryReport(false); !errors.Is(err, ErrNoInjuryReport) || calls != 0 {
+		t.Errorf("remembered miss: err = %v, http calls = %d, want ErrNoInjuryReport without a request", err, calls)
+	}
+	if err := client.FetchInjuryReport(true); err == nil || calls != injuryReportLookback {
+		t.Errorf("refresh: err = %v, http calls = %d, want the hourly reports to be tried again", err, calls)
+	}
+}
+
+func TestClient_FetchTransactions(t *testing.T) {
+	var requested RequestURL
+	var written string
+	client := &Client{
+		http: &MockHTTPClient{getFunc: func(url RequestURL) ([]byte, error) {
+			requested = url
+			return []byte(`{"NBA_Player_Movement":{"rows":[]}}`), nil
+		}},
+		Paths: &MockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/" + name }},
+		FileSystem: &MockFileSystem{
+			fileExistsFunc: func(path string) bool { return false },
+			writeFileFunc: func(path string, data []byte) error {
+				written = path
+				return nil
+			},
+		},
+	}
+
+	if err := client.FetchTransactions(); err != nil {
+		t.Fatalf("FetchTransactions() unexpected error: %v", err)
+	}
+	if requested != transactionsURL || written != "/tmp/transactions" {
+		t.Errorf("expected the feed to be cached, requested %s, written %s", requested, written)
+	}
+}
+
+func TestClient_FetchLiveScoreboard(t *testing.T) {
+	client := &Client{
+		http: &MockHTTPClient{getFunc: func(url RequestURL) ([]byte, error) {
+			if url != liveScoreboardURL {
+				t.Errorf("unexpected URL %s", url)
+			}
+			return []byte(`{"scoreboard":{"gameDate":"2025-01-15","games":[{"gameId":"0022400001","gameStatus":2,
+				"period":4,"gameClock":"PT01M45.00S","homeTeam":{"teamId":1610612747,"score":97},
+				"awayTeam":{"teamId":1610612738,"score":100}}]}}`), nil
+		}},
+	}
+
+	games, err := client.FetchLiveScoreboard()
+	if err != nil {
+		t.Fatalf("FetchLiveScoreboard() unexpected error: %v", err)
+	}
+	if len(games) != 1 || games[0].GameClock != "PT01M45.00S" || games[0].AwayTeam.Score != 100 {
+		t.Errorf("unexpected games %+v", games)
+	}
+}
+
+func TestClient_Refetch(t *testing.T) {
+	var written []byte
+	client := &Client{
+		http: &MockHTTPClient{getFunc: func(url RequestURL) ([]byte, error) {
+			return []byte(`{"resultSets":[]}`), nil
+		}},
+		Paths: &MockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/refetch_" + name }},
+		FileSystem: &MockFileSystem{
+			fileExistsFunc: func(path string) bool { return true },
+			writeFileFunc: func(path string, data []byte) error {
+				written = data
+				return nil
+			},
+		},
+	}
+
+	if err := client.Refetch("/tmp/refetch_unknown"); err == nil {
+		t.Error("expected an error for a file without a known source")
+	}
+
+	// the cached file is skipped, but its source is remembered
+	if err := client.fetchToCache("https://example.com/schedule", "/tmp/refetch_seasonSchedule"); err != nil {
+		t.Fatal(err)
+	}
+	if written != nil {
+		t.Fatal("expected the cached file to be skipped")
+	}
+	if err := client.Refetch("/tmp/refetch_seasonSchedule"); err != nil {
+		t.Fatalf("Refetch() error: %v", err)
+	}
+	if string(written) != `{"resultSets":[]}` {
+		t.Errorf("expected the file to be written again, got %s", written)
+	}
+}
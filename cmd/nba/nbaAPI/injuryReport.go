@@ -0,0 +1,60 @@
+package nbaAPI
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// injuryReportURL is where the league publishes the official injury report, one PDF per hourly update
+// named after its date and hour in Eastern Time, e.g. Injury-Report_2025-01-15_05PM.pdf
+const injuryReportURL = "https://ak-static.cms.nba.com/referee/injury/Injury-Report_%s_%s.pdf"
+
+// injuryReportLookback is the number of hourly updates tried, newest first, to find the latest injury report
+const injuryReportLookback = 12
+
+// ErrNoInjuryReport is returned when no injury report was published lately, e.g. in the offseason
+var ErrNoInjuryReport = errors.New("no injury report published")
+
+// InjuryReportURL returns the URL of the injury report published at the hour of t
+func InjuryReportURL(t time.Time) RequestURL {
+	return RequestURL(fmt.Sprintf(injuryReportURL, t.Format("2006-01-02"), t.Format("03PM")))
+}
+
+// FetchInjuryReport downloads the latest official injury report. The report is updated once an hour, so a
+// cached report is kept for the rest of the hour unless refresh is set. Finding no report is remembered for the
+// day, without it every roster would try all the hourly URLs again.
+func (c *Client) FetchInjuryReport(refresh bool) error {
+	path := c.Paths.GetFullPath("injuryReport", "")
+	missing := c.Paths.GetFullPath("injuryReportMissing", "")
+	if !refresh {
+		if c.FileSystem.FileExists(path) {
+			return nil
+		}
+		if _, err := c.FileSystem.ReadFile(missing); err == nil {
+			return fmt.Errorf("%w today", ErrNoInjuryReport)
+		}
+	}
+
+	eastern, _ := time.LoadLocation("America/New_York")
+	now := time.Now().In(eastern)
+	for i := 0; i < injuryReportLookback; i++ {
+		reqURL := InjuryReportURL(now.Add(-time.Duration(i) * time.Hour))
+		data, err := c.http.Get(reqURL)
+		if err != nil || len(data) == 0 {
+			continue
+		}
+		log.Printf("using injury report %s", reqURL)
+		if err = c.FileSystem.WriteFile(path, data); err != nil {
+			return fmt.Errorf("write error for injury report: %w", err)
+		}
+		// a report published after a miss was remembered replaces the miss
+		_ = c.FileSystem.RemoveFile(missing)
+		return nil
+	}
+	if err := c.FileSystem.WriteFile(missing, []byte(now.Format(time.RFC3339))); err != nil {
+		log.Printf("could not remember the missing injury report: %v", err)
+	}
+	return fmt.Errorf("%w in the last %d hours", ErrNoInjuryReport, injuryReportLookback)
+}
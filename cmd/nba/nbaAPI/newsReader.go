@@ -0,0 +1,250 @@
+package nbaAPI
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+)
+
+// ArticleText is the readable text of a news article
+type ArticleText struct {
+	URL        string
+	Title      string
+	Byline     string
+	Published  string
+	Paragraphs []string
+}
+
+var (
+	ldJSONScript  = regexp.MustCompile(`(?is)<script[^>]*type="application/ld\+json"[^>]*>(.*?)</script>`)
+	articleBlock  = regexp.MustCompile(`(?is)<article[^>]*>(.*?)</article>`)
+	paragraphTag  = regexp.MustCompile(`(?is)<p[^>]*>(.*?)</p>`)
+	headingTag    = regexp.MustCompile(`(?is)<h1[^>]*>(.*?)</h1>`)
+	titleTag      = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	metaTag       = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	metaAttribute = regexp.MustCompile(`(?is)(name|property|content)\s*=\s*"([^"]*)"`)
+	noiseBlock    = regexp.MustCompile(`(?is)<(script|style|noscript|aside|nav|footer|figure)[^>]*>.*?</(script|style|noscript|aside|nav|footer|figure)>`)
+)
+
+// minParagraphLength drops the captions, share buttons and credits a page has between its paragraphs
+const minParagraphLength = 40
+
+// articleID is the name of the cached text of an article
+func articleID(url string) string {
+	sum := sha1.Sum([]byte(articleKey(url)))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// FetchArticle returns the readable text of an article, downloaded once a day and cached next to the headlines
+func (nc *NewsClient) FetchArticle(url string) (ArticleText, error) {
+	cacheFile := nc.Paths.GetFullPath("newsArticle", articleID(url))
+	if nc.FileSystem.FileExists(cacheFile) {
+		// a corrupt cache is removed by ReadCachedJSON and the article downloaded again
+		var cached ArticleText
+		if filesystemops.ReadCachedJSON(nc.FileSystem, cacheFile, &cached) == nil && len(cached.Paragraphs) > 0 {
+			return cached, nil
+		}
+	}
+
+	page, err := getPage(nc.client, url)
+	if err != nil {
+		return ArticleText{}, err
+	}
+	article, err := extractArticle(page, url)
+	if err != nil {
+		return ArticleText{}, err
+	}
+
+	if dirErr := nc.FileSystem.EnsureDirectoryExists(nc.Paths.GetFullPath("newsCachePath", "")); dirErr != nil {
+		log.Printf("error creating news directory: %v", dirErr)
+	}
+	data, err := json.Marshal(article)
+	if err != nil {
+		return ArticleText{}, err
+	}
+	if writeErr := nc.FileSystem.WriteFile(cacheFile, data); writeErr != nil {
+		log.Printf("error writing article cache: %v", writeErr)
+	}
+	return article, nil
+}
+
+// extractArticle finds the readable text of an article page. Most news sites describe their articles with
+// schema.org JSON-LD, which is used when it has the body. Otherwise the paragraphs of the page's <article>,
+// or of the whole page, are the text and its meta tags name the title and author.
+func extractArticle(page []byte, url string) (ArticleText, error) {
+	article := ArticleText{URL: url}
+	content := string(page)
+
+	if ld, ok := ldJSONArticle(content); ok {
+		article.Title = cleanHTML(ld.Headline)
+		article.Byline = ld.author()
+		article.Published = ld.DatePublished
+		if d, ok := parsePublished(ld.DatePublished); ok {
+			article.Published = d.Local().Format("2006-01-02 15:04")
+		}
+		article.Paragraphs = splitParagraphs(ld.ArticleBody)
+	}
+
+	meta := metaTags(content)
+	if article.Title == "" {
+		article.Title = firstNonEmpty(meta["og:title"], meta["twitter:title"], firstMatch(headingTag, content),
+			firstMatch(titleTag, content))
+	}
+	if article.Byline == "" {
+		article.Byline = firstNonEmpty(meta["author"], meta["article:author"])
+	}
+	if article.Published == "" {
+		if d, ok := parsePublished(meta["article:published_time"]); ok {
+			article.Published = d.Local().Format("2006-01-02 15:04")
+		}
+	}
+
+	if len(article.Paragraphs) == 0 {
+		body := noiseBlock.ReplaceAllString(content, "")
+		if m := articleBlock.FindStringSubmatch(body); m != nil {
+			body = m[1]
+		}
+		for _, p := range paragraphTag.FindAllStringSubmatch(body, -1) {
+			text := strings.Join(strings.Fields(cleanHTML(p[1])), " ")
+			if len(text) >= minParagraphLength {
+				article.Paragraphs = append(article.Paragraphs, text)
+			}
+		}
+	}
+
+	if len(article.Paragraphs) == 0 {
+		return ArticleText{}, fmt.Errorf("no readable text found on %s", url)
+	}
+	return article, nil
+}
+
+// ldArticle is the part of a schema.org NewsArticle the reader uses
+type ldArticle struct {
+	Type          interface{} `json:"@type"`
+	Headline      string      `json:"headline"`
+	DatePublished string      `json:"datePublished"`
+	ArticleBody   string      `json:"articleBody"`
+	Author        interface{} `json:"author"`
+	Graph         []ldArticle `json:"@graph"`
+}
+
+// author returns the names of the authors, which are a name, a person or a list of either
+func (a ldArticle) author() string {
+	var names []string
+	var collect func(v interface{})
+	collect = func(v interface{}) {
+		switch author := v.(type) {
+		case string:
+			names = append(names, author)
+		case map[string]interface{}:
+			if name, ok := author["name"].(string); ok {
+				names = append(names, name)
+			}
+		case []interface{}:
+			for _, el := range author {
+				collect(el)
+			}
+		}
+	}
+	collect(a.Author)
+	return strings.Join(names, ", ")
+}
+
+// isArticle tells if the JSON-LD object is an article, its type is a name or a list of names
+func (a ldArticle) isArticle() bool {
+	var typeNames []string
+	switch t := a.Type.(type) {
+	case string:
+		typeNames = []string{t}
+	case []interface{}:
+		for _, el := range t {
+			if s, ok := el.(string); ok {
+				typeNames = append(typeNames, s)
+			}
+		}
+	}
+	for _, t := range typeNames {
+		if strings.HasSuffix(t, "Article") || t == "BlogPosting" {
+			return true
+		}
+	}
+	return false
+}
+
+// ldJSONArticle returns the first article of the JSON-LD of a page which has a body
+func ldJSONArticle(content string) (ldArticle, bool) {
+	for _, m := range ldJSONScript.FindAllStringSubmatch(content, -1) {
+		var candidates []ldArticle
+		var single ldArticle
+		if json.Unmarshal([]byte(m[1]), &single) == nil {
+			candidates = append(append(candidates, single), single.Graph...)
+		} else if json.Unmarshal([]byte(m[1]), &candidates) != nil {
+			continue
+		}
+		for _, c := range candidates {
+			if c.isArticle() && strings.TrimSpace(c.ArticleBody) != "" {
+				return c, true
+			}
+		}
+	}
+	return ldArticle{}, false
+}
+
+// splitParagraphs splits the body of an article on its blank lines, or on its lines when it has no blank lines
+func splitParagraphs(body string) []string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	sep := "\n\n"
+	if !strings.Contains(body, sep) {
+		sep = "\n"
+	}
+	var paragraphs []string
+	for _, p := range strings.Split(body, sep) {
+		if text := strings.Join(strings.Fields(cleanHTML(p)), " "); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	return paragraphs
+}
+
+// metaTags returns the content of the meta tags of a page by their name or property
+func metaTags(content string) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range metaTag.FindAllString(content, -1) {
+		var key, value string
+		for _, attr := range metaAttribute.FindAllStringSubmatch(tag, -1) {
+			if strings.EqualFold(attr[1], "content") {
+				value = attr[2]
+			} else {
+				key = strings.ToLower(attr[2])
+			}
+		}
+		if key != "" && value != "" {
+			if _, ok := tags[key]; !ok {
+				tags[key] = cleanHTML(value)
+			}
+		}
+	}
+	return tags
+}
+
+func firstMatch(re *regexp.Regexp, content string) string {
+	if m := re.FindStringSubmatch(content); m != nil {
+		return cleanHTML(m[1])
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
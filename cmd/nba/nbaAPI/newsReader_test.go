@@ -0,0 +1,96 @@
+package nbaAPI
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const ldJSONPage = `<html><head><title>Ignored | Site</title>
+<script type="application/ld+json">{"@context":"https://schema.org","@graph":[{"@type":"WebPage","name":"x"},
+{"@type":["NewsArticle"],"headline":"Lakers trade for Doncic","datePublished":"2025-02-02T06:00:00Z",
+"author":[{"@type":"Person","name":"Shams Charania"},{"name":"Dave McMenamin"}],
+"articleBody":"The Lakers acquired Luka Doncic.\n\nAnthony Davis heads to Dallas."}]}</script>
+</head><body><p>Should not be used because the JSON-LD has the body of the article.</p></body></html>`
+
+const paragraphPage = `<html><head><meta property="og:title" content="Power Rankings &amp; more">
+<meta name="author" content="John Schuhmann"><meta property="article:published_time" content="2025-02-03T15:00:00Z">
+</head><body><nav><p>Menu item that is long enough to count as a paragraph, but in the nav</p></nav>
+<article><h1>Power Rankings</h1><p>Share</p>
+<p>The Cavaliers remain on top of the rankings after <a href="/x">another win</a>.</p>
+<figure><p>A caption which is long enough to pass the paragraph length check</p></figure>
+<p>The Thunder are right behind them with the best defense in the league.</p></article>
+<footer><p>Copyright notice that is long enough to count as a paragraph of text</p></footer></body></html>`
+
+func TestExtractArticle_LDJSON(t *testing.T) {
+	a, err := extractArticle([]byte(ldJSONPage), "https://example.com/doncic")
+	if err != nil {
+		t.Fatalf("extractArticle() error: %v", err)
+	}
+	if a.Title != "Lakers trade for Doncic" || a.Byline != "Shams Charania, Dave McMenamin" || a.Published == "" {
+		t.Errorf("unexpected article %+v", a)
+	}
+	if len(a.Paragraphs) != 2 || a.Paragraphs[1] != "Anthony Davis heads to Dallas." {
+		t.Errorf("unexpected paragraphs %q", a.Paragraphs)
+	}
+}
+
+func TestExtractArticle_Paragraphs(t *testing.T) {
+	a, err := extractArticle([]byte(paragraphPage), "https://www.nba.com/news/power-rankings")
+	if err != nil {
+		t.Fatalf("extractArticle() error: %v", err)
+	}
+	if a.Title != "Power Rankings & more" || a.Byline != "John Schuhmann" || a.Published == "" {
+		t.Errorf("unexpected article %+v", a)
+	}
+	if len(a.Paragraphs) != 2 || !strings.HasSuffix(a.Paragraphs[0], "after another win.") {
+		t.Errorf("expected the two paragraphs of the article, got %q", a.Paragraphs)
+	}
+
+	if _, err := extractArticle([]byte("<html><body><p>Short</p></body></html>"), "x"); err == nil {
+		t.Error("expected an error for a page without readable text")
+	}
+}
+
+func TestNewsClient_FetchArticleCaches(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(ldJSONPage))
+	}))
+	defer server.Close()
+
+	cache := make(map[string][]byte)
+	nc := &NewsClient{
+		client: server.Client(),
+		Paths: &MockPathManager{fullPathFunc: func(name, param string) string {
+			return "/tmp/" + name + "_" + param
+		}},
+		FileSystem: &MockFileSystem{
+			fileExistsFunc: func(path string) bool { _, ok := cache[path]; return ok },
+			readFileFunc:   func(path string) ([]byte, error) { return cache[path], nil },
+			writeFileFunc: func(path string, data []byte) error {
+				if !strings.HasPrefix(path, "/tmp/newsArticle_") {
+					t.Errorf("unexpected cache path %s", path)
+				}
+				cache[path] = data
+				return nil
+			},
+			dirExistsFunc: func(string) error { return nil },
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		a, err := nc.FetchArticle(server.URL + "/doncic")
+		if err != nil {
+			t.Fatalf("FetchArticle() error: %v", err)
+		}
+		if a.Title != "Lakers trade for Doncic" {
+			t.Errorf("unexpected title %s", a.Title)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected the second read to come from the cache, got %d requests", requests)
+	}
+}
@@ -2,14 +2,16 @@ package nbaAPI
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
 	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
-	"io"
+	"html"
 	"log"
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -19,20 +21,76 @@ type NewsClient struct {
 	client     *http.Client
 	FileSystem filesystemops.FileSystemHandler
 	Paths      pathManager.PathManager
+	Sources    []NewsSource
 }
 
+// NewsArticle is a headline of a news source. Timestamp is the publish date as shown, empty when the source
+// does not tell it. Teams and Players are the ones the headline mentions, once tagged by a NewsTagger.
 type NewsArticle struct {
 	Title     string
 	Timestamp string
 	URL       string
+	Summary   string
+	Source    string
+	Published time.Time
+	Teams     []string
+	Players   []string
 }
 
+// NewNewsClient instantiates a NewsClient reading the configured news sources
 func NewNewsClient(fs filesystemops.FileSystemHandler, paths pathManager.PathManager) *NewsClient {
-	return &NewsClient{
+	nc := &NewsClient{
 		client:     &http.Client{Timeout: 10 * time.Second},
 		FileSystem: fs,
 		Paths:      paths,
 	}
+
+	configs, err := LoadNewsSourceConfigs(fs, paths)
+	if err != nil {
+		log.Printf("error loading news sources, using the default ones: %v", err)
+	}
+	for _, cfg := range configs {
+		source, err := NewNewsSource(cfg, nc.client)
+		if err != nil {
+			log.Printf("skipping news source: %v", err)
+			continue
+		}
+		nc.Sources = append(nc.Sources, source)
+	}
+	return nc
+}
+
+// Fetch reads the articles of every source concurrently and merges them, a failing source is skipped
+func (nc *NewsClient) Fetch() ([]NewsArticle, error) {
+	if len(nc.Sources) == 0 {
+		return nil, fmt.Errorf("no news sources configured")
+	}
+
+	results := make([][]NewsArticle, len(nc.Sources))
+	errs := make([]error, len(nc.Sources))
+	var wg sync.WaitGroup
+	for i, source := range nc.Sources {
+		wg.Add(1)
+		go func(i int, source NewsSource) {
+			defer wg.Done()
+			results[i], errs[i] = source.Fetch()
+		}(i, source)
+	}
+	wg.Wait()
+
+	failed := 0
+	for i, err := range errs {
+		if err != nil {
+			log.Printf("error fetching news from %s: %v", nc.Sources[i].Name(), err)
+			failed++
+			continue
+		}
+		log.Printf("Found %d articles on %s", len(results[i]), nc.Sources[i].Name())
+	}
+	if failed == len(nc.Sources) {
+		return nil, fmt.Errorf("all %d news sources failed", failed)
+	}
+	return MergeArticles(results...), nil
 }
 
 func (nc *NewsClient) FetchNews() ([]NewsArticle, error) {
@@ -45,12 +103,13 @@ func (nc *NewsClient) FetchNews() ([]NewsArticle, error) {
 	}
 
 	if nc.FileSystem.FileExists(cacheFile) {
-		cacheData, err := nc.FileSystem.ReadFile(cacheFile)
-		if err != nil {
+		// a corrupt cache is removed by ReadCachedJSON and the news fetched again
+		var cachedArticles []NewsArticle
+		err := filesystemops.ReadCachedJSON(nc.FileSystem, cacheFile, &cachedArticles)
+		if err != nil && !errors.Is(err, filesystemops.ErrCorruptCache) {
 			return nil, err
 		}
-		var cachedArticles []NewsArticle
-		if json.Unmarshal(cacheData, &cachedArticles) == nil && len(cachedArticles) > 0 {
+		if err == nil && len(cachedArticles) > 0 {
 			log.Println("Using cached articles")
 			return cachedArticles, nil
 		}
@@ -66,9 +125,9 @@ func (nc *NewsClient) FetchNews() ([]NewsArticle, error) {
 		},
 	}
 
-	articles, err := nc.Scrape()
+	articles, err := nc.Fetch()
 	if err != nil {
-		log.Printf("error scraping news: %v", err)
+		log.Printf("error fetching news: %v", err)
 		return defaultArticles, nil
 	}
 
@@ -90,85 +149,12 @@ func (nc *NewsClient) FetchNews() ([]NewsArticle, error) {
 	return articles, nil
 }
 
-func (nc *NewsClient) Scrape() ([]NewsArticle, error) {
-	req, err := http.NewRequest("GET", nbaNewsURL, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36")
-
-	resp, err := nc.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch news: %d", resp.StatusCode)
-	}
-
-	log.Println("Reading response body")
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	content := string(body)
-	log.Printf("Response body length: %d bytes", len(content))
-
-	var articles []NewsArticle
-	articlePattern := `<a\s+[^>]*href="(/news/[^"]+)"[^>]*>\s*<div[^>]*>.*?<div[^>]*>.*?<h\d[^>]*>(.*?)</h\d>`
-	articleRegex := regexp.MustCompile(articlePattern)
-
-	matches := articleRegex.FindAllStringSubmatch(content, -1)
-
-	seenURLs := make(map[string]bool)
-	for _, match := range matches {
-		if len(match) >= 3 {
-			url := "https://www.nba.com" + match[1]
-			title := cleanHTML(match[2])
-
-			if seenURLs[url] {
-				continue
-			}
-			seenURLs[url] = true
-
-			articles = append(articles, NewsArticle{
-				Title:     title,
-				Timestamp: time.Now().Format("2006-01-02"),
-				URL:       url,
-			})
-
-			if len(articles) >= 10 {
-				break
-			}
-		}
-	}
-
-	log.Printf("Found %d articles", len(articles))
-	return articles, nil
-}
+var htmlTag = regexp.MustCompile(`<[^>]+>`)
 
-func cleanHTML(html string) string {
-	re := regexp.MustCompile(`<[^>]+>`)
-	text := re.ReplaceAllString(html, "")
-
-	text = strings.ReplaceAll(text, "&amp;", "&")
-	text = strings.ReplaceAll(text, "&lt;", "<")
-	text = strings.ReplaceAll(text, "&gt;", ">")
-	text = strings.ReplaceAll(text, "&quot;", "\"")
-	text = strings.ReplaceAll(text, "&#39;", "'")
-	text = strings.ReplaceAll(text, "&#x27;", "'")
-	text = strings.ReplaceAll(text, "&apos;", "'")
-	text = strings.ReplaceAll(text, "&#96;", "`")
-	text = strings.ReplaceAll(text, "&#x60;", "`")
-	text = strings.ReplaceAll(text, "&ndash;", "–")
-	text = strings.ReplaceAll(text, "&mdash;", "—")
-	text = strings.ReplaceAll(text, "&#8211;", "–")
-	text = strings.ReplaceAll(text, "&#8212;", "—")
-	text = strings.ReplaceAll(text, "&nbsp;", " ") // Non-breaking space
-
-	text = strings.TrimSpace(text)
-
-	return text
+// cleanHTML strips the markup of a text and decodes its entities
+func cleanHTML(text string) string {
+	text = htmlTag.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	text = strings.ReplaceAll(text, "\u00a0", " ") // Non-breaking space
+	return strings.TrimSpace(text)
 }
@@ -0,0 +1,332 @@
+package nbaAPI
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+)
+
+// the kinds of news sources
+const (
+	NewsSourceFeed = "feed" // an RSS or Atom feed
+	NewsSourceNBA  = "nba"  // a page of nba.com, which embeds its articles as JSON
+)
+
+const newsUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36"
+
+// NewsSource is a place news articles are read from
+type NewsSource interface {
+	Name() string
+	Fetch() ([]NewsArticle, error)
+}
+
+// NewsSourceConfig configures a news source, the sources are read from news_sources.json in the config directory
+type NewsSourceConfig struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// DefaultNewsSources are used until the user configures their own
+var DefaultNewsSources = []NewsSourceConfig{
+	{Name: "NBA.com", Type: NewsSourceNBA, URL: nbaNewsURL},
+	{Name: "ESPN", Type: NewsSourceFeed, URL: "https://www.espn.com/espn/rss/nba/news"},
+	{Name: "CBS Sports", Type: NewsSourceFeed, URL: "https://www.cbssports.com/rss/headlines/nba/"},
+}
+
+// LoadNewsSourceConfigs reads the configured news sources, or the default ones when none are configured
+func LoadNewsSourceConfigs(fs filesystemops.FileSystemHandler, paths pathManager.PathManager) ([]NewsSourceConfig, error) {
+	path := paths.GetFullPath("newsSources", "")
+	data, err := filesystemops.ReadConfigFile(fs, path)
+	if err != nil || data == nil {
+		return DefaultNewsSources, err
+	}
+	var configs []NewsSourceConfig
+	if err = json.Unmarshal(data, &configs); err != nil {
+		return DefaultNewsSources, fmt.Errorf("could not unmarshal news sources %s: %w", path, err)
+	}
+	return configs, nil
+}
+
+// NewNewsSource instantiates the source a config describes
+func NewNewsSource(cfg NewsSourceConfig, client *http.Client) (NewsSource, error) {
+	name := cfg.Name
+	if name == "" {
+		name = cfg.URL
+	}
+	switch strings.ToLower(cfg.Type) {
+	case NewsSourceFeed, "rss", "atom":
+		return &FeedSource{name: name, url: cfg.URL, client: client}, nil
+	case NewsSourceNBA:
+		return &NBAEmbeddedSource{name: name, url: cfg.URL, client: client}, nil
+	}
+	return nil, fmt.Errorf("unknown type %q of news source %s", cfg.Type, name)
+}
+
+// getPage downloads a page the way a browser would
+func getPage(client *http.Client, pageURL string) ([]byte, error) {
+	req, err := http.NewRequest("GET", pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", newsUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %d", pageURL, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return body, nil
+}
+
+// FeedSource reads the articles of an RSS 2.0 or Atom feed
+type FeedSource struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+// feedDocument holds the items of an RSS feed or the entries of an Atom feed, whichever the document is
+type feedDocument struct {
+	Items []struct {
+		Title       string `xml:"title"`
+		Link        string `xml:"link"`
+		GUID        string `xml:"guid"`
+		PubDate     string `xml:"pubDate"`
+		DCDate      string `xml:"http://purl.org/dc/elements/1.1/ date"`
+		Description string `xml:"description"`
+	} `xml:"channel>item"`
+	Entries []struct {
+		Title string `xml:"title"`
+		Links []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+		Summary   string `xml:"summary"`
+		Content   string `xml:"content"`
+	} `xml:"entry"`
+}
+
+func (s *FeedSource) Name() string { return s.name }
+
+func (s *FeedSource) Fetch() ([]NewsArticle, error) {
+	body, err := getPage(s.client, s.url)
+	if err != nil {
+		return nil, err
+	}
+	return parseFeed(body, s.name)
+}
+
+// parseFeed reads the articles of an RSS or Atom document
+func parseFeed(data []byte, source string) ([]NewsArticle, error) {
+	var doc feedDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse feed %s: %w", source, err)
+	}
+
+	var articles []NewsArticle
+	for _, item := range doc.Items {
+		link := strings.TrimSpace(item.Link)
+		if link == "" && strings.HasPrefix(item.GUID, "http") {
+			link = strings.TrimSpace(item.GUID)
+		}
+		date := item.PubDate
+		if date == "" {
+			date = item.DCDate
+		}
+		articles = appendArticle(articles, item.Title, link, date, item.Description, source)
+	}
+	for _, entry := range doc.Entries {
+		var link string
+		for _, l := range entry.Links {
+			if l.Rel == "" || l.Rel == "alternate" {
+				link = l.Href
+				break
+			}
+		}
+		date := entry.Published
+		if date == "" {
+			date = entry.Updated
+		}
+		summary := entry.Summary
+		if summary == "" {
+			summary = entry.Content
+		}
+		articles = appendArticle(articles, entry.Title, link, date, summary, source)
+	}
+	return articles, nil
+}
+
+// NBAEmbeddedSource reads the articles nba.com embeds as JSON into its pages for its page renderer
+type NBAEmbeddedSource struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+var nextDataScript = regexp.MustCompile(`(?s)<script[^>]*id="__NEXT_DATA__"[^>]*>(.*?)</script>`)
+
+// the keys nba.com uses for the fields of an article
+var (
+	nbaLinkKeys    = []string{"permalink", "url", "link", "canonicalUrl"}
+	nbaDateKeys    = []string{"date", "publishedDate", "datePublished", "publishDate", "published", "modified"}
+	nbaSummaryKeys = []string{"excerpt", "summary", "description", "subheadline", "dek"}
+)
+
+func (s *NBAEmbeddedSource) Name() string { return s.name }
+
+func (s *NBAEmbeddedSource) Fetch() ([]NewsArticle, error) {
+	body, err := getPage(s.client, s.url)
+	if err != nil {
+		return nil, err
+	}
+	return parseNBAEmbedded(body, s.name)
+}
+
+// parseNBAEmbedded finds the articles in the JSON embedded into a page of nba.com. Every object with a title
+// and a link to a news article is one.
+func parseNBAEmbedded(page []byte, source string) ([]NewsArticle, error) {
+	m := nextDataScript.FindSubmatch(page)
+	if m == nil {
+		return nil, fmt.Errorf("no embedded data found on %s", source)
+	}
+	var data interface{}
+	if err := json.Unmarshal(m[1], &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal embedded data of %s: %w", source, err)
+	}
+
+	var articles []NewsArticle
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			title, _ := node["title"].(string)
+			link := firstString(node, nbaLinkKeys)
+			if title != "" && strings.Contains(link, "/news/") {
+				if strings.HasPrefix(link, "/") {
+					link = "https://www.nba.com" + link
+				}
+				articles = appendArticle(articles, title, link, firstString(node, nbaDateKeys),
+					firstString(node, nbaSummaryKeys), source)
+			}
+			for _, child := range node {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range node {
+				walk(child)
+			}
+		}
+	}
+	walk(data)
+	return articles, nil
+}
+
+func firstString(node map[string]interface{}, keys []string) string {
+	for _, k := range keys {
+		if s, ok := node[k].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// appendArticle adds an article with its publish date parsed and its title and summary stripped of markup
+func appendArticle(articles []NewsArticle, title, link, date, summary, source string) []NewsArticle {
+	title = cleanHTML(title)
+	if title == "" || link == "" {
+		return articles
+	}
+	a := NewsArticle{
+		Title:   title,
+		URL:     link,
+		Summary: strings.Join(strings.Fields(cleanHTML(summary)), " "),
+		Source:  source,
+	}
+	if published, ok := parsePublished(date); ok {
+		a.Published = published
+		a.Timestamp = published.Local().Format("2006-01-02 15:04")
+	}
+	return append(articles, a)
+}
+
+var publishedLayouts = []string{
+	time.RFC1123Z, time.RFC1123, time.RFC3339, time.RFC822Z, time.RFC822,
+	"Mon, 2 Jan 2006 15:04:05 -0700", "Mon, 2 Jan 2006 15:04:05 MST",
+	"2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02",
+}
+
+// parsePublished reads a publish date in any of the formats feeds use
+func parsePublished(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range publishedLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// articleKey is the URL of an article without its query, fragment and trailing slash, as sources link the
+// same article differently
+func articleKey(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return rawURL
+	}
+	return strings.ToLower(u.Host) + strings.TrimSuffix(u.Path, "/")
+}
+
+// MergeArticles merges the articles of several sources, newest first. An article found more than once is kept
+// once, with the publish date and summary of whichever source had them.
+func MergeArticles(lists ...[]NewsArticle) []NewsArticle {
+	var merged []NewsArticle
+	index := make(map[string]int)
+	for _, list := range lists {
+		for _, a := range list {
+			key := articleKey(a.URL)
+			i, seen := index[key]
+			if !seen {
+				index[key] = len(merged)
+				merged = append(merged, a)
+				continue
+			}
+			if merged[i].Published.IsZero() && !a.Published.IsZero() {
+				merged[i].Published = a.Published
+				merged[i].Timestamp = a.Timestamp
+			}
+			if merged[i].Summary == "" {
+				merged[i].Summary = a.Summary
+			}
+		}
+	}
+
+	// articles without a publish date go last
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Published.IsZero() != merged[j].Published.IsZero() {
+			return !merged[i].Published.IsZero()
+		}
+		return merged[i].Published.After(merged[j].Published)
+	})
+	return merged
+}
@@ -0,0 +1,178 @@
+package nbaAPI
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const rssFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/"><channel><title>NBA</title>
+<item><title><![CDATA[Celtics &amp; Knicks split]]></title><link>https://www.espn.com/nba/story/_/id/1?src=rss</link>
+<pubDate>Tue, 04 Feb 2025 18:30:00 GMT</pubDate><description><![CDATA[<p>Boston won the <b>second</b> game.</p>]]></description></item>
+<item><title>No date</title><guid>https://www.espn.com/nba/story/_/id/2</guid><dc:date>2025-02-03T10:00:00Z</dc:date></item>
+</channel></rss>`
+
+const atomFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom"><title>Hoops</title>
+<entry><title>Lakers trade for Doncic</title><link rel="alternate" href="https://example.com/doncic"/>
+<link rel="enclosure" href="https://example.com/doncic.jpg"/><published>2025-02-02T06:00:00Z</published>
+<summary>A blockbuster.</summary></entry>
+</feed>`
+
+const nbaPage = `<html><body><script id="__NEXT_DATA__" type="application/json">
+{"props":{"pageProps":{"feed":[{"title":"Power Rankings","permalink":"/news/power-rankings-week-15",
+"date":"2025-02-03T15:00:00","excerpt":"Who is on top?"},{"title":"Schedule","permalink":"/schedule"}],
+"hero":{"title":"Lakers trade for Doncic","url":"https://example.com/doncic/","excerpt":"Duplicate"}}}}
+</script></body></html>`
+
+func TestParseFeed_RSS(t *testing.T) {
+	articles, err := parseFeed([]byte(rssFeed), "ESPN")
+	if err != nil {
+		t.Fatalf("parseFeed() error: %v", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+	a := articles[0]
+	if a.Title != "Celtics & Knicks split" || a.Summary != "Boston won the second game." || a.Source != "ESPN" {
+		t.Errorf("unexpected article %+v", a)
+	}
+	if !a.Published.Equal(time.Date(2025, 2, 4, 18, 30, 0, 0, time.UTC)) || a.Timestamp == "" {
+		t.Errorf("expected the publish date of the item, got %v", a.Published)
+	}
+	if articles[1].URL != "https://www.espn.com/nba/story/_/id/2" || articles[1].Published.IsZero() {
+		t.Errorf("expected the guid link and the dc:date, got %+v", articles[1])
+	}
+}
+
+func TestParseFeed_Atom(t *testing.T) {
+	articles, err := parseFeed([]byte(atomFeed), "Hoops")
+	if err != nil {
+		t.Fatalf("parseFeed() error: %v", err)
+	}
+	if len(articles) != 1 || articles[0].URL != "https://example.com/doncic" || articles[0].Summary != "A blockbuster." {
+		t.Errorf("unexpected articles %+v", articles)
+	}
+}
+
+func TestParseNBAEmbedded(t *testing.T) {
+	articles, err := parseNBAEmbedded([]byte(nbaPage), "NBA.com")
+	if err != nil {
+		t.Fatalf("parseNBAEmbedded() error: %v", err)
+	}
+	if len(articles) != 1 {
+		t.Fatalf("expected only the news article, got %+v", articles)
+	}
+	a := articles[0]
+	if a.URL != "https://www.nba.com/news/power-rankings-week-15" || a.Summary != "Who is on top?" || a.Published.IsZero() {
+		t.Errorf("unexpected article %+v", a)
+	}
+
+	if _, err := parseNBAEmbedded([]byte("<html></html>"), "NBA.com"); err == nil {
+		t.Error("expected an error for a page without embedded data")
+	}
+}
+
+func TestMergeArticles(t *testing.T) {
+	older := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+	merged := MergeArticles(
+		[]NewsArticle{{Title: "A", URL: "https://example.com/a/"}, {Title: "B", URL: "https://example.com/b", Published: older}},
+		[]NewsArticle{{Title: "A again", URL: "https://EXAMPLE.com/a?utm=x", Published: newer, Summary: "sum"}},
+	)
+	if len(merged) != 2 {
+		t.Fatalf("expected the duplicate to be merged, got %+v", merged)
+	}
+	if merged[0].Title != "A" || !merged[0].Published.Equal(newer) || merged[0].Summary != "sum" {
+		t.Errorf("expected the first article with the date and summary of its duplicate, got %+v", merged[0])
+	}
+	if merged[1].Title != "B" {
+		t.Errorf("expected the older article last, got %+v", merged[1])
+	}
+}
+
+func TestNewsSources_FetchOverHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rss":
+			w.Write([]byte(rssFeed))
+		case "/atom":
+			w.Write([]byte(atomFeed))
+		case "/news":
+			w.Write([]byte(nbaPage))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	nc := &NewsClient{}
+	for _, cfg := range []NewsSourceConfig{
+		{Name: "rss", Type: "rss", URL: server.URL + "/rss"},
+		{Name: "atom", Type: NewsSourceFeed, URL: server.URL + "/atom"},
+		{Name: "nba", Type: NewsSourceNBA, URL: server.URL + "/news"},
+		{Name: "broken", Type: NewsSourceFeed, URL: server.URL + "/missing"},
+	} {
+		source, err := NewNewsSource(cfg, server.Client())
+		if err != nil {
+			t.Fatalf("NewNewsSource(%s) error: %v", cfg.Name, err)
+		}
+		nc.Sources = append(nc.Sources, source)
+	}
+
+	articles, err := nc.Fetch()
+	if err != nil {
+		t.Fatalf("Fetch() error: %v", err)
+	}
+	// the Doncic story is on both the Atom feed and the nba.com page
+	if len(articles) != 4 {
+		t.Fatalf("expected 4 articles, got %d: %+v", len(articles), articles)
+	}
+	if articles[0].Title != "Celtics & Knicks split" {
+		t.Errorf("expected the newest article first, got %s", articles[0].Title)
+	}
+}
+
+type failingSource struct{}
+
+func (failingSource) Name() string                  { return "failing" }
+func (failingSource) Fetch() ([]NewsArticle, error) { return nil, errors.New("down") }
+
+func TestNewsClient_FetchAllSourcesFail(t *testing.T) {
+	nc := &NewsClient{Sources: []NewsSource{failingSource{}}}
+	if _, err := nc.Fetch(); err == nil {
+		t.Error("expected an error when every source fails")
+	}
+}
+
+func TestNewNewsSource_UnknownType(t *testing.T) {
+	if _, err := NewNewsSource(NewsSourceConfig{Name: "x", Type: "html"}, http.DefaultClient); err == nil {
+		t.Error("expected an error for an unknown source type")
+	}
+}
+
+func TestLoadNewsSourceConfigs(t *testing.T) {
+	paths := &MockPathManager{fullPathFunc: func(name, param string) string { return "/tmp/" + name }}
+
+	configs, err := LoadNewsSourceConfigs(&MockFileSystem{fileExistsFunc: func(string) bool { return false }}, paths)
+	if err != nil || len(configs) != len(DefaultNewsSources) {
+		t.Errorf("expected the default sources, got %v, %v", configs, err)
+	}
+
+	fs := &MockFileSystem{
+		fileExistsFunc: func(string) bool { return true },
+		readFileFunc: func(path string) ([]byte, error) {
+			if path != "/tmp/newsSources" {
+				t.Errorf("unexpected path %s", path)
+			}
+			return []byte(`[{"name":"Hoops","type":"atom","url":"https://example.com/feed"}]`), nil
+		},
+	}
+	configs, err = LoadNewsSourceConfigs(fs, paths)
+	if err != nil || len(configs) != 1 || configs[0].Name != "Hoops" {
+		t.Errorf("expected the configured source, got %v, %v", configs, err)
+	}
+}
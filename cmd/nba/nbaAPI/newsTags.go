@@ -0,0 +1,149 @@
+package nbaAPI
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// teamAliases are the other names headlines use for a team, besides the team name itself
+var teamAliases = map[string][]string{
+	"76ers":        {"Sixers"},
+	"TrailBlazers": {"Trail Blazers", "Blazers"},
+	"Timberwolves": {"Wolves"},
+	"Mavericks":    {"Mavs"},
+	"Cavaliers":    {"Cavs"},
+}
+
+// NewsPlayer is a player news articles are tagged with, and the team name they play for
+type NewsPlayer struct {
+	Name string
+	Team string
+}
+
+// NewsTagger finds the teams and players a news article mentions
+type NewsTagger struct {
+	teams   map[string][]string
+	players []NewsPlayer
+}
+
+// NewNewsTagger instantiates a tagger for the given team names and players. The team names are written without
+// spaces, e.g. "TrailBlazers".
+func NewNewsTagger(teams []string, players []NewsPlayer) *NewsTagger {
+	t := &NewsTagger{teams: make(map[string][]string, len(teams)), players: players}
+	for _, team := range teams {
+		t.teams[team] = append([]string{team}, teamAliases[team]...)
+	}
+	return t
+}
+
+// Tag sets the teams and players the title and summary of an article mention. The team of a mentioned player is
+// mentioned too.
+func (t *NewsTagger) Tag(a *NewsArticle) {
+	text := a.Title + "\n" + a.Summary
+	teams := make(map[string]bool)
+	for team, names := range t.teams {
+		for _, name := range names {
+			if containsWord(text, name) {
+				teams[team] = true
+				break
+			}
+		}
+	}
+
+	a.Players = nil
+	for _, p := range t.players {
+		if p.Name != "" && containsWord(text, p.Name) {
+			a.Players = append(a.Players, p.Name)
+			if team := strings.ReplaceAll(p.Team, " ", ""); team != "" {
+				if _, known := t.teams[team]; known {
+					teams[team] = true
+				}
+			}
+		}
+	}
+
+	a.Teams = nil
+	for team := range teams {
+		a.Teams = append(a.Teams, team)
+	}
+	sort.Strings(a.Teams)
+	sort.Strings(a.Players)
+}
+
+// TagArticles tags every article
+func (t *NewsTagger) TagArticles(articles []NewsArticle) {
+	for i := range articles {
+		t.Tag(&articles[i])
+	}
+}
+
+// containsWord tells if the text mentions the word as a whole word, "Heat" is not mentioned by "Heated"
+func containsWord(text, word string) bool {
+	for start := 0; ; {
+		i := strings.Index(text[start:], word)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(word)
+		if !isWordRune(lastRune(text[:i])) && !isWordRune(firstRune(text[end:])) {
+			return true
+		}
+		start = i + 1
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func firstRune(s string) rune {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return 0
+	}
+	return r
+}
+
+func lastRune(s string) rune {
+	r, size := utf8.DecodeLastRuneInString(s)
+	if size == 0 {
+		return 0
+	}
+	return r
+}
+
+// FilterArticlesByTeam returns the articles tagged with a team, an empty team matches all
+func FilterArticlesByTeam(articles []NewsArticle, team string) []NewsArticle {
+	if team == "" {
+		return articles
+	}
+	var filtered []NewsArticle
+	for _, a := range articles {
+		for _, t := range a.Teams {
+			if t == team {
+				filtered = append(filtered, a)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// ArticleTeams returns the teams the articles are tagged with in alphabetical order
+func ArticleTeams(articles []NewsArticle) []string {
+	seen := make(map[string]bool)
+	var teams []string
+	for _, a := range articles {
+		for _, t := range a.Teams {
+			if !seen[t] {
+				seen[t] = true
+				teams = append(teams, t)
+			}
+		}
+	}
+	sort.Strings(teams)
+	return teams
+}
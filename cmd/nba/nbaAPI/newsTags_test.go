@@ -0,0 +1,57 @@
+package nbaAPI
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testTagger() *NewsTagger {
+	return NewNewsTagger([]string{"Celtics", "Heat", "TrailBlazers", "76ers", "Mavericks"}, []NewsPlayer{
+		{Name: "Jayson Tatum", Team: "Celtics"},
+		{Name: "Anfernee Simons", Team: "Trail Blazers"},
+		{Name: "Luka Doncic", Team: "Lakers"},
+	})
+}
+
+func TestNewsTagger_Tag(t *testing.T) {
+	tagger := testTagger()
+	tests := []struct {
+		article     NewsArticle
+		wantTeams   []string
+		wantPlayers []string
+	}{
+		{NewsArticle{Title: "Jayson Tatum leads Boston past Miami", Summary: "The Heat fell to 20-25."},
+			[]string{"Celtics", "Heat"}, []string{"Jayson Tatum"}},
+		{NewsArticle{Title: "Blazers and Sixers talk trade"}, []string{"76ers", "TrailBlazers"}, nil},
+		{NewsArticle{Title: "Anfernee Simons scores 30"}, []string{"TrailBlazers"}, []string{"Anfernee Simons"}},
+		{NewsArticle{Title: "A heated rivalry", Summary: "Mavs fans still miss Luka Doncic."},
+			[]string{"Mavericks"}, []string{"Luka Doncic"}},
+		{NewsArticle{Title: "Celticsfans react"}, nil, nil},
+	}
+	for _, tt := range tests {
+		a := tt.article
+		tagger.Tag(&a)
+		if !reflect.DeepEqual(a.Teams, tt.wantTeams) || !reflect.DeepEqual(a.Players, tt.wantPlayers) {
+			t.Errorf("Tag(%q) = %v %v, want %v %v", tt.article.Title, a.Teams, a.Players, tt.wantTeams, tt.wantPlayers)
+		}
+	}
+}
+
+func TestFilterArticlesByTeam(t *testing.T) {
+	articles := []NewsArticle{
+		{Title: "Celtics win", URL: "a"},
+		{Title: "Heat lose", URL: "b"},
+		{Title: "Celtics and Heat trade", URL: "c"},
+	}
+	testTagger().TagArticles(articles)
+
+	if got := FilterArticlesByTeam(articles, ""); len(got) != 3 {
+		t.Errorf("expected all articles without a team, got %d", len(got))
+	}
+	if got := FilterArticlesByTeam(articles, "Celtics"); len(got) != 2 || got[1].URL != "c" {
+		t.Errorf("expected the 2 Celtics articles, got %+v", got)
+	}
+	if teams := ArticleTeams(articles); !reflect.DeepEqual(teams, []string{"Celtics", "Heat"}) {
+		t.Errorf("unexpected teams %v", teams)
+	}
+}
@@ -22,6 +22,13 @@ type (
 	Scope       string
 )
 
+// The PerMode values of the playercareerstats endpoint
+const (
+	PerModePerGame PerMode = "PerGame"
+	PerModeTotals  PerMode = "Totals"
+	PerModePer36   PerMode = "Per36"
+)
+
 type RequestParams interface {
 	ToValues() url.Values
 	Endpoint() string
@@ -251,7 +258,11 @@ func (p PlayerProfileV2Params) Validate() error {
 	if p.PlayerID == "" {
 		return fmt.Errorf("playerID is required")
 	}
-	return nil
+	switch p.PerMode {
+	case PerModePerGame, PerModeTotals, PerModePer36:
+		return nil
+	}
+	return fmt.Errorf("unsupported perMode %q", p.PerMode)
 }
 
 func (p PlayerGameLogParams) ToValues() url.Values {
@@ -362,3 +373,506 @@ func (rb *nbaRequestBuilder) BuildCommonPlayoffSeriesRequest(season string) Requ
 	}
 	return rb.buildURL(params)
 }
+
+type SeasonScheduleParams struct {
+	LeagueID string
+	Season   string
+}
+
+func (p SeasonScheduleParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("Season", p.Season)
+	return values
+}
+
+func (p SeasonScheduleParams) Endpoint() string { return "scheduleleaguev2" }
+
+func (p SeasonScheduleParams) Validate() error {
+	if p.LeagueID == "" {
+		return fmt.Errorf("leagueID is required")
+	}
+	if p.Season == "" {
+		return fmt.Errorf("season is required")
+	}
+	return nil
+}
+
+func (rb *nbaRequestBuilder) BuildSeasonScheduleRequest(season string) RequestURL {
+	params := SeasonScheduleParams{
+		LeagueID: LeagueID,
+		Season:   season,
+	}
+	return rb.buildURL(params)
+}
+
+// LeagueGameLogParams requests the game log of every team (PlayerOrTeam T) or player (P) of a season
+type LeagueGameLogParams struct {
+	LeagueID     string
+	Season       string
+	SeasonType   SeasonType
+	PlayerOrTeam string
+	Sorter       string
+	Direction    string
+}
+
+func (p LeagueGameLogParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("Season", p.Season)
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("PlayerOrTeam", p.PlayerOrTeam)
+	values.Set("Sorter", p.Sorter)
+	values.Set("Direction", p.Direction)
+	return values
+}
+
+func (p LeagueGameLogParams) Endpoint() string { return "leaguegamelog" }
+
+func (p LeagueGameLogParams) Validate() error {
+	if p.Season == "" {
+		return fmt.Errorf("season is required")
+	}
+	if p.PlayerOrTeam != "T" && p.PlayerOrTeam != "P" {
+		return fmt.Errorf("playerOrTeam must be T or P, got %q", p.PlayerOrTeam)
+	}
+	return nil
+}
+
+// BuildLeagueGameLogRequest requests the regular season game log of all teams, oldest games first
+func (rb *nbaRequestBuilder) BuildLeagueGameLogRequest(season string) RequestURL {
+	params := LeagueGameLogParams{
+		LeagueID:     LeagueID,
+		Season:       season,
+		SeasonType:   "Regular Season",
+		PlayerOrTeam: "T",
+		Sorter:       "DATE",
+		Direction:    "ASC",
+	}
+	return rb.buildURL(params)
+}
+
+// ShotChartDetailParams requests the field goal attempts of a player (or of a whole team with PlayerID 0).
+// The endpoint rejects requests missing any of its filters, so the unused ones are sent with their neutral values.
+type ShotChartDetailParams struct {
+	LeagueID       string
+	PlayerID       string
+	TeamID         string
+	Season         string
+	SeasonType     SeasonType
+	ContextMeasure string
+	LastNGames     int
+	GameID         string
+}
+
+func (p ShotChartDetailParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("PlayerID", p.PlayerID)
+	values.Set("TeamID", p.TeamID)
+	values.Set("Season", p.Season)
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("ContextMeasure", p.ContextMeasure)
+	values.Set("LastNGames", strconv.Itoa(p.LastNGames))
+	values.Set("GameID", p.GameID)
+	for _, zero := range []string{"Month", "OpponentTeamID", "Period", "PlayerPosition", "RookieYear"} {
+		values.Set(zero, "0")
+	}
+	for _, empty := range []string{"DateFrom", "DateTo", "GameSegment", "Location", "Outcome", "SeasonSegment",
+		"VsConference", "VsDivision"} {
+		values.Set(empty, "")
+	}
+	return values
+}
+
+func (p ShotChartDetailParams) Endpoint() string { return "shotchartdetail" }
+
+func (p ShotChartDetailParams) Validate() error {
+	if p.PlayerID == "" {
+		return fmt.Errorf("playerID is required")
+	}
+	if p.TeamID == "" {
+		return fmt.Errorf("teamID is required")
+	}
+	if p.Season == "" && p.GameID == "" {
+		return fmt.Errorf("season or gameID is required")
+	}
+	return nil
+}
+
+// BuildShotChartRequest builds the shot chart request of a player, or of a team when playerID is empty
+func (rb *nbaRequestBuilder) BuildShotChartRequest(playerID, teamID string, filter types.ShotChartFilter) RequestURL {
+	if playerID == "" {
+		playerID = "0"
+	}
+	if teamID == "" {
+		teamID = "0"
+	}
+	params := ShotChartDetailParams{
+		LeagueID:       LeagueID,
+		PlayerID:       playerID,
+		TeamID:         teamID,
+		Season:         filter.Season,
+		SeasonType:     "Regular Season",
+		ContextMeasure: "FGA",
+		LastNGames:     filter.LastNGames,
+		GameID:         filter.GameID,
+	}
+	return rb.buildURL(params)
+}
+
+// LeagueDashTeamShotLocationsParams requests the shooting of every team per court zone. MeasureType "Base" returns
+// the shots of the teams themselves, "Opponent" the shots of their opponents.
+type LeagueDashTeamShotLocationsParams struct {
+	LeagueID      string
+	Season        string
+	SeasonType    SeasonType
+	PerMode       PerMode
+	MeasureType   string
+	DistanceRange string
+}
+
+func (p LeagueDashTeamShotLocationsParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("Season", p.Season)
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("PerMode", string(p.PerMode))
+	values.Set("MeasureType", p.MeasureType)
+	values.Set("DistanceRange", p.DistanceRange)
+	setNeutralDashboardFilters(values)
+	return values
+}
+
+func (p LeagueDashTeamShotLocationsParams) Endpoint() string { return "leaguedashteamshotlocations" }
+
+func (p LeagueDashTeamShotLocationsParams) Validate() error {
+	if p.Season == "" {
+		return fmt.Errorf("season is required")
+	}
+	if p.MeasureType != "Base" && p.MeasureType != "Opponent" {
+		return fmt.Errorf("measureType must be Base or Opponent, got %q", p.MeasureType)
+	}
+	return nil
+}
+
+// BuildTeamShotLocationsRequest builds the request for the per zone shooting of all teams ("Base")
+// or of their opponents ("Opponent")
+func (rb *nbaRequestBuilder) BuildTeamShotLocationsRequest(season, measureType string) RequestURL {
+	params := LeagueDashTeamShotLocationsParams{
+		LeagueID:      LeagueID,
+		Season:        season,
+		SeasonType:    "Regular Season",
+		PerMode:       "Totals",
+		MeasureType:   measureType,
+		DistanceRange: "By Zone",
+	}
+	return rb.buildURL(params)
+}
+
+// PlayerDashboards are the player dashboard endpoints ("playerdashboardby" + dashboard) used for the player splits
+var PlayerDashboards = []string{"generalsplits", "opponent", "lastngames"}
+
+// PlayerDashboardParams requests one of the split dashboards of a player for a season
+type PlayerDashboardParams struct {
+	Dashboard   string
+	PlayerID    string
+	Season      string
+	SeasonType  SeasonType
+	PerMode     PerMode
+	MeasureType string
+}
+
+func (p PlayerDashboardParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", LeagueID)
+	values.Set("PlayerID", p.PlayerID)
+	values.Set("Season", p.Season)
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("PerMode", string(p.PerMode))
+	values.Set("MeasureType", p.MeasureType)
+	setNeutralDashboardFilters(values)
+	return values
+}
+
+func (p PlayerDashboardParams) Endpoint() string { return "playerdashboardby" + p.Dashboard }
+
+func (p PlayerDashboardParams) Validate() error {
+	if p.PlayerID == "" {
+		return fmt.Errorf("playerID is required")
+	}
+	if p.Season == "" {
+		return fmt.Errorf("season is required")
+	}
+	for _, dashboard := range PlayerDashboards {
+		if p.Dashboard == dashboard {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown player dashboard %q", p.Dashboard)
+}
+
+// BuildPlayerDashboardRequest builds the request for a per game split dashboard of a player
+func (rb *nbaRequestBuilder) BuildPlayerDashboardRequest(playerID, dashboard, season string) RequestURL {
+	params := PlayerDashboardParams{
+		Dashboard:   dashboard,
+		PlayerID:    playerID,
+		Season:      season,
+		SeasonType:  "Regular Season",
+		PerMode:     "PerGame",
+		MeasureType: "Base",
+	}
+	return rb.buildURL(params)
+}
+
+// setNeutralDashboardFilters sets the filters the dashboard endpoints require to their "no filter" values
+func setNeutralDashboardFilters(values url.Values) {
+	for _, zero := range []string{"LastNGames", "Month", "OpponentTeamID", "Period"} {
+		values.Set(zero, "0")
+	}
+	for _, no := range []string{"PaceAdjust", "PlusMinus", "Rank"} {
+		values.Set(no, "N")
+	}
+	for _, empty := range []string{"DateFrom", "DateTo", "GameSegment", "Location", "Outcome", "SeasonSegment",
+		"VsConference", "VsDivision"} {
+		values.Set(empty, "")
+	}
+}
+
+// PlayerAwardsParams requests every award, selection and honor of a player's career
+type PlayerAwardsParams struct {
+	PlayerID string
+}
+
+func (p PlayerAwardsParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("PlayerID", p.PlayerID)
+	return values
+}
+
+func (p PlayerAwardsParams) Endpoint() string { return "playerawards" }
+
+func (p PlayerAwardsParams) Validate() error {
+	if p.PlayerID == "" {
+		return fmt.Errorf("playerID is required")
+	}
+	return nil
+}
+
+func (rb *nbaRequestBuilder) BuildPlayerAwardsRequest(playerID string) RequestURL {
+	return rb.buildURL(PlayerAwardsParams{PlayerID: playerID})
+}
+
+// DraftHistoryParams requests every pick of a single draft. Season is the year of the draft, e.g. 2003.
+// The endpoint also filters by team and college, but those filters are applied locally on the cached draft.
+type DraftHistoryParams struct {
+	LeagueID string
+	Season   string
+}
+
+func (p DraftHistoryParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("Season", p.Season)
+	return values
+}
+
+func (p DraftHistoryParams) Endpoint() string { return "drafthistory" }
+
+func (p DraftHistoryParams) Validate() error {
+	if _, err := strconv.Atoi(p.Season); err != nil || len(p.Season) != 4 {
+		return fmt.Errorf("season must be the year of the draft, got %q", p.Season)
+	}
+	return nil
+}
+
+func (rb *nbaRequestBuilder) BuildDraftHistoryRequest(year string) RequestURL {
+	return rb.buildURL(DraftHistoryParams{LeagueID: LeagueID, Season: year})
+}
+
+// AllTimeLeadersParams requests the all-time top TopX players of every stat category, in a single response
+type AllTimeLeadersParams struct {
+	LeagueID   string
+	PerMode    PerMode
+	SeasonType SeasonType
+	TopX       int
+}
+
+func (p AllTimeLeadersParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("PerMode", string(p.PerMode))
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("TopX", strconv.Itoa(p.TopX))
+	return values
+}
+
+func (p AllTimeLeadersParams) Endpoint() string { return "alltimeleadersgrids" }
+
+func (p AllTimeLeadersParams) Validate() error {
+	if p.PerMode != PerModeTotals && p.PerMode != PerModePerGame {
+		return fmt.Errorf("perMode must be Totals or PerGame, got %q", p.PerMode)
+	}
+	if p.TopX < 1 {
+		return fmt.Errorf("topX must be positive, got %d", p.TopX)
+	}
+	return nil
+}
+
+// BuildAllTimeLeadersRequest requests the regular season top 25 of every category
+func (rb *nbaRequestBuilder) BuildAllTimeLeadersRequest(perMode PerMode) RequestURL {
+	params := AllTimeLeadersParams{
+		LeagueID:   LeagueID,
+		PerMode:    perMode,
+		SeasonType: "Regular Season",
+		TopX:       25,
+	}
+	return rb.buildURL(params)
+}
+
+// FranchiseParams requests the franchise endpoint of a team named by Resource: franchiseleaders or franchiseplayers.
+// Both accept the same filters, franchiseleaders just ignores PerMode and SeasonType.
+type FranchiseParams struct {
+	Resource   string
+	LeagueID   string
+	TeamID     string
+	PerMode    PerMode
+	SeasonType SeasonType
+}
+
+func (p FranchiseParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", p.LeagueID)
+	values.Set("TeamID", p.TeamID)
+	if p.Resource == "players" {
+		values.Set("PerMode", string(p.PerMode))
+		values.Set("SeasonType", string(p.SeasonType))
+	}
+	return values
+}
+
+func (p FranchiseParams) Endpoint() string { return "franchise" + p.Resource }
+
+func (p FranchiseParams) Validate() error {
+	if p.Resource != "leaders" && p.Resource != "players" {
+		return fmt.Errorf("unknown franchise resource %q", p.Resource)
+	}
+	if p.TeamID == "" {
+		return fmt.Errorf("teamID is required")
+	}
+	return nil
+}
+
+func (rb *nbaRequestBuilder) BuildFranchiseLeadersRequest(teamID string) RequestURL {
+	return rb.buildURL(FranchiseParams{Resource: "leaders", LeagueID: LeagueID, TeamID: teamID})
+}
+
+// BuildFranchisePlayersRequest requests the regular season totals of everyone who played for a franchise
+func (rb *nbaRequestBuilder) BuildFranchisePlayersRequest(teamID string) RequestURL {
+	params := FranchiseParams{
+		Resource:   "players",
+		LeagueID:   LeagueID,
+		TeamID:     teamID,
+		PerMode:    PerModeTotals,
+		SeasonType: "Regular Season",
+	}
+	return rb.buildURL(params)
+}
+
+// LineupGroupSizes are the lineup sizes shown in the team profile: two-man, three-man and five-man units
+var LineupGroupSizes = []int{2, 3, 5}
+
+// TeamLineupsParams requests the stats of every lineup of GroupQuantity players a team used
+type TeamLineupsParams struct {
+	TeamID        string
+	Season        string
+	SeasonType    SeasonType
+	PerMode       PerMode
+	MeasureType   string
+	GroupQuantity int
+}
+
+func (p TeamLineupsParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", LeagueID)
+	values.Set("TeamID", p.TeamID)
+	values.Set("Season", p.Season)
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("PerMode", string(p.PerMode))
+	values.Set("MeasureType", p.MeasureType)
+	values.Set("GroupQuantity", strconv.Itoa(p.GroupQuantity))
+	setNeutralDashboardFilters(values)
+	return values
+}
+
+func (p TeamLineupsParams) Endpoint() string { return "teamdashlineups" }
+
+func (p TeamLineupsParams) Validate() error {
+	if p.TeamID == "" {
+		return fmt.Errorf("teamID is required")
+	}
+	if p.Season == "" {
+		return fmt.Errorf("season is required")
+	}
+	if p.GroupQuantity < 2 || p.GroupQuantity > 5 {
+		return fmt.Errorf("groupQuantity must be between 2 and 5, got %d", p.GroupQuantity)
+	}
+	return nil
+}
+
+// BuildTeamLineupsRequest requests the advanced season totals of a team's lineups, which include the net rating
+func (rb *nbaRequestBuilder) BuildTeamLineupsRequest(teamID, season string, groupQuantity int) RequestURL {
+	params := TeamLineupsParams{
+		TeamID:        teamID,
+		Season:        season,
+		SeasonType:    "Regular Season",
+		PerMode:       PerModeTotals,
+		MeasureType:   "Advanced",
+		GroupQuantity: groupQuantity,
+	}
+	return rb.buildURL(params)
+}
+
+// TeamOnOffParams requests how a team played with each of its players on and off the court
+type TeamOnOffParams struct {
+	TeamID      string
+	Season      string
+	SeasonType  SeasonType
+	PerMode     PerMode
+	MeasureType string
+}
+
+func (p TeamOnOffParams) ToValues() url.Values {
+	values := url.Values{}
+	values.Set("LeagueID", LeagueID)
+	values.Set("TeamID", p.TeamID)
+	values.Set("Season", p.Season)
+	values.Set("SeasonType", string(p.SeasonType))
+	values.Set("PerMode", string(p.PerMode))
+	values.Set("MeasureType", p.MeasureType)
+	setNeutralDashboardFilters(values)
+	return values
+}
+
+func (p TeamOnOffParams) Endpoint() string { return "teamplayeronoffsummary" }
+
+func (p TeamOnOffParams) Validate() error {
+	if p.TeamID == "" {
+		return fmt.Errorf("teamID is required")
+	}
+	if p.Season == "" {
+		return fmt.Errorf("season is required")
+	}
+	return nil
+}
+
+func (rb *nbaRequestBuilder) BuildTeamOnOffRequest(teamID, season string) RequestURL {
+	params := TeamOnOffParams{
+		TeamID:      teamID,
+		Season:      season,
+		SeasonType:  "Regular Season",
+		PerMode:     PerModeTotals,
+		MeasureType: "Base",
+	}
+	return rb.buildURL(params)
+}
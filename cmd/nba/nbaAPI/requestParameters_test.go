@@ -172,3 +172,165 @@ func TestCommonPlayoffSeriesParams_Validate(t *testing.T) {
 		t.Errorf("Validate() unexpected error: %v", err)
 	}
 }
+
+func TestSeasonScheduleParams_Validate(t *testing.T) {
+	p := SeasonScheduleParams{LeagueID: LeagueID}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for missing Season")
+	}
+
+	p.Season = "2024-25"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := p.Endpoint(); got != "scheduleleaguev2" {
+		t.Errorf("Endpoint() = %s, want scheduleleaguev2", got)
+	}
+}
+
+func TestShotChartDetailParams_Validate(t *testing.T) {
+	p := ShotChartDetailParams{LeagueID: LeagueID}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for missing PlayerID")
+	}
+
+	p.PlayerID = "1628389"
+	p.TeamID = "0"
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for missing Season and GameID")
+	}
+
+	p.GameID = "0022400100"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+}
+
+func TestShotChartDetailParams_ToValues(t *testing.T) {
+	p := ShotChartDetailParams{LeagueID: LeagueID, PlayerID: "1628389", TeamID: "0", Season: "2024-25",
+		SeasonType: "Regular Season", ContextMeasure: "FGA", LastNGames: 10}
+	v := p.ToValues()
+	if got := v.Get("LastNGames"); got != "10" {
+		t.Errorf("ToValues().Get(LastNGames) = %s, want 10", got)
+	}
+	if got := v.Get("ContextMeasure"); got != "FGA" {
+		t.Errorf("ToValues().Get(ContextMeasure) = %s, want FGA", got)
+	}
+	if _, ok := v["OpponentTeamID"]; !ok {
+		t.Error("ToValues() is missing the OpponentTeamID filter")
+	}
+	if got := p.Endpoint(); got != "shotchartdetail" {
+		t.Errorf("Endpoint() = %s, want shotchartdetail", got)
+	}
+}
+
+func TestLeagueGameLogParams_Validate(t *testing.T) {
+	p := LeagueGameLogParams{LeagueID: LeagueID, Season: "2024-25", PlayerOrTeam: "X"}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for PlayerOrTeam X")
+	}
+
+	p.PlayerOrTeam = "T"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := p.Endpoint(); got != "leaguegamelog" {
+		t.Errorf("Endpoint() = %s, want leaguegamelog", got)
+	}
+}
+
+func TestPlayerDashboardParams(t *testing.T) {
+	p := PlayerDashboardParams{Dashboard: "bogus", PlayerID: "1628389", Season: "2024-25"}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for an unknown dashboard")
+	}
+
+	p.Dashboard = "opponent"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := p.Endpoint(); got != "playerdashboardbyopponent" {
+		t.Errorf("Endpoint() = %s, want playerdashboardbyopponent", got)
+	}
+	if got := p.ToValues().Get("PaceAdjust"); got != "N" {
+		t.Errorf("ToValues().Get(PaceAdjust) = %q, want N", got)
+	}
+}
+
+func TestPlayerAwardsParams(t *testing.T) {
+	p := PlayerAwardsParams{}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for a missing PlayerID")
+	}
+
+	p.PlayerID = "203999"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := p.Endpoint(); got != "playerawards" {
+		t.Errorf("Endpoint() = %s, want playerawards", got)
+	}
+	if got := p.ToValues().Get("PlayerID"); got != "203999" {
+		t.Errorf("ToValues().Get(PlayerID) = %q, want 203999", got)
+	}
+}
+
+func TestAllTimeLeadersParams_Validate(t *testing.T) {
+	p := AllTimeLeadersParams{LeagueID: LeagueID, PerMode: PerModePer36, TopX: 25}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for PerMode Per36")
+	}
+
+	p.PerMode = PerModeTotals
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := p.ToValues().Get("TopX"); got != "25" {
+		t.Errorf("ToValues().Get(TopX) = %q, want 25", got)
+	}
+}
+
+func TestFranchiseParams(t *testing.T) {
+	p := FranchiseParams{Resource: "history", LeagueID: LeagueID, TeamID: "1610612748"}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for an unknown resource")
+	}
+
+	p.Resource = "leaders"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := p.Endpoint(); got != "franchiseleaders" {
+		t.Errorf("Endpoint() = %s, want franchiseleaders", got)
+	}
+	if p.ToValues().Has("PerMode") {
+		t.Error("franchiseleaders does not take a PerMode")
+	}
+
+	p = FranchiseParams{Resource: "players", LeagueID: LeagueID, TeamID: "1610612748", PerMode: PerModeTotals}
+	if got := p.ToValues().Get("PerMode"); got != "Totals" {
+		t.Errorf("ToValues().Get(PerMode) = %q, want Totals", got)
+	}
+}
+
+func TestTeamLineupsParams(t *testing.T) {
+	p := TeamLineupsParams{TeamID: "1610612738", Season: "2024-25", GroupQuantity: 6}
+	if err := p.Validate(); err == nil {
+		t.Error("Validate() expected error for a six-man lineup")
+	}
+
+	for _, size := range LineupGroupSizes {
+		p.GroupQuantity = size
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate() unexpected error for %d-man lineups: %v", size, err)
+		}
+	}
+	if got := p.ToValues().Get("GroupQuantity"); got != "5" {
+		t.Errorf("ToValues().Get(GroupQuantity) = %q, want 5", got)
+	}
+
+	onOff := TeamOnOffParams{Season: "2024-25"}
+	if err := onOff.Validate(); err == nil {
+		t.Error("Validate() expected error without a team")
+	}
+}
@@ -0,0 +1,15 @@
+package nbaAPI
+
+import "fmt"
+
+// transactionsURL is the player movement feed behind the transactions page of nba.com
+const transactionsURL RequestURL = "https://stats.nba.com/js/data/playermovement/NBA_Player_Movement.json"
+
+// FetchTransactions downloads the league's transactions, trades, signings, waivers and other roster moves.
+// The feed is cached for the day, like the news.
+func (c *Client) FetchTransactions() error {
+	if err := c.FileSystem.EnsureDirectoryExists(c.Paths.GetFullPath("newsCachePath", "")); err != nil {
+		return fmt.Errorf("could not create the news directory: %w", err)
+	}
+	return c.fetchToCache(transactionsURL, c.Paths.GetFullPath("transactions", ""))
+}
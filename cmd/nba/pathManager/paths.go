@@ -5,6 +5,7 @@ import (
 	"github.com/sLg00/nba-now-tui/cmd/nba/types"
 	"log"
 	"os"
+	"time"
 )
 
 type PathManager interface {
@@ -29,6 +30,19 @@ type PathComps struct {
 	NewsCachePath     string
 	NewsCacheFile     string
 	PlayoffsPath      string
+	PlayoffsFile      string //date prefix of playoff files of the ongoing season
+	CurrentSeason     string //season whose playoff files are refreshed daily
+	ScheduleFile      string //season schedule file name
+	PredictionsPath   string //folder to store bracket challenge predictions
+	ShotChartPath     string //folder to store shot charts
+	ShotChartFile     string //date prefix of shot chart files
+	GameLogFile       string //league team game log file name
+	DashboardFile     string //date prefix of player dashboard files
+	DraftPath         string //folder to store draft histories
+	InjuryReportFile  string //injury report file name
+	InjuryReportHour  string //hour the injury report is cached for, it is published hourly
+	TransactionsFile  string //transactions feed file name
+	LeaguePlayersFile string //player index of the whole league
 }
 
 func PathFactory(dates types.DateProvider, id string) PathManager {
@@ -60,6 +74,19 @@ func PathFactory(dates types.DateProvider, id string) PathManager {
 		NewsCachePath:     "news/",
 		NewsCacheFile:     today + "_news",
 		PlayoffsPath:      "playoffs/",
+		PlayoffsFile:      today + "_",
+		CurrentSeason:     dates.GetCurrentSeason(),
+		ScheduleFile:      today + "_schedule_",
+		PredictionsPath:   "predictions/",
+		ShotChartPath:     "shotcharts/",
+		ShotChartFile:     today + "_",
+		GameLogFile:       today + "_gamelog_",
+		DashboardFile:     today + "_dashboard_",
+		DraftPath:         "drafts/",
+		InjuryReportFile:  today + "_injuries",
+		InjuryReportHour:  time.Now().Format("15"),
+		TransactionsFile:  today + "_transactions",
+		LeaguePlayersFile: today + "_league",
 	}
 }
 
@@ -83,6 +110,16 @@ func PathFactoryForDate(date string) PathManager {
 		NewsCachePath:     "news/",
 		NewsCacheFile:     date + "_news",
 		PlayoffsPath:      "playoffs/",
+		ScheduleFile:      date + "_schedule_",
+		PredictionsPath:   "predictions/",
+		ShotChartPath:     "shotcharts/",
+		ShotChartFile:     date + "_",
+		GameLogFile:       date + "_gamelog_",
+		DashboardFile:     date + "_dashboard_",
+		DraftPath:         "drafts/",
+		InjuryReportFile:  date + "_injuries",
+		TransactionsFile:  date + "_transactions",
+		LeaguePlayersFile: date + "_league",
 	}
 }
 
@@ -101,20 +138,80 @@ func (p *PathComps) GetFullPath(fileType string, id string) string {
 		return base + p.TeamProfilePath + id
 	case "playerIndex":
 		return base + p.TeamPlayersPath + id
+	case "leaguePlayerIndex":
+		return base + p.TeamPlayersPath + p.LeaguePlayersFile
 	case "playerInfo":
 		return base + p.PlayerProfilePath + id + "_info"
 	case "playerCareerStats":
 		return base + p.PlayerProfilePath + id + "_career"
 	case "playerGameLog":
 		return base + p.PlayerProfilePath + id + "_gamelog"
+	case "playerAwards":
+		return base + p.PlayerProfilePath + id + "_awards"
 	case "newsCachePath":
 		return base + p.NewsCachePath
 	case "newsCacheFile":
 		return base + p.NewsCachePath + p.NewsCacheFile
+	case "newsArticle":
+		return base + p.NewsCachePath + p.NewsCacheFile + "_" + id
 	case "playoffBracket":
 		return base + p.PlayoffsPath + id + "_bracket"
 	case "playoffSeriesGames":
+		// results of the ongoing playoffs change daily, past seasons are cached for good
+		if p.CurrentSeason != "" && id == p.CurrentSeason {
+			return base + p.PlayoffsPath + p.PlayoffsFile + id + "_games"
+		}
 		return base + p.PlayoffsPath + id + "_games"
+	case "seasonSchedule":
+		// past seasons are over, their schedule (with all the results) is cached for good
+		if p.CurrentSeason != "" && id != p.CurrentSeason {
+			return base + "schedule_" + id
+		}
+		return base + p.ScheduleFile + id
+	case "predictionsPath":
+		return base + p.PredictionsPath + id + "/"
+	case "shotChart":
+		return base + p.ShotChartPath + p.ShotChartFile + id
+	case "teamShotLocations":
+		return base + p.ShotChartPath + p.ShotChartFile + "teams_" + id
+	case "leagueGameLog":
+		return base + p.GameLogFile + id
+	case "playerDashboard":
+		return base + p.PlayerProfilePath + p.DashboardFile + id
+	case "draftHistory":
+		return base + p.DraftPath + id + "_draft"
+	case "allTimeLeaders":
+		return base + "alltimeleaders_" + id
+	case "teamDashboard":
+		return base + p.TeamProfilePath + p.DashboardFile + id
+	case "franchiseLeaders":
+		return base + p.TeamProfilePath + id + "_franchise_leaders"
+	case "franchisePlayers":
+		return base + p.TeamProfilePath + id + "_franchise_players"
+	case "injuryReport":
+		if p.InjuryReportHour != "" {
+			return base + p.InjuryReportFile + "_" + p.InjuryReportHour
+		}
+		return base + p.InjuryReportFile
+	case "injuryReportMissing":
+		return base + p.InjuryReportFile + "_none"
+	case "transactions":
+		return base + p.NewsCachePath + p.TransactionsFile
+	case "favorites":
+		return base + "favorites.json"
+	case "newsSources":
+		return base + "news_sources.json"
+	case "notifications":
+		return base + "notifications.json"
+	case "cacheLock":
+		return base + "cache.lock"
+	case "storage":
+		return base + "storage.json"
+	case "database":
+		return base + "nba.db"
+	case "archive":
+		// the backfilled seasons are kept for good, outside the folders swept by CleanOldFiles
+		return base + "archive/" + id + "/"
 	default:
 		return base
 	}
@@ -128,5 +225,7 @@ func (p *PathComps) GetBasePaths() []string {
 		p.Home + p.Path + p.PlayerProfilePath,
 		p.Home + p.Path + p.NewsCachePath,
 		p.Home + p.Path + p.PlayoffsPath,
+		p.Home + p.Path + p.ShotChartPath,
+		p.Home + p.Path + p.TeamProfilePath,
 	}
 }
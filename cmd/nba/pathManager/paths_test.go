@@ -92,3 +92,54 @@ func TestGetBasePaths_IncludesPlayoffs(t *testing.T) {
 	}
 	t.Error("GetBasePaths() does not include playoffs path")
 }
+
+func TestGetFullPath_SeasonSchedule(t *testing.T) {
+	pm := PathFactory(&mockDateProvider{date: "2025-02-14", season: "2024-25"}, "")
+	got := pm.GetFullPath("seasonSchedule", "2024-25")
+	if !strings.HasSuffix(got, "/.config/nba-tui/2025-02-14_schedule_2024-25") {
+		t.Errorf("GetFullPath(seasonSchedule) = %s, want suffix 2025-02-14_schedule_2024-25", got)
+	}
+	past := pm.GetFullPath("seasonSchedule", "2022-23")
+	if !strings.HasSuffix(past, "/.config/nba-tui/schedule_2022-23") {
+		t.Errorf("GetFullPath(seasonSchedule, past) = %s, want undated file", past)
+	}
+}
+
+func TestGetFullPath_PlayoffSeriesGames_CurrentSeasonIsDated(t *testing.T) {
+	pm := PathFactory(&mockDateProvider{date: "2025-04-20", season: "2024-25"}, "")
+	current := pm.GetFullPath("playoffSeriesGames", "2024-25")
+	if !strings.HasSuffix(current, "playoffs/2025-04-20_2024-25_games") {
+		t.Errorf("GetFullPath(playoffSeriesGames, current) = %s, want dated file", current)
+	}
+	past := pm.GetFullPath("playoffSeriesGames", "2022-23")
+	if !strings.HasSuffix(past, "playoffs/2022-23_games") {
+		t.Errorf("GetFullPath(playoffSeriesGames, past) = %s, want undated file", past)
+	}
+}
+
+func TestGetFullPath_Predictions(t *testing.T) {
+	pm := PathFactory(&mockDateProvider{date: "2025-04-20", season: "2024-25"}, "")
+	got := pm.GetFullPath("predictionsPath", "2024-25")
+	if !strings.HasSuffix(got, "/.config/nba-tui/predictions/2024-25/") {
+		t.Errorf("GetFullPath(predictionsPath) = %s, want suffix predictions/2024-25/", got)
+	}
+}
+
+func TestGetFullPath_ShotChartIsDated(t *testing.T) {
+	pm := PathFactory(&mockDateProvider{date: "2025-02-14", season: "2024-25"}, "")
+	got := pm.GetFullPath("shotChart", "p1628389_2024-25_l0")
+	if !strings.HasSuffix(got, "/.config/nba-tui/shotcharts/2025-02-14_p1628389_2024-25_l0") {
+		t.Errorf("GetFullPath(shotChart) = %s, want dated file in shotcharts/", got)
+	}
+}
+
+func TestGetFullPath_InjuryReportIsHourly(t *testing.T) {
+	pm := PathFactory(&mockDateProvider{date: "2025-01-15", season: "2024-25"}, "")
+	pm.(*PathComps).InjuryReportHour = "17"
+	if got := pm.GetFullPath("injuryReport", ""); !strings.HasSuffix(got, "/.config/nba-tui/2025-01-15_injuries_17") {
+		t.Errorf("GetFullPath(injuryReport) = %s, want the report of the hour", got)
+	}
+	if got := pm.GetFullPath("injuryReportMissing", ""); !strings.HasSuffix(got, "/.config/nba-tui/2025-01-15_injuries_none") {
+		t.Errorf("GetFullPath(injuryReportMissing) = %s, want a file for the day", got)
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+// SeriesPick is a single bracket challenge prediction: the team (by tricode) expected to win the series at the given
+// bracket cursor index and in how many games
+type SeriesPick struct {
+	Series int    `json:"series"`
+	Winner string `json:"winner"`
+	Games  int    `json:"games"`
+}
+
+// BracketPrediction is a named set of picks for the playoffs of a season, stored as a local JSON file
+type BracketPrediction struct {
+	Name      string       `json:"name"`
+	Season    string       `json:"season"`
+	UpdatedAt string       `json:"updatedAt"`
+	Picks     []SeriesPick `json:"picks"`
+}
+
+// Pick returns the pick made for the series at the given bracket cursor index
+func (bp BracketPrediction) Pick(series int) (SeriesPick, bool) {
+	for _, pick := range bp.Picks {
+		if pick.Series == series {
+			return pick, true
+		}
+	}
+	return SeriesPick{}, false
+}
+
+// SetPick adds or replaces the pick of a series. An empty winner removes the pick.
+func (bp *BracketPrediction) SetPick(pick SeriesPick) {
+	for i, existing := range bp.Picks {
+		if existing.Series != pick.Series {
+			continue
+		}
+		if pick.Winner == "" {
+			bp.Picks = append(bp.Picks[:i], bp.Picks[i+1:]...)
+		} else {
+			bp.Picks[i] = pick
+		}
+		return
+	}
+	if pick.Winner != "" {
+		bp.Picks = append(bp.Picks, pick)
+	}
+}
+
+// PredictionScore is the leaderboard entry of a single prediction
+type PredictionScore struct {
+	Rank           int    `json:"RANK" isVisible:"true" display:"#" width:"4"`
+	Name           string `json:"NAME" isVisible:"true" display:"Name" width:"20"`
+	Points         int    `json:"POINTS" isVisible:"true" display:"Points" width:"8"`
+	MaxPoints      int    `json:"MAX_POINTS" isVisible:"true" display:"Max" width:"8"`
+	CorrectWinners int    `json:"CORRECT_WINNERS" isVisible:"true" display:"Winners" width:"9"`
+	CorrectGames   int    `json:"CORRECT_GAMES" isVisible:"true" display:"Exact" width:"8"`
+	Picks          int    `json:"PICKS" isVisible:"true" display:"Picks" width:"8"`
+	UpdatedAt      string `json:"UPDATED_AT" isVisible:"true" display:"Updated" width:"18"`
+}
+
+// ToStringSlice is a method on the PredictionScore type that enables the attributes of the type to be converted to strings
+func (ps PredictionScore) ToStringSlice() []string {
+	return structToStringSlice(ps)
+}
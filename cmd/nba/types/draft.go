@@ -0,0 +1,20 @@
+package types
+
+// DraftPick is a row of the drafthistory endpoint: a single pick of a draft and the player selected with it
+type DraftPick struct {
+	PlayerID         int    `json:"PERSON_ID" isVisible:"false" isID:"true"`
+	OverallPick      int    `json:"OVERALL_PICK" isVisible:"true" display:"Pick" width:"6"`
+	RoundNumber      int    `json:"ROUND_NUMBER" isVisible:"true" display:"Round" width:"7"`
+	RoundPick        int    `json:"ROUND_PICK" isVisible:"true" display:"Rd. Pick" width:"10"`
+	PlayerName       string `json:"PLAYER_NAME" isVisible:"true" display:"Player" width:"26"`
+	TeamAbbreviation string `json:"TEAM_ABBREVIATION" isVisible:"true" display:"Team" width:"6"`
+	TeamCity         string `json:"TEAM_CITY" isVisible:"true" display:"City" width:"16"`
+	TeamName         string `json:"TEAM_NAME" isVisible:"false"`
+	TeamID           int    `json:"TEAM_ID" isVisible:"false"`
+	Organization     string `json:"ORGANIZATION" isVisible:"true" display:"College/Team" width:"30"`
+	OrganizationType string `json:"ORGANIZATION_TYPE" isVisible:"true" display:"From" width:"24"`
+}
+
+func (dp DraftPick) ToStringSlice() []string {
+	return structToStringSlice(dp)
+}
@@ -0,0 +1,45 @@
+package types
+
+// Favorites are the teams and players the user follows, by their NBA IDs
+type Favorites struct {
+	Teams   []string `json:"teams"`
+	Players []string `json:"players"`
+}
+
+// HasTeam tells if a team is a favorite
+func (f Favorites) HasTeam(teamID string) bool {
+	return containsID(f.Teams, teamID)
+}
+
+// HasPlayer tells if a player is a favorite
+func (f Favorites) HasPlayer(playerID string) bool {
+	return containsID(f.Players, playerID)
+}
+
+// ToggleTeam adds the team to the favorites, or removes it when it already is one
+func (f *Favorites) ToggleTeam(teamID string) {
+	f.Teams = toggleID(f.Teams, teamID)
+}
+
+// TogglePlayer adds the player to the favorites, or removes them when they already are one
+func (f *Favorites) TogglePlayer(playerID string) {
+	f.Players = toggleID(f.Players, playerID)
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func toggleID(ids []string, id string) []string {
+	for i, v := range ids {
+		if v == id {
+			return append(ids[:i:i], ids[i+1:]...)
+		}
+	}
+	return append(ids, id)
+}
@@ -130,3 +130,17 @@ func (d DailyGameResults) ToStringSlice() []string {
 func (bst BoxScoreTeam) ToStringSlice() []string {
 	return structToStringSlice(bst)
 }
+
+// BoxScoreLine is the stat line of a player in a single game, as kept by the SQLite storage
+type BoxScoreLine struct {
+	GameID     string
+	GameDate   string // YYYY-MM-DD, empty when the game's scoreboard was never stored
+	Season     string
+	PlayerID   int
+	PlayerName string
+	TeamID     int
+	Minutes    string
+	Points     int
+	Rebounds   int
+	Assists    int
+}
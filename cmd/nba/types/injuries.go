@@ -0,0 +1,75 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Injury statuses of the official injury report, from least to most likely to play
+const (
+	InjuryStatusOut          = "Out"
+	InjuryStatusDoubtful     = "Doubtful"
+	InjuryStatusQuestionable = "Questionable"
+	InjuryStatusProbable     = "Probable"
+	InjuryStatusAvailable    = "Available"
+)
+
+// InjuryStatuses lists the statuses of the injury report in order of severity
+var InjuryStatuses = []string{InjuryStatusOut, InjuryStatusDoubtful, InjuryStatusQuestionable, InjuryStatusProbable,
+	InjuryStatusAvailable}
+
+// InjuryReportEntry is a row of the official injury report: a player's status for the next game of their team
+type InjuryReportEntry struct {
+	Team     string `json:"TEAM" isVisible:"true" display:"Team" width:"24"`
+	Player   string `json:"PLAYER_NAME" isVisible:"true" display:"Player" width:"26"`
+	Status   string `json:"CURRENT_STATUS" isVisible:"true" display:"Status" width:"14"`
+	Reason   string `json:"REASON" isVisible:"true" display:"Reason" width:"52"`
+	Matchup  string `json:"MATCHUP" isVisible:"true" display:"Game" width:"10"`
+	GameTime string `json:"GAME_TIME" isVisible:"true" display:"Time" width:"12"`
+	GameDate string `json:"GAME_DATE" isVisible:"false"`
+}
+
+// InjuryReport is the latest official injury report, as published at Published
+type InjuryReport struct {
+	Published string
+	Entries   []InjuryReportEntry
+}
+
+// Lookup finds the injury report entry of a player by name
+func (r InjuryReport) Lookup(firstName, lastName string) (InjuryReportEntry, bool) {
+	key := InjuryNameKey(firstName + " " + lastName)
+	for _, e := range r.Entries {
+		if InjuryNameKey(e.Player) == key {
+			return e, true
+		}
+	}
+	return InjuryReportEntry{}, false
+}
+
+// ForMatchup returns the entries of both teams of a game, matchups are written as AWAY@HOME tricodes
+func (r InjuryReport) ForMatchup(awayTricode, homeTricode string) []InjuryReportEntry {
+	matchup := awayTricode + "@" + homeTricode
+	var entries []InjuryReportEntry
+	for _, e := range r.Entries {
+		if strings.EqualFold(e.Matchup, matchup) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// InjuryNameKey normalizes a player name for matching the injury report against rosters and box scores,
+// which spell names with different casing, spacing and punctuation
+func InjuryNameKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func (e InjuryReportEntry) ToStringSlice() []string {
+	return structToStringSlice(e)
+}
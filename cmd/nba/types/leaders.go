@@ -0,0 +1,77 @@
+package types
+
+import "strconv"
+
+// AllTimeLeader is a player on the all-time leaderboard of a stat category. Value is already formatted,
+// as a total, an average or a percentage depending on the category.
+type AllTimeLeader struct {
+	PlayerID   int    `json:"PLAYER_ID" isVisible:"false" isID:"true"`
+	Rank       int    `json:"RANK" isVisible:"true" display:"Rank" width:"6"`
+	PlayerName string `json:"PLAYER_NAME" isVisible:"true" display:"Player" width:"28"`
+	Value      string `json:"VALUE" isVisible:"true" display:"Value" width:"12"`
+	Active     string `json:"IS_ACTIVE" isVisible:"true" display:"Active" width:"8"`
+}
+
+// AllTimeLeaderCategory is the leaderboard of a single stat category of the alltimeleadersgrids endpoint
+type AllTimeLeaderCategory struct {
+	Stat    string
+	Label   string
+	Leaders []AllTimeLeader
+}
+
+// FranchiseLeader is the franchise record holder of a stat category
+type FranchiseLeader struct {
+	PlayerID int    `json:"PERSON_ID" isVisible:"false" isID:"true"`
+	Category string `json:"CATEGORY" isVisible:"true" display:"Category" width:"12"`
+	Player   string `json:"PLAYER" isVisible:"true" display:"Player" width:"28"`
+	Value    int    `json:"VALUE" isVisible:"true" display:"Total" width:"10"`
+}
+
+// FranchisePlayer is a row of the franchiseplayers endpoint: the regular season totals of a player with the franchise
+type FranchisePlayer struct {
+	PlayerID int     `json:"PERSON_ID" isVisible:"false" isID:"true"`
+	Player   string  `json:"PLAYER" isVisible:"true" display:"Player" width:"26"`
+	Active   int     `json:"ACTIVE_WITH_TEAM" isVisible:"false"`
+	GP       int     `json:"GP" isVisible:"true" display:"GP" width:"7"`
+	PTS      int     `json:"PTS" isVisible:"true" display:"PTS" width:"8"`
+	REB      int     `json:"REB" isVisible:"true" display:"REB" width:"8"`
+	AST      int     `json:"AST" isVisible:"true" display:"AST" width:"8"`
+	STL      int     `json:"STL" isVisible:"true" display:"STL" width:"8"`
+	BLK      int     `json:"BLK" isVisible:"true" display:"BLK" width:"8"`
+	FGPCT    float64 `json:"FG_PCT" percentage:"true" isVisible:"true" display:"FG%" width:"8"`
+	FG3PCT   float64 `json:"FG3_PCT" percentage:"true" isVisible:"true" display:"3P%" width:"8"`
+	FTPCT    float64 `json:"FT_PCT" percentage:"true" isVisible:"true" display:"FT%" width:"8"`
+}
+
+// FranchiseHistory summarizes a franchise: the seasons it played, taken from TeamCommonInfo, and its leaders
+type FranchiseHistory struct {
+	FirstSeason string
+	LastSeason  string
+	Leaders     []FranchiseLeader
+	Players     []FranchisePlayer
+}
+
+// Seasons returns the number of seasons the franchise played, 0 when the first or last season is unknown
+func (h FranchiseHistory) Seasons() int {
+	first, err := strconv.Atoi(h.FirstSeason)
+	if err != nil {
+		return 0
+	}
+	last, err := strconv.Atoi(h.LastSeason)
+	if err != nil || last < first {
+		return 0
+	}
+	return last - first + 1
+}
+
+func (l AllTimeLeader) ToStringSlice() []string {
+	return structToStringSlice(l)
+}
+
+func (fl FranchiseLeader) ToStringSlice() []string {
+	return structToStringSlice(fl)
+}
+
+func (fp FranchisePlayer) ToStringSlice() []string {
+	return structToStringSlice(fp)
+}
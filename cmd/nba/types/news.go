@@ -0,0 +1,13 @@
+package types
+
+// NewsHeadline is a news article as listed in a table, the URL is kept to open the article
+type NewsHeadline struct {
+	Date   string `json:"DATE" isVisible:"true" display:"Date" width:"18"`
+	Source string `json:"SOURCE" isVisible:"true" display:"Source" width:"12"`
+	Title  string `json:"TITLE" isVisible:"true" display:"Headline" width:"100"`
+	URL    string `json:"URL" isVisible:"true" isID:"true"`
+}
+
+func (h NewsHeadline) ToStringSlice() []string {
+	return structToStringSlice(h)
+}
@@ -48,6 +48,40 @@ type GameLogEntry struct {
 	BLK       int     `json:"BLK" isVisible:"true" display:"BLK" width:"6"`
 	FGPCT     float64 `json:"FG_PCT" percentage:"true" isVisible:"true" display:"FG%" width:"8"`
 	PlusMinus float64 `json:"PLUS_MINUS" isVisible:"true" display:"+/-" width:"8"`
+	GameID    string  `json:"Game_ID" isID:"true"`
+}
+
+// SeasonTotals is SeasonStats with column names that fit the season totals and the per 36 minutes stats.
+// It shares the fields of SeasonStats, so the two convert into each other.
+type SeasonTotals struct {
+	SeasonID string  `json:"SEASON_ID" isVisible:"true" display:"Season" width:"14"`
+	TeamAbbr string  `json:"TEAM_ABBREVIATION" isVisible:"true" display:"Team" width:"8"`
+	GP       int     `json:"GP" isVisible:"true" display:"GP" width:"6"`
+	MIN      float64 `json:"MIN" isVisible:"true" display:"MIN" width:"9"`
+	PTS      float64 `json:"PTS" isVisible:"true" display:"PTS" width:"9"`
+	REB      float64 `json:"REB" isVisible:"true" display:"REB" width:"9"`
+	AST      float64 `json:"AST" isVisible:"true" display:"AST" width:"9"`
+	STL      float64 `json:"STL" isVisible:"true" display:"STL" width:"9"`
+	BLK      float64 `json:"BLK" isVisible:"true" display:"BLK" width:"9"`
+	FGPCT    float64 `json:"FG_PCT" percentage:"true" isVisible:"true" display:"FG%" width:"8"`
+	FG3PCT   float64 `json:"FG3_PCT" percentage:"true" isVisible:"true" display:"3PT%" width:"8"`
+	FTPCT    float64 `json:"FT_PCT" percentage:"true" isVisible:"true" display:"FT%" width:"8"`
+}
+
+// CareerStats holds the season by season and the career rows of the playercareerstats endpoint for one PerMode
+type CareerStats struct {
+	RegularSeason []SeasonStats
+	Playoffs      []SeasonStats
+	Career        []SeasonStats
+}
+
+// CareerStatsCacheID returns the identifier of the cached career stats of a player in a PerMode.
+// The per game stats keep the plain player id, as they are downloaded with the rest of the profile.
+func CareerStatsCacheID(playerID, perMode string) string {
+	if perMode == "" || perMode == "PerGame" {
+		return playerID
+	}
+	return playerID + "_" + perMode
 }
 
 type SeasonStatsList []SeasonStats
@@ -61,6 +95,67 @@ func (ss SeasonStats) ToStringSlice() []string {
 	return structToStringSlice(ss)
 }
 
+func (st SeasonTotals) ToStringSlice() []string {
+	return structToStringSlice(st)
+}
+
 func (gl GameLogEntry) ToStringSlice() []string {
 	return structToStringSlice(gl)
 }
+
+// PlayerSplit is a row of the player dashboard endpoints: the per game averages of a player within one split,
+// e.g. home games, games in January or games against a single opponent
+type PlayerSplit struct {
+	Split     string  `json:"GROUP_VALUE" isVisible:"true" display:"Split" width:"24"`
+	GP        int     `json:"GP" isVisible:"true" display:"GP" width:"5"`
+	W         int     `json:"W" isVisible:"true" display:"W" width:"5"`
+	L         int     `json:"L" isVisible:"true" display:"L" width:"5"`
+	MIN       float64 `json:"MIN" isVisible:"true" display:"MIN" width:"7"`
+	PTS       float64 `json:"PTS" isVisible:"true" display:"PTS" width:"7"`
+	REB       float64 `json:"REB" isVisible:"true" display:"REB" width:"7"`
+	AST       float64 `json:"AST" isVisible:"true" display:"AST" width:"7"`
+	STL       float64 `json:"STL" isVisible:"true" display:"STL" width:"7"`
+	BLK       float64 `json:"BLK" isVisible:"true" display:"BLK" width:"7"`
+	TOV       float64 `json:"TOV" isVisible:"true" display:"TOV" width:"7"`
+	FGPCT     float64 `json:"FG_PCT" percentage:"true" isVisible:"true" display:"FG%" width:"8"`
+	FG3PCT    float64 `json:"FG3_PCT" percentage:"true" isVisible:"true" display:"3P%" width:"8"`
+	FTPCT     float64 `json:"FT_PCT" percentage:"true" isVisible:"true" display:"FT%" width:"8"`
+	PlusMinus float64 `json:"PLUS_MINUS" isVisible:"true" display:"+/-" width:"7"`
+}
+
+// PlayerSplitGroup holds related splits which are displayed together as a single table
+type PlayerSplitGroup struct {
+	Name   string
+	Splits []PlayerSplit
+}
+
+func (ps PlayerSplit) ToStringSlice() []string {
+	return structToStringSlice(ps)
+}
+
+// PlayerAward is a row of the playerawards endpoint: a single award, selection or honor of a player.
+// Detail is not part of the response, it is derived from the team number, week or month of the award.
+type PlayerAward struct {
+	Season      string `json:"SEASON" isVisible:"true" display:"Season" width:"10"`
+	Description string `json:"DESCRIPTION" isVisible:"true" display:"Award" width:"36"`
+	Detail      string `json:"DETAIL" isVisible:"true" display:"Detail" width:"22"`
+	Team        string `json:"TEAM" isVisible:"true" display:"Team" width:"26"`
+	TeamNumber  string `json:"ALL_NBA_TEAM_NUMBER" isVisible:"false"`
+	Month       string `json:"MONTH" isVisible:"false"`
+	Week        string `json:"WEEK" isVisible:"false"`
+	Conference  string `json:"CONFERENCE" isVisible:"false"`
+}
+
+// PlayerAwardSummary counts the headline awards of a player, shown as badges on the player profile
+type PlayerAwardSummary struct {
+	MVP             int
+	FinalsMVP       int
+	Championships   int
+	AllNBA          int
+	AllStar         int
+	PlayerOfTheWeek int
+}
+
+func (pa PlayerAward) ToStringSlice() []string {
+	return structToStringSlice(pa)
+}
@@ -114,3 +114,12 @@ func TestSeasonStats_StructTags(t *testing.T) {
 		}
 	}
 }
+
+func TestCareerStatsCacheID(t *testing.T) {
+	if got := CareerStatsCacheID("1628389", "PerGame"); got != "1628389" {
+		t.Errorf("CareerStatsCacheID(PerGame) = %s, want the plain player id", got)
+	}
+	if got := CareerStatsCacheID("1628389", "Per36"); got != "1628389_Per36" {
+		t.Errorf("CareerStatsCacheID(Per36) = %s, want 1628389_Per36", got)
+	}
+}
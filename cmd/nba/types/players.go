@@ -95,6 +95,8 @@ type IndexPlayer struct {
 	Rebounds        float64 `json:"REB" isVisible:"true" display:"Rebounds"`
 	Assists         float64 `json:"AST" isVisible:"true" display:"Assists"`
 	StatsTimeframe  string  `json:"STATS_TIMEFRAME" isVisible:"false"`
+	InjuryStatus    string  `json:"INJURY_STATUS" isVisible:"true" display:"Status" width:"14"`
+	Transaction     string  `json:"TRANSACTION" isVisible:"true" display:"Move" width:"22"`
 }
 
 type IndexPlayers []IndexPlayer
@@ -9,21 +9,32 @@ type PlayoffTeam struct {
 }
 
 // PlayoffSeries represents one matchup in the bracket.
+// Play-in games are single game "series" (PlayIn set), decided by the first win.
 // Status: "pre" (projected, not started), "active", "complete".
 type PlayoffSeries struct {
 	SeriesID   string
-	Round      int    // 1=First Round, 2=Semis, 3=Conf Finals, 4=Finals
+	Round      int    // 1=First Round, 2=Semis, 3=Conf Finals, 4=Finals; 0 for play-in games
 	Conference string // "East", "West", "Finals"
 	TopTeam    PlayoffTeam
 	BottomTeam PlayoffTeam
 	Status     string
+	PlayIn     bool
 }
 
 type PlayoffBracket struct {
 	Season string
 	Series []PlayoffSeries // length 15 max; ordered by cursor index (see design doc)
+	PlayIn []PlayoffSeries // length 6 max; East then West, each ordered 7v8, 9v10, final. Empty before 2020-21
 }
 
+// Play-in game positions within a conference's block of PlayoffBracket.PlayIn
+const (
+	PlayInSevenEight         = 0
+	PlayInNineTen            = 1
+	PlayInFinal              = 2
+	PlayInGamesPerConference = 3
+)
+
 type PlayoffGame struct {
 	GameID      string
 	Date        string
@@ -36,7 +47,36 @@ type PlayoffGame struct {
 }
 
 func (s PlayoffSeries) IsComplete() bool {
-	return s.TopTeam.Wins == 4 || s.BottomTeam.Wins == 4
+	return s.TopTeam.Wins == s.winsNeeded() || s.BottomTeam.Wins == s.winsNeeded()
+}
+
+// Winner returns the team that won the series, if it is complete.
+func (s PlayoffSeries) Winner() (PlayoffTeam, bool) {
+	switch {
+	case s.TopTeam.Wins == s.winsNeeded():
+		return s.TopTeam, true
+	case s.BottomTeam.Wins == s.winsNeeded():
+		return s.BottomTeam, true
+	}
+	return PlayoffTeam{}, false
+}
+
+// Loser returns the team that lost the series, if it is complete.
+func (s PlayoffSeries) Loser() (PlayoffTeam, bool) {
+	switch {
+	case s.TopTeam.Wins == s.winsNeeded():
+		return s.BottomTeam, true
+	case s.BottomTeam.Wins == s.winsNeeded():
+		return s.TopTeam, true
+	}
+	return PlayoffTeam{}, false
+}
+
+func (s PlayoffSeries) winsNeeded() int {
+	if s.PlayIn {
+		return 1
+	}
+	return 4
 }
 
 // Leader returns the tricode of the team leading the series, or "" if tied.
@@ -49,3 +89,41 @@ func (s PlayoffSeries) Leader() string {
 	}
 	return ""
 }
+
+// PlayoffOdds holds the simulated end-of-season outlook of a single team.
+// Probabilities are in the 0-1 range: TopSixPct (direct playoff spot), PlayInPct (seeds 7-10) and MissPct (11-15).
+type PlayoffOdds struct {
+	TeamID          int     `json:"TEAM_ID" isVisible:"false" isID:"true"`
+	TeamName        string  `json:"TEAM_NAME" isVisible:"true" display:"Team" width:"15"`
+	Conference      string  `json:"CONFERENCE" isVisible:"false"`
+	Wins            int     `json:"W" isVisible:"true" display:"Wins" width:"8"`
+	Losses          int     `json:"L" isVisible:"true" display:"Losses" width:"8"`
+	GamesLeft       int     `json:"GAMES_LEFT" isVisible:"true" display:"Left" width:"8"`
+	ProjectedWins   float64 `json:"PROJ_W" isVisible:"true" display:"Proj. W" width:"10"`
+	ProjectedLosses float64 `json:"PROJ_L" isVisible:"true" display:"Proj. L" width:"10"`
+	CurrentSeed     int     `json:"CURRENT_SEED" isVisible:"true" display:"Seed" width:"8"`
+	ProjectedSeed   int     `json:"PROJ_SEED" isVisible:"true" display:"Proj. Seed" width:"12"`
+	TopSixPct       float64 `json:"TOP_SIX_PCT" percentage:"true" isVisible:"true" display:"Top 6" width:"10"`
+	PlayInPct       float64 `json:"PLAY_IN_PCT" percentage:"true" isVisible:"true" display:"Play-In" width:"10"`
+	MissPct         float64 `json:"MISS_PCT" percentage:"true" isVisible:"true" display:"Out" width:"10"`
+}
+
+func (po PlayoffOdds) ToStringSlice() []string {
+	return structToStringSlice(po)
+}
+
+// PlayoffArchiveEntry summarizes the playoffs of a single past season
+type PlayoffArchiveEntry struct {
+	Season       string `json:"SEASON" isVisible:"true" display:"Season" width:"9"`
+	Champion     string `json:"CHAMPION" isVisible:"true" display:"Champion" width:"10"`
+	FinalsResult string `json:"FINALS_RESULT" isVisible:"true" display:"Finals" width:"12"`
+	RunnerUp     string `json:"RUNNER_UP" isVisible:"true" display:"Runner-up" width:"11"`
+	FinalsMVP    string `json:"FINALS_MVP" isVisible:"true" display:"Finals MVP" width:"26"`
+	EastChampion string `json:"EAST_CHAMPION" isVisible:"true" display:"East" width:"8"`
+	WestChampion string `json:"WEST_CHAMPION" isVisible:"true" display:"West" width:"8"`
+}
+
+// ToStringSlice is a method on the PlayoffArchiveEntry type that enables the attributes of the type to be converted to strings
+func (pa PlayoffArchiveEntry) ToStringSlice() []string {
+	return structToStringSlice(pa)
+}
@@ -29,3 +29,44 @@ func TestPlayoffSeries_Leader(t *testing.T) {
 		t.Errorf("Leader() tied series = %s, want empty string", got)
 	}
 }
+
+func TestPlayoffSeries_PlayInWinner(t *testing.T) {
+	s := PlayoffSeries{
+		PlayIn:     true,
+		TopTeam:    PlayoffTeam{Tricode: "ORL", Wins: 1},
+		BottomTeam: PlayoffTeam{Tricode: "ATL"},
+	}
+	if !s.IsComplete() {
+		t.Error("expected play-in game with 1 win to be complete")
+	}
+	if winner, ok := s.Winner(); !ok || winner.Tricode != "ORL" {
+		t.Errorf("Winner() = %s (%v), want ORL", winner.Tricode, ok)
+	}
+	if loser, ok := s.Loser(); !ok || loser.Tricode != "ATL" {
+		t.Errorf("Loser() = %s (%v), want ATL", loser.Tricode, ok)
+	}
+
+	series := PlayoffSeries{TopTeam: PlayoffTeam{Wins: 1}}
+	if _, ok := series.Winner(); ok {
+		t.Error("expected series with 1 win to have no winner")
+	}
+}
+
+func TestBracketPrediction_SetPick(t *testing.T) {
+	var prediction BracketPrediction
+	prediction.SetPick(SeriesPick{Series: 0, Winner: "BOS", Games: 6})
+	prediction.SetPick(SeriesPick{Series: 3, Winner: "PHI", Games: 5})
+	prediction.SetPick(SeriesPick{Series: 0, Winner: "MIA", Games: 7})
+
+	if pick, ok := prediction.Pick(0); !ok || pick.Winner != "MIA" || pick.Games != 7 {
+		t.Errorf("Pick(0) = %+v, want MIA in 7", pick)
+	}
+
+	prediction.SetPick(SeriesPick{Series: 0})
+	if _, ok := prediction.Pick(0); ok {
+		t.Error("expected pick of series 0 to be removed")
+	}
+	if len(prediction.Picks) != 1 {
+		t.Errorf("len(Picks) = %d, want 1", len(prediction.Picks))
+	}
+}
@@ -35,7 +35,8 @@ type ResponseSet struct {
 		Request string `json:"request"`
 		Time    string `json:"time"`
 	} `json:"meta"`
-	Scoreboard *ScoreboardV3Data `json:"scoreboard,omitempty"`
+	Scoreboard     *ScoreboardV3Data `json:"scoreboard,omitempty"`
+	LeagueSchedule *LeagueSchedule   `json:"leagueSchedule,omitempty"`
 }
 
 // structToStringSlice is the core function that converts type attributes from Float64 and Int to String,
@@ -0,0 +1,67 @@
+package types
+
+import "strings"
+
+// LeagueSchedule represents the "leagueSchedule" object returned by the scheduleleaguev2 endpoint
+type LeagueSchedule struct {
+	SeasonYear string             `json:"seasonYear"`
+	LeagueID   string             `json:"leagueId"`
+	GameDates  []ScheduleGameDate `json:"gameDates"`
+}
+
+// ScheduleGameDate groups all scheduled games of a single date
+type ScheduleGameDate struct {
+	GameDate string         `json:"gameDate"`
+	Games    []ScheduleGame `json:"games"`
+}
+
+// ScheduleGame represents a single game in the league schedule (played or not)
+type ScheduleGame struct {
+	GameID         string       `json:"gameId"`
+	GameCode       string       `json:"gameCode"`
+	GameStatus     int          `json:"gameStatus"`
+	GameStatusText string       `json:"gameStatusText"`
+	GameDateEst    string       `json:"gameDateEst"`
+	HomeTeam       ScheduleTeam `json:"homeTeam"`
+	AwayTeam       ScheduleTeam `json:"awayTeam"`
+}
+
+// ScheduleTeam is the team data attached to a scheduled game
+type ScheduleTeam struct {
+	TeamID      int    `json:"teamId"`
+	TeamName    string `json:"teamName"`
+	TeamCity    string `json:"teamCity"`
+	TeamTricode string `json:"teamTricode"`
+	Wins        int    `json:"wins"`
+	Losses      int    `json:"losses"`
+	Score       int    `json:"score"`
+	Seed        int    `json:"seed"`
+}
+
+// ScheduledGame is the flattened, internal representation of a ScheduleGame
+type ScheduledGame struct {
+	GameID     string
+	GameDate   string // YYYY-MM-DD
+	GameStatus int    // 1 - scheduled, 2 - live, 3 - final
+	HomeTeamID int
+	AwayTeamID int
+	HomeScore  int
+	AwayScore  int
+	HomeSeed   int
+	AwaySeed   int
+}
+
+// IsRegularSeason reports whether the game is a regular season game (game IDs prefixed with "002")
+func (g ScheduledGame) IsRegularSeason() bool {
+	return strings.HasPrefix(g.GameID, "002")
+}
+
+// IsPlayIn reports whether the game is a play-in tournament game (game IDs prefixed with "005")
+func (g ScheduledGame) IsPlayIn() bool {
+	return strings.HasPrefix(g.GameID, "005")
+}
+
+// IsFinal reports whether the game has been completed
+func (g ScheduledGame) IsFinal() bool {
+	return g.GameStatus == 3
+}
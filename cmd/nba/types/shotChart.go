@@ -0,0 +1,94 @@
+package types
+
+import "fmt"
+
+// Shot is a single field goal attempt from the shotchartdetail endpoint. LocX and LocY are in tenths of a foot,
+// relative to the basket: x runs from -250 (left sideline) to 250, y from -47.5 (baseline) towards half court.
+type Shot struct {
+	GameID     string `json:"GAME_ID"`
+	GameDate   string `json:"GAME_DATE"`
+	Period     int    `json:"PERIOD"`
+	ActionType string `json:"ACTION_TYPE"`
+	ShotType   string `json:"SHOT_TYPE"`
+	ZoneBasic  string `json:"SHOT_ZONE_BASIC"`
+	ZoneArea   string `json:"SHOT_ZONE_AREA"`
+	ZoneRange  string `json:"SHOT_ZONE_RANGE"`
+	Distance   int    `json:"SHOT_DISTANCE"`
+	LocX       int    `json:"LOC_X"`
+	LocY       int    `json:"LOC_Y"`
+	MadeFlag   int    `json:"SHOT_MADE_FLAG"`
+}
+
+// Made reports whether the shot went in
+func (s Shot) Made() bool {
+	return s.MadeFlag == 1
+}
+
+// ShotZoneStats is the shooting of a player or team from one of the basic court zones, next to the league average
+type ShotZoneStats struct {
+	Zone        string  `json:"ZONE" isVisible:"true" display:"Zone" width:"22"`
+	FGM         int     `json:"FGM" isVisible:"true" display:"FGM" width:"6"`
+	FGA         int     `json:"FGA" isVisible:"true" display:"FGA" width:"6"`
+	FGPct       float64 `json:"FG_PCT" percentage:"true" isVisible:"true" display:"FG%" width:"8"`
+	LeagueFGPct float64 `json:"LEAGUE_FG_PCT" percentage:"true" isVisible:"true" display:"Lg FG%" width:"8"`
+	Frequency   float64 `json:"FREQUENCY" percentage:"true" isVisible:"true" display:"Freq" width:"8"`
+}
+
+// ToStringSlice is a method on the ShotZoneStats type that enables the attributes of the type to be converted to strings
+func (sz ShotZoneStats) ToStringSlice() []string {
+	return structToStringSlice(sz)
+}
+
+// ShotChart holds the shots matching a ShotChartFilter and their per zone breakdown
+type ShotChart struct {
+	Shots []Shot
+	Zones []ShotZoneStats
+}
+
+// Zone returns the stats of a basic court zone
+func (sc ShotChart) Zone(zone string) (ShotZoneStats, bool) {
+	for _, z := range sc.Zones {
+		if z.Zone == zone {
+			return z, true
+		}
+	}
+	return ShotZoneStats{}, false
+}
+
+// ShotChartFilter narrows down the shots of a shot chart. LastNGames and GameID are optional, GameID takes precedence.
+type ShotChartFilter struct {
+	Season     string
+	LastNGames int
+	GameID     string
+}
+
+// CacheID returns the identifier of the cached shot chart of a player or team for this filter
+func (f ShotChartFilter) CacheID(playerID, teamID string) string {
+	subject := "p" + playerID
+	if playerID == "" || playerID == "0" {
+		subject = "t" + teamID
+	}
+	if f.GameID != "" {
+		return fmt.Sprintf("%s_%s_g%s", subject, f.Season, f.GameID)
+	}
+	return fmt.Sprintf("%s_%s_l%d", subject, f.Season, f.LastNGames)
+}
+
+// ShotLocations is the response of the leaguedashteamshotlocations endpoint. Unlike the other stats endpoints
+// its resultSets is a single object with two header rows: the shot zones, each spanning a few columns,
+// and the column names below them.
+type ShotLocations struct {
+	ResultSets struct {
+		Name    string                `json:"name"`
+		Headers []ShotLocationsHeader `json:"headers"`
+		RowSet  [][]interface{}       `json:"rowSet"`
+	} `json:"resultSets"`
+}
+
+// ShotLocationsHeader is one of the header rows of ShotLocations
+type ShotLocationsHeader struct {
+	Name          string   `json:"name"`
+	ColumnsToSkip int      `json:"columnsToSkip"`
+	ColumnSpan    int      `json:"columnSpan"`
+	ColumnNames   []string `json:"columnNames"`
+}
@@ -0,0 +1,23 @@
+package types
+
+import "testing"
+
+func TestShotChartFilter_CacheID(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   ShotChartFilter
+		playerID string
+		teamID   string
+		want     string
+	}{
+		{"player season", ShotChartFilter{Season: "2024-25"}, "1628389", "", "p1628389_2024-25_l0"},
+		{"player last games", ShotChartFilter{Season: "2024-25", LastNGames: 10}, "1628389", "0", "p1628389_2024-25_l10"},
+		{"player game", ShotChartFilter{Season: "2024-25", LastNGames: 10, GameID: "0022400100"}, "1628389", "", "p1628389_2024-25_g0022400100"},
+		{"team season", ShotChartFilter{Season: "2024-25"}, "", "1610612748", "t1610612748_2024-25_l0"},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.CacheID(tt.playerID, tt.teamID); got != tt.want {
+			t.Errorf("%s: CacheID() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+// TeamGameResult is a row of the leaguegamelog endpoint queried for teams: the result of one team in one game
+type TeamGameResult struct {
+	TeamID           int    `json:"TEAM_ID"`
+	TeamAbbreviation string `json:"TEAM_ABBREVIATION"`
+	TeamName         string `json:"TEAM_NAME"`
+	GameID           string `json:"GAME_ID"`
+	GameDate         string `json:"GAME_DATE"`
+	WL               string `json:"WL"`
+}
+
+// StandingsPoint is the record and conference rank of a team after all games of a date
+type StandingsPoint struct {
+	Date     string
+	Wins     int
+	Losses   int
+	ConfRank int
+}
+
+// WinPct returns the winning percentage of the record, 0 before the first game
+func (p StandingsPoint) WinPct() float64 {
+	if p.Wins+p.Losses == 0 {
+		return 0
+	}
+	return float64(p.Wins) / float64(p.Wins+p.Losses)
+}
+
+// TeamStandingsHistory is the day by day record of a team over a season. Points has one entry per game date
+// of the league, so the histories of all teams line up.
+type TeamStandingsHistory struct {
+	TeamID     int
+	Tricode    string
+	Nickname   string
+	Conference string
+	Points     []StandingsPoint
+}
+
+// Latest returns the standing after the last game date
+func (h TeamStandingsHistory) Latest() StandingsPoint {
+	if len(h.Points) == 0 {
+		return StandingsPoint{}
+	}
+	return h.Points[len(h.Points)-1]
+}
@@ -1,5 +1,7 @@
 package types
 
+import "strconv"
+
 type Team struct {
 	LeagueID                string  `json:"LeagueID" isVisible:"false"`
 	SeasonID                string  `json:"SeasonID" isVisible:"false"`
@@ -41,7 +43,7 @@ type Team struct {
 	StrCurrentStreak        string  `json:"strCurrentStreak" isVisible:"false"`
 	ConferenceGamesBack     float64 `json:"ConferenceGamesBack" isVisible:"false"`
 	DivisionGamesBack       float64 `json:"DivisionGamesBack" isVisible:"false"`
-	ClinchedConferenceTitle int     `json:"ClinchedConferenceTitle" isVisible:"true" display:"Clinched Conf.""`
+	ClinchedConferenceTitle int     `json:"ClinchedConferenceTitle" isVisible:"true" display:"Clinched Conf."`
 	ClinchedDivisionTitle   int     `json:"ClinchedDivisionTitle" isVisible:"true" display:"Clinched Div."`
 	ClinchedPlayoffBirth    int     `json:"ClinchedPlayoffBirth" isVisible:"true" display:"Clinched PO"`
 	ClinchedPlayIn          int     `json:"ClinchedPlayIn" isVisible:"true" display:"Clinched PlayIn"`
@@ -96,6 +98,22 @@ type Team struct {
 
 type Teams []Team
 
+// TeamSplit is a single split record of a team (e.g. record vs. the East, in January, in games decided by 3 or less).
+// The standings API returns these as "W-L" strings, which are broken down into wins, losses and win percentage.
+type TeamSplit struct {
+	Split  string  `json:"SPLIT" isVisible:"true" display:"Split" width:"22"`
+	Record string  `json:"RECORD" isVisible:"true" display:"Record" width:"10"`
+	Wins   int     `json:"W" isVisible:"true" display:"Wins" width:"8"`
+	Losses int     `json:"L" isVisible:"true" display:"Losses" width:"8"`
+	WinPct float64 `json:"WIN_PCT" percentage:"true" isVisible:"true" display:"Win%" width:"8"`
+}
+
+// TeamSplitGroup holds related splits which are displayed together as a single table
+type TeamSplitGroup struct {
+	Name   string
+	Splits []TeamSplit
+}
+
 type TeamCommonInfo struct {
 	TeamID         int     `json:"TEAM_ID" isVisible:"false" isID:"true"`
 	SeasonYear     string  `json:"SEASON_YEAR" isVisible:"false" isID:"false"`
@@ -125,6 +143,11 @@ func (t Team) ToStringSlice() []string {
 	return structToStringSlice(t)
 }
 
+// ToStringSlice is a method on the TeamSplit type that enables the attributes of the type to be converted to strings
+func (ts TeamSplit) ToStringSlice() []string {
+	return structToStringSlice(ts)
+}
+
 // ToStringSlice is a method on the Teams type that enables the attributes of type to be converted to strings
 func (ts Teams) ToStringSlice() []string {
 	return structToStringSlice(ts)
@@ -146,3 +169,58 @@ func (ts Teams) SplitStandingsPerConference() (Teams, Teams) {
 	}
 	return eastTeams, westTeams
 }
+
+// FindByID returns the team with the given TeamID from the standings
+func (ts Teams) FindByID(teamID int) (Team, bool) {
+	for _, team := range ts {
+		if team.TeamID == teamID {
+			return team, true
+		}
+	}
+	return Team{}, false
+}
+
+// TeamLineup is a row of the advanced teamdashlineups endpoint: the season totals of a unit of two to five players
+type TeamLineup struct {
+	GroupID   string  `json:"GROUP_ID" isVisible:"false" isID:"true"`
+	Lineup    string  `json:"GROUP_NAME" isVisible:"true" display:"Lineup" width:"60"`
+	GP        int     `json:"GP" isVisible:"true" sortable:"true" display:"GP" width:"6"`
+	W         int     `json:"W" isVisible:"true" sortable:"true" display:"W" width:"5"`
+	L         int     `json:"L" isVisible:"true" sortable:"true" display:"L" width:"5"`
+	MIN       float64 `json:"MIN" isVisible:"true" sortable:"true" display:"MIN" width:"9"`
+	OffRating float64 `json:"OFF_RATING" isVisible:"true" sortable:"true" display:"OffRtg" width:"9"`
+	DefRating float64 `json:"DEF_RATING" isVisible:"true" sortable:"true" display:"DefRtg" width:"9"`
+	NetRating float64 `json:"NET_RATING" isVisible:"true" sortable:"true" display:"NetRtg" width:"9"`
+	TSPCT     float64 `json:"TS_PCT" percentage:"true" isVisible:"true" sortable:"true" display:"TS%" width:"7"`
+	Pace      float64 `json:"PACE" isVisible:"true" sortable:"true" display:"Pace" width:"8"`
+}
+
+// TeamLineupGroup holds the lineups of one size, e.g. all five-man units
+type TeamLineupGroup struct {
+	Size    int
+	Lineups []TeamLineup
+}
+
+// PlayerOnOff compares how a team played with a player on the court and with the player on the bench
+type PlayerOnOff struct {
+	PlayerID     int     `json:"VS_PLAYER_ID" isVisible:"false" isID:"true"`
+	Player       string  `json:"VS_PLAYER_NAME" isVisible:"true" display:"Player" width:"26"`
+	OnMIN        float64 `json:"ON_MIN" isVisible:"true" sortable:"true" display:"On MIN" width:"9"`
+	OnNetRating  float64 `json:"ON_NET_RATING" isVisible:"true" sortable:"true" display:"On NetRtg" width:"11"`
+	OffMIN       float64 `json:"OFF_MIN" isVisible:"true" sortable:"true" display:"Off MIN" width:"9"`
+	OffNetRating float64 `json:"OFF_NET_RATING" isVisible:"true" sortable:"true" display:"Off NetRtg" width:"12"`
+	OnOffDiff    float64 `json:"ON_OFF_DIFF" isVisible:"true" sortable:"true" display:"On-Off" width:"9"`
+}
+
+// TeamLineupsCacheID returns the identifier of the cached lineups of a size for a team and season
+func TeamLineupsCacheID(teamID string, groupQuantity int, season string) string {
+	return teamID + "_lineups_" + strconv.Itoa(groupQuantity) + "_" + season
+}
+
+func (tl TeamLineup) ToStringSlice() []string {
+	return structToStringSlice(tl)
+}
+
+func (po PlayerOnOff) ToStringSlice() []string {
+	return structToStringSlice(po)
+}
@@ -0,0 +1,24 @@
+package types
+
+// TransactionsResponse is the player movement feed of nba.com, one row per player and team of a transaction
+type TransactionsResponse struct {
+	PlayerMovement struct {
+		Rows []map[string]interface{} `json:"rows"`
+	} `json:"NBA_Player_Movement"`
+}
+
+// Transaction is a roster move: a trade, signing, waiver or other move of one player by one team
+type Transaction struct {
+	Date        string `json:"TRANSACTION_DATE" isVisible:"true" display:"Date" width:"12"`
+	Type        string `json:"TRANSACTION_TYPE" isVisible:"true" display:"Type" width:"10"`
+	TeamID      int    `json:"TEAM_ID" isVisible:"false" isID:"true"`
+	Team        string `json:"TEAM_ABBREVIATION" isVisible:"true" display:"Team" width:"6"`
+	TeamName    string `json:"TEAM_NAME" isVisible:"false"`
+	PlayerID    int    `json:"PLAYER_ID" isVisible:"false" isID:"true"`
+	Player      string `json:"PLAYER_NAME" isVisible:"true" display:"Player" width:"24"`
+	Description string `json:"TRANSACTION_DESCRIPTION" isVisible:"true" display:"Transaction" width:"90"`
+}
+
+func (t Transaction) ToStringSlice() []string {
+	return structToStringSlice(t)
+}
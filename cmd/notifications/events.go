@@ -0,0 +1,181 @@
+package notifications
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// EventKind is what happened in a game
+type EventKind int
+
+const (
+	GameStarted EventKind = iota
+	GameFinal
+	CloseLate
+)
+
+// closeLateMargin and closeLateClock define a close game late: within 5 points in the last 2 minutes of the
+// fourth quarter or of an overtime
+const (
+	closeLateMargin = 5
+	closeLateClock  = 2 * time.Minute
+)
+
+// Event is a notification about a game of a favorite team
+type Event struct {
+	Kind      EventKind
+	GameID    string
+	Away      string
+	Home      string
+	AwayScore int
+	HomeScore int
+	Period    int
+	Clock     string
+}
+
+// Title is the headline of the notification
+func (e Event) Title() string {
+	switch e.Kind {
+	case GameStarted:
+		return fmt.Sprintf("%s @ %s tipped off", e.Away, e.Home)
+	case GameFinal:
+		return fmt.Sprintf("Final: %s %d @ %s %d", e.Away, e.AwayScore, e.Home, e.HomeScore)
+	case CloseLate:
+		return fmt.Sprintf("Close game: %s @ %s", e.Away, e.Home)
+	}
+	return e.Away + " @ " + e.Home
+}
+
+// Message is the body of the notification
+func (e Event) Message() string {
+	score := fmt.Sprintf("%s %d - %d %s", e.Away, e.AwayScore, e.HomeScore, e.Home)
+	switch e.Kind {
+	case GameStarted:
+		return score
+	case GameFinal:
+		if e.Period > 4 {
+			return score + fmt.Sprintf(" (%s)", periodName(e.Period))
+		}
+		return score
+	}
+	return fmt.Sprintf("%s, %s left in the %s", score, e.Clock, periodName(e.Period))
+}
+
+func periodName(period int) string {
+	switch {
+	case period <= 4:
+		return "Q" + strconv.Itoa(period)
+	case period == 5:
+		return "OT"
+	}
+	return strconv.Itoa(period-4) + "OT"
+}
+
+var gameClockPattern = regexp.MustCompile(`^PT(\d+)M(\d+(?:\.\d+)?)S$`)
+
+// ParseGameClock reads the time left in a period as the scoreboard tells it, e.g. "PT01M45.00S"
+func ParseGameClock(clock string) (time.Duration, bool) {
+	m := gameClockPattern.FindStringSubmatch(clock)
+	if m == nil {
+		return 0, false
+	}
+	minutes, _ := strconv.Atoi(m[1])
+	seconds, _ := strconv.ParseFloat(m[2], 64)
+	return time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second)), true
+}
+
+// formatClock formats the time left in a period like a scoreboard, e.g. "1:45"
+func formatClock(d time.Duration) string {
+	secs := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
+// gameState is what the watcher remembers of a game between two polls
+type gameState struct {
+	status          int
+	closeLatePeriod int
+}
+
+// Watcher turns the polls of the live scoreboard into events about the games of the favorite teams. A game
+// has to be seen before it starts or ends for the start or end to be an event, so opening the app during a
+// game does not notify it started.
+type Watcher struct {
+	favorites map[int]bool
+	games     map[string]gameState
+}
+
+// NewWatcher instantiates a Watcher without favorite teams
+func NewWatcher() *Watcher {
+	return &Watcher{favorites: make(map[int]bool), games: make(map[string]gameState)}
+}
+
+// SetFavorites replaces the favorite teams, by their team IDs
+func (w *Watcher) SetFavorites(teamIDs []int) {
+	w.favorites = make(map[int]bool, len(teamIDs))
+	for _, id := range teamIDs {
+		w.favorites[id] = true
+	}
+}
+
+// Update compares the games of a poll to the previous poll and returns what happened since
+func (w *Watcher) Update(games []types.ScoreboardV3Game) []Event {
+	var events []Event
+	for _, g := range games {
+		if !w.favorites[g.HomeTeam.TeamID] && !w.favorites[g.AwayTeam.TeamID] {
+			continue
+		}
+		prev, seen := w.games[g.GameID]
+		state := gameState{status: g.GameStatus, closeLatePeriod: prev.closeLatePeriod}
+		event := Event{
+			GameID:    g.GameID,
+			Away:      g.AwayTeam.TeamTricode,
+			Home:      g.HomeTeam.TeamTricode,
+			AwayScore: g.AwayTeam.Score,
+			HomeScore: g.HomeTeam.Score,
+			Period:    g.Period,
+		}
+
+		switch {
+		case seen && prev.status == 1 && g.GameStatus == 2:
+			event.Kind = GameStarted
+			events = append(events, event)
+		case seen && prev.status == 2 && g.GameStatus == 3:
+			event.Kind = GameFinal
+			events = append(events, event)
+		}
+
+		if g.GameStatus == 2 && g.Period > prev.closeLatePeriod && isCloseLate(g) {
+			left, _ := ParseGameClock(g.GameClock)
+			event.Kind = CloseLate
+			event.Clock = formatClock(left)
+			events = append(events, event)
+			state.closeLatePeriod = g.Period
+		}
+		w.games[g.GameID] = state
+	}
+	return events
+}
+
+// isCloseLate tells if a game is within 5 points in the last 2 minutes of the fourth quarter or an overtime
+func isCloseLate(g types.ScoreboardV3Game) bool {
+	regulation := g.RegulationPeriods
+	if regulation == 0 {
+		regulation = 4
+	}
+	if g.Period < regulation {
+		return false
+	}
+	left, ok := ParseGameClock(g.GameClock)
+	if !ok || left > closeLateClock {
+		return false
+	}
+	margin := g.HomeTeam.Score - g.AwayTeam.Score
+	if margin < 0 {
+		margin = -margin
+	}
+	return margin <= closeLateMargin
+}
@@ -0,0 +1,105 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func scoreboardGame(status, period int, clock string, away, home int) types.ScoreboardV3Game {
+	return types.ScoreboardV3Game{
+		GameID:     "0022400001",
+		GameStatus: status,
+		Period:     period,
+		GameClock:  clock,
+		AwayTeam:   types.ScoreboardV3Team{TeamID: 1610612738, TeamTricode: "BOS", Score: away},
+		HomeTeam:   types.ScoreboardV3Team{TeamID: 1610612747, TeamTricode: "LAL", Score: home},
+	}
+}
+
+func TestParseGameClock(t *testing.T) {
+	tests := []struct {
+		clock string
+		want  time.Duration
+		ok    bool
+	}{
+		{"PT01M45.00S", time.Minute + 45*time.Second, true},
+		{"PT00M02.50S", 2500 * time.Millisecond, true},
+		{"PT12M00S", 12 * time.Minute, true},
+		{"", 0, false},
+		{"1:45", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseGameClock(tt.clock)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseGameClock(%q) = %v, %v, want %v, %v", tt.clock, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestWatcher_Update(t *testing.T) {
+	w := NewWatcher()
+	w.SetFavorites([]int{1610612738})
+
+	kinds := func(events []Event) []EventKind {
+		var k []EventKind
+		for _, e := range events {
+			k = append(k, e.Kind)
+		}
+		return k
+	}
+	polls := []struct {
+		name string
+		game types.ScoreboardV3Game
+		want []EventKind
+	}{
+		{"scheduled", scoreboardGame(1, 0, "", 0, 0), nil},
+		{"tip off", scoreboardGame(2, 1, "PT11M40.00S", 2, 0), []EventKind{GameStarted}},
+		{"early", scoreboardGame(2, 2, "PT01M00.00S", 50, 48), nil},
+		{"close late", scoreboardGame(2, 4, "PT01M45.00S", 100, 97), []EventKind{CloseLate}},
+		{"still close, same period", scoreboardGame(2, 4, "PT00M30.00S", 100, 99), nil},
+		{"close in overtime", scoreboardGame(2, 5, "PT00M50.00S", 110, 108), []EventKind{CloseLate}},
+		{"final", scoreboardGame(3, 5, "PT00M00.00S", 112, 108), []EventKind{GameFinal}},
+		{"still final", scoreboardGame(3, 5, "PT00M00.00S", 112, 108), nil},
+	}
+	for _, p := range polls {
+		got := kinds(w.Update([]types.ScoreboardV3Game{p.game}))
+		if len(got) != len(p.want) {
+			t.Fatalf("%s: got events %v, want %v", p.name, got, p.want)
+		}
+		for i := range got {
+			if got[i] != p.want[i] {
+				t.Errorf("%s: got events %v, want %v", p.name, got, p.want)
+			}
+		}
+	}
+}
+
+func TestWatcher_Update_NotFavoriteOrJoinedLate(t *testing.T) {
+	w := NewWatcher()
+	w.SetFavorites([]int{1610612744})
+	if events := w.Update([]types.ScoreboardV3Game{scoreboardGame(2, 4, "PT01M00.00S", 90, 90)}); len(events) != 0 {
+		t.Errorf("expected no events for a game without favorite teams, got %v", events)
+	}
+
+	// a game already going when first seen has not just started
+	w.SetFavorites([]int{1610612747})
+	if events := w.Update([]types.ScoreboardV3Game{scoreboardGame(2, 2, "PT05M00.00S", 40, 40)}); len(events) != 0 {
+		t.Errorf("expected no events for a game first seen in progress, got %v", events)
+	}
+}
+
+func TestEvent_Message(t *testing.T) {
+	e := Event{Kind: CloseLate, Away: "BOS", Home: "LAL", AwayScore: 100, HomeScore: 97, Period: 4, Clock: "1:45"}
+	if got, want := e.Message(), "BOS 100 - 97 LAL, 1:45 left in the Q4"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	e = Event{Kind: GameFinal, Away: "BOS", Home: "LAL", AwayScore: 112, HomeScore: 108, Period: 6}
+	if got, want := e.Title(), "Final: BOS 112 @ LAL 108"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := e.Message(), "BOS 112 - 108 LAL (2OT)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// Config configures the notifications, it is read from notifications.json in the config directory
+type Config struct {
+	Enabled         bool     `json:"enabled"`
+	Sinks           []string `json:"sinks"`
+	Command         string   `json:"command"`
+	WebhookURL      string   `json:"webhook_url"`
+	IntervalSeconds int      `json:"interval_seconds"`
+}
+
+// DefaultConfig is off. Once enabled, it rings the bell and asks the terminal for a desktop notification,
+// polling every 30 seconds.
+var DefaultConfig = Config{
+	Enabled:         false,
+	Sinks:           []string{SinkBell, SinkOSC9},
+	Command:         "notify-send",
+	IntervalSeconds: 30,
+}
+
+// LoadConfig reads the notifications config, the default config is used when there is none. Settings left out
+// of the file keep their defaults.
+func LoadConfig(fs filesystemops.FileSystemHandler, paths pathManager.PathManager) (Config, error) {
+	path := paths.GetFullPath("notifications", "")
+	data, err := filesystemops.ReadConfigFile(fs, path)
+	if err != nil || data == nil {
+		return DefaultConfig, err
+	}
+	cfg := DefaultConfig
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return DefaultConfig, fmt.Errorf("could not unmarshal notifications config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Interval returns how often the live scoreboard is polled
+func (c Config) Interval() time.Duration {
+	if c.IntervalSeconds < 5 {
+		return 5 * time.Second
+	}
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
+
+// NewSinks instantiates the sinks of the config, terminal sinks write to w
+func NewSinks(cfg Config, w io.Writer) ([]Sink, error) {
+	var sinks []Sink
+	var errs []error
+	for _, name := range cfg.Sinks {
+		switch name {
+		case SinkBell:
+			sinks = append(sinks, NewBellSink(w))
+		case SinkOSC9:
+			sinks = append(sinks, NewOSCSink(w, 9))
+		case SinkOSC777:
+			sinks = append(sinks, NewOSCSink(w, 777))
+		case SinkNotifySend:
+			command := cfg.Command
+			if command == "" {
+				command = "notify-send"
+			}
+			sinks = append(sinks, NewCommandSink(command))
+		case SinkWebhook:
+			if cfg.WebhookURL == "" {
+				errs = append(errs, fmt.Errorf("the webhook sink needs a webhook_url"))
+				continue
+			}
+			sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, nil))
+		default:
+			errs = append(errs, fmt.Errorf("unknown notification sink %q", name))
+		}
+	}
+	return sinks, errors.Join(errs...)
+}
+
+// Notifier sends the events of the favorite teams' games to every sink
+type Notifier struct {
+	sinks   []Sink
+	watcher *Watcher
+}
+
+// NewNotifier instantiates a Notifier delivering to the given sinks
+func NewNotifier(sinks []Sink) *Notifier {
+	return &Notifier{sinks: sinks, watcher: NewWatcher()}
+}
+
+// Poll takes the games of a poll of the live scoreboard with the favorite teams at that time, and notifies
+// what happened since the previous poll. A failing sink does not keep the others from being notified.
+func (n *Notifier) Poll(games []types.ScoreboardV3Game, favorites types.Favorites) error {
+	var teamIDs []int
+	for _, id := range favorites.Teams {
+		if teamID, err := strconv.Atoi(id); err == nil {
+			teamIDs = append(teamIDs, teamID)
+		}
+	}
+	n.watcher.SetFavorites(teamIDs)
+
+	var errs []error
+	for _, e := range n.watcher.Update(games) {
+		log.Printf("notifying: %s - %s", e.Title(), e.Message())
+		for _, s := range n.sinks {
+			if err := s.Notify(e); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", s.Name(), err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run polls the live scoreboard every interval until the context is done. The favorites are read on every
+// poll, so teams made favorites while the app runs are followed right away. Nothing is fetched without them.
+func (n *Notifier) Run(ctx context.Context, interval time.Duration,
+	fetch func() ([]types.ScoreboardV3Game, error), favorites func() (types.Favorites, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if fav, err := favorites(); err != nil {
+			log.Println("notifications: could not load favorites:", err)
+		} else if len(fav.Teams) > 0 {
+			games, err := fetch()
+			if err != nil {
+				log.Println("notifications: could not poll the live scoreboard:", err)
+			} else if err = n.Poll(games, fav); err != nil {
+				log.Println("notifications:", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package notifications
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+type testPaths struct {
+	dir string
+}
+
+func (p testPaths) GetFullPath(fileType string, id string) string {
+	return filepath.Join(p.dir, fileType+".json")
+}
+
+func (p testPaths) GetBasePaths() []string { return []string{p.dir} }
+
+type recordingSink struct {
+	events []Event
+	err    error
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Notify(e Event) error {
+	s.events = append(s.events, e)
+	return s.err
+}
+
+func TestLoadConfig(t *testing.T) {
+	paths := testPaths{dir: t.TempDir()}
+	fs := filesystemops.NewDefaultFsHandler()
+
+	cfg, err := LoadConfig(fs, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Enabled || len(cfg.Sinks) != 2 || cfg.IntervalSeconds != 30 {
+		t.Errorf("expected the default config without a file, got %+v", cfg)
+	}
+
+	data := `{"sinks": ["notify-send", "webhook"], "webhook_url": "http://localhost:9000/hook"}`
+	if err = os.WriteFile(paths.GetFullPath("notifications", ""), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadConfig(fs, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.WebhookURL != "http://localhost:9000/hook" || cfg.IntervalSeconds != 30 || cfg.Command != "notify-send" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	sinks, err := NewSinks(cfg, os.Stdout)
+	if err != nil || len(sinks) != 2 {
+		t.Errorf("expected the notify-send and webhook sinks, got %d sinks, %v", len(sinks), err)
+	}
+}
+
+func TestNewSinks_Invalid(t *testing.T) {
+	sinks, err := NewSinks(Config{Sinks: []string{"bell", "pager", "webhook"}}, os.Stdout)
+	if err == nil {
+		t.Error("expected errors for an unknown sink and a webhook without URL")
+	}
+	if len(sinks) != 1 || sinks[0].Name() != SinkBell {
+		t.Errorf("expected the valid sinks to be kept, got %v", sinks)
+	}
+}
+
+func TestNotifier_Poll(t *testing.T) {
+	failing := &recordingSink{err: errors.New("unreachable")}
+	working := &recordingSink{}
+	n := NewNotifier([]Sink{failing, working})
+	favorites := types.Favorites{Teams: []string{"1610612747"}}
+
+	if err := n.Poll([]types.ScoreboardV3Game{scoreboardGame(2, 3, "PT05M00.00S", 70, 72)}, favorites); err != nil {
+		t.Fatal(err)
+	}
+	err := n.Poll([]types.ScoreboardV3Game{scoreboardGame(3, 4, "PT00M00.00S", 101, 104)}, favorites)
+	if err == nil {
+		t.Error("expected the error of the failing sink")
+	}
+	if len(working.events) != 1 || working.events[0].Kind != GameFinal {
+		t.Errorf("expected the final to reach the working sink, got %v", working.events)
+	}
+}
@@ -0,0 +1,173 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// the names of the sinks in the notifications config
+const (
+	SinkBell       = "bell"
+	SinkOSC9       = "osc9"
+	SinkOSC777     = "osc777"
+	SinkNotifySend = "notify-send"
+	SinkWebhook    = "webhook"
+)
+
+// Sink delivers notifications somewhere
+type Sink interface {
+	Name() string
+	Notify(e Event) error
+}
+
+// BellSink rings the terminal bell
+type BellSink struct {
+	w io.Writer
+}
+
+// NewBellSink returns a sink writing the bell character to w, the terminal
+func NewBellSink(w io.Writer) *BellSink {
+	return &BellSink{w: w}
+}
+
+func (s *BellSink) Name() string { return SinkBell }
+
+func (s *BellSink) Notify(Event) error {
+	_, err := io.WriteString(s.w, "\a")
+	return err
+}
+
+// OSCSink asks the terminal for a desktop notification with an OSC escape sequence. OSC 9 is understood by
+// iTerm2, Windows Terminal, kitty and others, OSC 777 by urxvt, foot and the VTE based terminals.
+type OSCSink struct {
+	w    io.Writer
+	code int
+}
+
+// NewOSCSink returns a sink writing the OSC 9 or OSC 777 escape sequence to w, the terminal
+func NewOSCSink(w io.Writer, code int) *OSCSink {
+	return &OSCSink{w: w, code: code}
+}
+
+func (s *OSCSink) Name() string { return fmt.Sprintf("osc%d", s.code) }
+
+func (s *OSCSink) Notify(e Event) error {
+	var seq string
+	switch s.code {
+	case 9:
+		seq = "\x1b]9;" + oscText(e.Title()+": "+e.Message()) + "\a"
+	case 777:
+		seq = "\x1b]777;notify;" + oscText(e.Title()) + ";" + oscText(e.Message()) + "\a"
+	default:
+		return fmt.Errorf("unsupported OSC notification code %d", s.code)
+	}
+	_, err := io.WriteString(s.w, seq)
+	return err
+}
+
+// oscText drops the characters which would end an OSC sequence or separate its fields early
+func oscText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\a' || r == '\x1b' || r == ';' {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// CommandSink runs a notification command like notify-send with the title and message as its arguments
+type CommandSink struct {
+	command string
+	args    []string
+}
+
+// NewCommandSink returns a sink running command, followed by args, the title and the message
+func NewCommandSink(command string, args ...string) *CommandSink {
+	return &CommandSink{command: command, args: args}
+}
+
+func (s *CommandSink) Name() string { return s.command }
+
+func (s *CommandSink) Notify(e Event) error {
+	args := append(append([]string{}, s.args...), e.Title(), e.Message())
+	if out, err := exec.Command(s.command, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %w: %s", s.command, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// WebhookSink POSTs the notifications as JSON to a URL
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// webhookPayload is the body of a webhook notification. The text field makes it a valid Slack, Mattermost or
+// Discord-compatible incoming webhook message.
+type webhookPayload struct {
+	Event     string `json:"event"`
+	Title     string `json:"title"`
+	Message   string `json:"message"`
+	Text      string `json:"text"`
+	GameID    string `json:"gameId"`
+	Away      string `json:"awayTeam"`
+	Home      string `json:"homeTeam"`
+	AwayScore int    `json:"awayScore"`
+	HomeScore int    `json:"homeScore"`
+	Period    int    `json:"period"`
+}
+
+// NewWebhookSink returns a sink posting to url
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSink{url: url, client: client}
+}
+
+func (s *WebhookSink) Name() string { return SinkWebhook }
+
+func (s *WebhookSink) Notify(e Event) error {
+	body, err := json.Marshal(webhookPayload{
+		Event:     e.Kind.String(),
+		Title:     e.Title(),
+		Message:   e.Message(),
+		Text:      e.Title() + "\n" + e.Message(),
+		GameID:    e.GameID,
+		Away:      e.Away,
+		Home:      e.Home,
+		AwayScore: e.AwayScore,
+		HomeScore: e.HomeScore,
+		Period:    e.Period,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (k EventKind) String() string {
+	switch k {
+	case GameStarted:
+		return "game_started"
+	case GameFinal:
+		return "game_final"
+	case CloseLate:
+		return "close_late"
+	}
+	return "unknown"
+}
@@ -0,0 +1,107 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var testEvent = Event{Kind: GameStarted, GameID: "0022400001", Away: "BOS", Home: "LAL", AwayScore: 2}
+
+func TestBellSink(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewBellSink(&buf).Notify(testEvent); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "\a" {
+		t.Errorf("got %q, want the bell", buf.String())
+	}
+}
+
+func TestOSCSink(t *testing.T) {
+	tests := []struct {
+		code int
+		want string
+	}{
+		{9, "\x1b]9;BOS @ LAL tipped off: BOS 2 - 0 LAL\a"},
+		{777, "\x1b]777;notify;BOS @ LAL tipped off;BOS 2 - 0 LAL\a"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := NewOSCSink(&buf, tt.code).Notify(testEvent); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("OSC %d: got %q, want %q", tt.code, buf.String(), tt.want)
+		}
+	}
+	if err := NewOSCSink(&bytes.Buffer{}, 99).Notify(testEvent); err == nil {
+		t.Error("expected an error for an unsupported OSC code")
+	}
+}
+
+func TestCommandSink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the stand-in for notify-send is a shell script")
+	}
+	dir := t.TempDir()
+	out := filepath.Join(dir, "args")
+	script := filepath.Join(dir, "notify-send")
+	err := os.WriteFile(script, []byte("#!/bin/sh\nprintf '%s\\n' \"$@\" > "+out+"\n"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = NewCommandSink(script, "-a", "nba-now").Notify(testEvent); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "-a\nnba-now\nBOS @ LAL tipped off\nBOS 2 - 0 LAL\n"
+	if string(data) != want {
+		t.Errorf("got args %q, want %q", data, want)
+	}
+
+	if err = NewCommandSink(filepath.Join(dir, "missing")).Notify(testEvent); err == nil {
+		t.Error("expected an error for a missing command")
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var got webhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if err := NewWebhookSink(server.URL, server.Client()).Notify(testEvent); err != nil {
+		t.Fatal(err)
+	}
+	if got.Event != "game_started" || got.GameID != "0022400001" || got.Away != "BOS" || got.AwayScore != 2 {
+		t.Errorf("unexpected payload %+v", got)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := NewWebhookSink(failing.URL, failing.Client()).Notify(testEvent); err == nil {
+		t.Error("expected an error for a failing webhook")
+	}
+}
@@ -0,0 +1,218 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// allTimePerModes are the PerModes of the all-time leaders, toggled with Keymap.Mode
+var allTimePerModes = []struct {
+	mode  nbaAPI.PerMode
+	label string
+}{
+	{nbaAPI.PerModeTotals, "CAREER TOTALS"},
+	{nbaAPI.PerModePerGame, "PER GAME"},
+}
+
+// AllTimeLeaders shows the all-time regular season top 25 of one stat category at a time
+type AllTimeLeaders struct {
+	categories  map[nbaAPI.PerMode][]types.AllTimeLeaderCategory
+	headers     []string
+	catIdx      int
+	modeIdx     int
+	leaderboard table.Model
+	loading     bool
+	err         error
+	width       int
+	height      int
+	quitting    bool
+}
+
+type allTimeLeadersFetchedMsg struct {
+	err        error
+	perMode    nbaAPI.PerMode
+	categories []types.AllTimeLeaderCategory
+	headers    []string
+}
+
+// NewAllTimeLeaders instantiates the all-time leaders on the career totals of the points category
+func NewAllTimeLeaders(size tea.WindowSizeMsg) (*AllTimeLeaders, tea.Cmd, error) {
+	m := &AllTimeLeaders{
+		categories: make(map[nbaAPI.PerMode][]types.AllTimeLeaderCategory),
+		loading:    true,
+		width:      size.Width,
+		height:     size.Height,
+	}
+	return m, fetchAllTimeLeadersCmd(allTimePerModes[0].mode), nil
+}
+
+func fetchAllTimeLeadersCmd(perMode nbaAPI.PerMode) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		if err := cl.FetchAllTimeLeaders(perMode); err != nil {
+			return allTimeLeadersFetchedMsg{perMode: perMode, err: fmt.Errorf("could not fetch all-time leaders: %w", err)}
+		}
+		rs, err := cl.Loader.LoadAllTimeLeaders(string(perMode))
+		if err != nil {
+			return allTimeLeadersFetchedMsg{perMode: perMode, err: err}
+		}
+		categories, headers, err := converters.PopulateAllTimeLeaders(rs)
+		return allTimeLeadersFetchedMsg{perMode: perMode, categories: categories, headers: headers, err: err}
+	}
+}
+
+// current returns the categories of the selected PerMode
+func (m AllTimeLeaders) current() []types.AllTimeLeaderCategory {
+	return m.categories[allTimePerModes[m.modeIdx].mode]
+}
+
+func (m AllTimeLeaders) buildTable() table.Model {
+	categories := m.current()
+	if len(categories) == 0 {
+		return table.New(nil)
+	}
+	leaders := categories[m.catIdx%len(categories)].Leaders
+	return buildTables(m.headers, types.ConvertToStringMatrix(leaders), types.AllTimeLeader{}).
+		Focused(true).
+		WithPageSize(calculatePageSize(m.height-2, 1)).
+		WithFooterVisibility(false)
+}
+
+// selectCategory moves to another category, keeping the index within the categories of the current PerMode
+func (m *AllTimeLeaders) selectCategory(idx int) {
+	categories := m.current()
+	if len(categories) == 0 {
+		return
+	}
+	m.catIdx = (idx + len(categories)) % len(categories)
+	m.leaderboard = m.buildTable()
+}
+
+func (m AllTimeLeaders) Init() tea.Cmd { return nil }
+
+func (m AllTimeLeaders) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case allTimeLeadersFetchedMsg:
+		if msg.perMode != allTimePerModes[m.modeIdx].mode {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			log.Println("could not load all-time leaders:", msg.err)
+			return m, nil
+		}
+		m.categories[msg.perMode] = msg.categories
+		m.headers = msg.headers
+		m.selectCategory(m.catIdx)
+		return m, nil
+
+	case playerProfileDownloadedMsg:
+		if msg.err != nil {
+			log.Println("could not download player profile:", msg.err)
+			return m, nil
+		}
+		pp, cmd, err := NewPlayerProfile(msg.playerID, msg.backView, msg.sourceDate, WindowSize)
+		if err != nil {
+			log.Println("could not load player profile:", err)
+			return m, nil
+		}
+		return pp, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			ll, cmd, err := NewLeagueLeaders(WindowSize)
+			if err != nil {
+				log.Println(err)
+				return InitMenu()
+			}
+			return ll, cmd
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, Keymap.Mode):
+			m.modeIdx = (m.modeIdx + 1) % len(allTimePerModes)
+			if _, ok := m.categories[allTimePerModes[m.modeIdx].mode]; !ok {
+				m.loading = true
+				m.err = nil
+				return m, fetchAllTimeLeadersCmd(allTimePerModes[m.modeIdx].mode)
+			}
+			m.loading = false
+			m.err = nil
+			m.selectCategory(m.catIdx)
+			return m, nil
+		case m.loading || m.err != nil:
+			return m, nil
+		case key.Matches(msg, Keymap.Right):
+			m.selectCategory(m.catIdx + 1)
+			return m, nil
+		case key.Matches(msg, Keymap.Left):
+			m.selectCategory(m.catIdx - 1)
+			return m, nil
+		case key.Matches(msg, Keymap.Enter):
+			playerID, ok := m.leaderboard.HighlightedRow().Data["PLAYER_ID"].(string)
+			if !ok {
+				return m, nil
+			}
+			return m, downloadPlayerProfile(playerID, "allTimeLeaders", "")
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.leaderboard = m.buildTable()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.leaderboard, cmd = m.leaderboard.Update(msg)
+	return m, cmd
+}
+
+func (m AllTimeLeaders) helpView() string {
+	return HelpStyle(Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		"<- ->: category | " +
+		Keymap.Mode.Help().Key + ": totals/per game | " +
+		Keymap.Enter.Help().Key + ": player profile")
+}
+
+func (m AllTimeLeaders) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	mode := allTimePerModes[m.modeIdx].label
+	var title, body string
+	switch {
+	case m.loading:
+		title = "ALL-TIME LEADERS - " + mode
+		body = "Loading all-time leaders..."
+	case m.err != nil:
+		title = "ALL-TIME LEADERS - " + mode
+		body = "Could not load the all-time leaders: " + m.err.Error()
+	default:
+		categories := m.current()
+		category := categories[m.catIdx]
+		arrow := lipgloss.NewStyle().Foreground(lipgloss.Color("5")).Render
+		title = fmt.Sprintf("ALL-TIME LEADERS - %s   %s %s %s  (%d/%d)", mode,
+			arrow("◀"), lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("5")).Render(category.Label), arrow("▶"),
+			m.catIdx+1, len(categories))
+		body = m.leaderboard.View()
+	}
+
+	return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Bold(true).Render(title),
+		"",
+		body,
+		m.helpView()))
+}
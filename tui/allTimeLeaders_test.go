@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestAllTimeLeaders_Categories(t *testing.T) {
+	defer func(size tea.WindowSizeMsg) { WindowSize = size }(WindowSize)
+	WindowSize = tea.WindowSizeMsg{Width: 120, Height: 40}
+
+	m := AllTimeLeaders{categories: map[nbaAPI.PerMode][]types.AllTimeLeaderCategory{}, loading: true, height: 40}
+	model, _ := m.Update(allTimeLeadersFetchedMsg{
+		perMode: nbaAPI.PerModeTotals,
+		headers: []string{"PLAYER_ID", "RANK", "PLAYER_NAME", "VALUE", "IS_ACTIVE"},
+		categories: []types.AllTimeLeaderCategory{
+			{Stat: "PTS", Label: "Points", Leaders: []types.AllTimeLeader{{PlayerID: 2544, Rank: 1, PlayerName: "LeBron James", Value: "41000"}}},
+			{Stat: "AST", Label: "Assists", Leaders: []types.AllTimeLeader{{PlayerID: 1717, Rank: 1, PlayerName: "John Stockton", Value: "15806"}}},
+		},
+	})
+	m = model.(AllTimeLeaders)
+	if view := m.View(); !strings.Contains(view, "Points") || !strings.Contains(view, "LeBron James") {
+		t.Errorf("View() is missing the points leaders:\n%s", view)
+	}
+
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m = model.(AllTimeLeaders)
+	if m.catIdx != 1 || !strings.Contains(m.View(), "John Stockton") {
+		t.Errorf("left from the first category should wrap to the last, got %d", m.catIdx)
+	}
+
+	model, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	m = model.(AllTimeLeaders)
+	if !m.loading || cmd == nil {
+		t.Error("switching to an unloaded PerMode should fetch it")
+	}
+
+	// a late response of the previous PerMode is ignored
+	model, _ = m.Update(allTimeLeadersFetchedMsg{perMode: nbaAPI.PerModeTotals})
+	if m = model.(AllTimeLeaders); !m.loading {
+		t.Error("a response for another PerMode should be ignored")
+	}
+}
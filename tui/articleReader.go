@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"log"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+)
+
+// ArticleReader shows the text of a news article in the terminal, word wrapped and scrollable
+type ArticleReader struct {
+	headline string
+	url      string
+	article  nbaAPI.ArticleText
+	back     tea.Model
+	port     viewport.Model
+	loading  bool
+	err      error
+	width    int
+	height   int
+	quitting bool
+}
+
+type articleFetchedMsg struct {
+	err     error
+	article nbaAPI.ArticleText
+}
+
+// NewArticleReader instantiates the reader of an article, b goes back to the view it was opened from
+func NewArticleReader(back tea.Model, nc *nbaAPI.NewsClient, headline, url string, size tea.WindowSizeMsg) (*ArticleReader, tea.Cmd) {
+	m := &ArticleReader{
+		headline: headline,
+		url:      url,
+		back:     back,
+		port:     viewport.New(size.Width-4, size.Height-8),
+		loading:  true,
+		width:    size.Width,
+		height:   size.Height,
+	}
+	return m, fetchArticleCmd(nc, url)
+}
+
+func fetchArticleCmd(nc *nbaAPI.NewsClient, url string) tea.Cmd {
+	return func() tea.Msg {
+		article, err := nc.FetchArticle(url)
+		return articleFetchedMsg{article: article, err: err}
+	}
+}
+
+// articleContent renders the title, byline and paragraphs of the article wrapped to the given width
+func articleContent(article nbaAPI.ArticleText, width int) string {
+	if width < 20 {
+		width = 20
+	}
+	text := lipgloss.NewStyle().Width(width)
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Width(width)
+
+	sections := []string{text.Bold(true).Render(article.Title)}
+	var byline []string
+	for _, s := range []string{article.Byline, article.Published} {
+		if s != "" {
+			byline = append(byline, s)
+		}
+	}
+	if len(byline) > 0 {
+		sections = append(sections, dim.Render(strings.Join(byline, " | ")))
+	}
+	for _, p := range article.Paragraphs {
+		sections = append(sections, "", text.Render(p))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *ArticleReader) Init() tea.Cmd { return nil }
+
+func (m *ArticleReader) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case articleFetchedMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			log.Printf("could not read article %s: %v", m.url, msg.err)
+			return m, nil
+		}
+		m.article = msg.article
+		m.port.SetContent(articleContent(m.article, m.port.Width-2))
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			return m.back, nil
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, Keymap.Browser):
+			go openURL(m.url)
+			return m, nil
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.port.Width = msg.Width - 4
+		m.port.Height = msg.Height - 8
+		if !m.loading && m.err == nil {
+			m.port.SetContent(articleContent(m.article, m.port.Width-2))
+		}
+		// the view the reader was opened from is resized too, for when the reader goes back to it
+		m.back, _ = m.back.Update(msg)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.port, cmd = m.port.Update(msg)
+	return m, cmd
+}
+
+func (m *ArticleReader) helpView() string {
+	return HelpStyle("\n" + Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		"↑/↓ pgup/pgdown: scroll | " +
+		Keymap.Browser.Help().Key + ": " + Keymap.Browser.Help().Desc + "\n")
+}
+
+func (m *ArticleReader) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var body string
+	switch {
+	case m.loading:
+		body = "Loading " + m.headline + "..."
+	case m.err != nil:
+		body = "Could not read the article: " + m.err.Error() + "\n\n" +
+			"Press " + Keymap.Browser.Help().Key + " to open it in the browser instead"
+	default:
+		body = m.port.View()
+	}
+	return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left, body, m.helpView()))
+}
@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+)
+
+func TestArticleContent_WrapsParagraphs(t *testing.T) {
+	article := nbaAPI.ArticleText{
+		Title:      "Lakers trade for Doncic",
+		Byline:     "Shams Charania",
+		Published:  "2025-02-02 07:00",
+		Paragraphs: []string{strings.Repeat("word ", 30), "Second paragraph."},
+	}
+	content := articleContent(article, 40)
+	if lipgloss.Width(content) > 40 {
+		t.Errorf("expected the content to be wrapped to 40 columns, got %d", lipgloss.Width(content))
+	}
+	for _, want := range []string{"Lakers trade for Doncic", "Shams Charania | 2025-02-02 07:00", "Second paragraph."} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in the article", want)
+		}
+	}
+}
+
+func TestArticleReader_Update(t *testing.T) {
+	news := &NewsModel{list: list.New([]list.Item{}, list.NewDefaultDelegate(), 80, 24), width: 80, height: 30}
+	m, _ := NewArticleReader(news, news.newsClient, "Headline", "https://example.com/a", tea.WindowSizeMsg{Width: 80, Height: 30})
+	if !strings.Contains(m.View(), "Loading Headline") {
+		t.Error("expected the reader to show it is loading")
+	}
+
+	m.Update(articleFetchedMsg{err: errors.New("timeout")})
+	if !strings.Contains(m.View(), "open it in the browser") {
+		t.Error("expected a failed article to point to the browser")
+	}
+
+	m.Update(articleFetchedMsg{article: nbaAPI.ArticleText{Title: "Headline", Paragraphs: []string{"Body text."}}})
+	if !strings.Contains(m.View(), "Body text.") {
+		t.Error("expected the text of the article")
+	}
+
+	back, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if back != news {
+		t.Error("expected b to go back to the news list")
+	}
+}
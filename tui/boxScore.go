@@ -131,6 +131,8 @@ func fetchBoxSoresCmd(gameID string) tea.Cmd {
 			column = table.NewColumn(col, col, width)
 			columns[i] = column
 		}
+		// the comment explains why a player did not play, e.g. "DNP - Injury/Illness - Left Ankle; Sprain"
+		columns = append(columns, table.NewColumn(boxScoreStatusColumn, boxScoreStatusColumn, 40))
 
 		for _, player := range homeDataSet {
 			rowData := make(table.RowData)
@@ -139,6 +141,7 @@ func fetchBoxSoresCmd(gameID string) tea.Cmd {
 				columnTitle := columns[i].Title()
 				rowData[columnTitle] = value
 			}
+			rowData[boxScoreStatusColumn] = boxScoreStatusCell(player.Comment)
 			homeRow = table.NewRow(rowData)
 			homeRows = append(homeRows, homeRow)
 		}
@@ -150,6 +153,7 @@ func fetchBoxSoresCmd(gameID string) tea.Cmd {
 				columnTitle := columns[i].Title()
 				rowData[columnTitle] = value
 			}
+			rowData[boxScoreStatusColumn] = boxScoreStatusCell(player.Comment)
 			awayRow = table.NewRow(rowData)
 			awayRows = append(awayRows, awayRow)
 		}
@@ -164,6 +168,18 @@ func fetchBoxSoresCmd(gameID string) tea.Cmd {
 	}
 }
 
+// boxScoreStatusColumn is the last column of the box score, holding the comments of the players who did not play
+const boxScoreStatusColumn = "Status"
+
+// boxScoreStatusCell renders a DNP comment in the color of a player ruled out on the injury report
+func boxScoreStatusCell(comment string) interface{} {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return ""
+	}
+	return table.NewStyledCell(comment, injuryStatusStyle(types.InjuryStatusOut).Bold(false))
+}
+
 // getColsAndValues is a function to extract and filter fields and values from complex structs.
 // Used currently on the boxScore rendering logic, to filter out unnecessary fields, YET
 // leave the original data structures intact to preserve integrity and have the ability to extend and alter
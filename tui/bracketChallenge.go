@@ -0,0 +1,369 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+type challengeState int
+
+const (
+	challengeLeaderboard challengeState = iota
+	challengeNaming
+	challengePicking
+)
+
+// BracketChallenge lets several people fill in their playoff predictions and compares them on a leaderboard.
+// Every pick is saved right away to the person's prediction file.
+type BracketChallenge struct {
+	season      string
+	state       challengeState
+	bracket     types.PlayoffBracket
+	predictions []types.BracketPrediction
+	leaderboard table.Model
+	prediction  types.BracketPrediction
+	nameInput   textinput.Model
+	store       *filesystemops.PredictionStore
+	cursorCol   int
+	cursorRow   int
+	status      string
+	loading     bool
+	width       int
+	height      int
+	quitting    bool
+}
+
+type challengeFetchedMsg struct {
+	err         error
+	bracket     types.PlayoffBracket
+	predictions []types.BracketPrediction
+}
+
+// NewBracketChallenge instantiates the bracket challenge of a season, starting at the leaderboard
+func NewBracketChallenge(season string, size tea.WindowSizeMsg) (*BracketChallenge, tea.Cmd, error) {
+	cl := nbaAPI.NewClient()
+
+	ti := textinput.New()
+	ti.Placeholder = "your name"
+	ti.CharLimit = 20
+	ti.Width = 22
+
+	m := &BracketChallenge{
+		season:    season,
+		state:     challengeLeaderboard,
+		nameInput: ti,
+		store:     filesystemops.NewPredictionStore(cl.FileSystem, cl.Paths),
+		loading:   true,
+		width:     size.Width,
+		height:    size.Height,
+	}
+	m.cursorCol, m.cursorRow = colRowForIndex(0)
+
+	return m, fetchChallengeCmd(season, m.store), nil
+}
+
+// fetchChallengeCmd loads the current bracket (which holds the actual results) and all saved predictions
+func fetchChallengeCmd(season string, store *filesystemops.PredictionStore) tea.Cmd {
+	return func() tea.Msg {
+		bracket, err := loadPlayoffBracket(season)
+		if err != nil {
+			return challengeFetchedMsg{err: err}
+		}
+		predictions, err := store.List(season)
+		if err != nil {
+			return challengeFetchedMsg{err: err}
+		}
+		return challengeFetchedMsg{bracket: bracket, predictions: predictions}
+	}
+}
+
+func (m *BracketChallenge) buildLeaderboard() {
+	scores := converters.BuildLeaderboard(m.predictions, m.bracket)
+	m.leaderboard = buildTables(converters.PredictionScoreHeaders(), types.ConvertToStringMatrix(scores), types.PredictionScore{}).
+		Focused(true).
+		WithPageSize(calculatePageSize(m.height, 1)).
+		WithFooterVisibility(false)
+}
+
+// savePrediction drops picks made obsolete by the last change and writes the prediction to disk
+func (m *BracketChallenge) savePrediction() {
+	m.prediction = converters.PrunePicks(m.bracket, m.prediction)
+	m.prediction.UpdatedAt = time.Now().Format("2006-01-02 15:04")
+	if err := m.store.Save(m.prediction); err != nil {
+		log.Println("could not save prediction:", err)
+		m.status = "Could not save prediction: " + err.Error()
+		return
+	}
+	m.status = ""
+
+	for i := range m.predictions {
+		if m.predictions[i].Name == m.prediction.Name {
+			m.predictions[i] = m.prediction
+			return
+		}
+	}
+	m.predictions = append(m.predictions, m.prediction)
+}
+
+// selectedSeries returns the cursor index and the predicted state of the series under the cursor
+func (m *BracketChallenge) selectedSeries() (int, types.PlayoffSeries) {
+	idx := cursorIndexForColRow(m.cursorCol, m.cursorRow)
+	predicted := converters.PredictedBracket(m.bracket, m.prediction)
+	if idx >= len(predicted.Series) {
+		return idx, types.PlayoffSeries{}
+	}
+	return idx, predicted.Series[idx]
+}
+
+// cycleWinner switches the pick of the selected series between the top team, the bottom team and no pick
+func (m *BracketChallenge) cycleWinner() {
+	idx, series := m.selectedSeries()
+	if series.Status != "pre" || converters.IsTBD(series.TopTeam) || converters.IsTBD(series.BottomTeam) {
+		m.status = "Picks can only be made for series which have both teams and have not started yet"
+		return
+	}
+
+	pick, ok := m.prediction.Pick(idx)
+	switch {
+	case !ok:
+		pick = types.SeriesPick{Series: idx, Winner: series.TopTeam.Tricode, Games: 6}
+	case pick.Winner == series.TopTeam.Tricode:
+		pick.Winner = series.BottomTeam.Tricode
+	default:
+		pick.Winner = ""
+	}
+	m.prediction.SetPick(pick)
+	m.savePrediction()
+}
+
+func (m *BracketChallenge) setGames(games int) {
+	idx, series := m.selectedSeries()
+	pick, ok := m.prediction.Pick(idx)
+	if !ok || series.Status != "pre" {
+		m.status = "Pick a winner first"
+		return
+	}
+	pick.Games = games
+	m.prediction.SetPick(pick)
+	m.savePrediction()
+}
+
+func (m *BracketChallenge) startPrediction(name string) {
+	name = strings.TrimSpace(name)
+	if filesystemops.PredictionFileName(name) == ".json" {
+		m.status = "Please enter a name"
+		return
+	}
+	for _, prediction := range m.predictions {
+		if filesystemops.PredictionFileName(prediction.Name) == filesystemops.PredictionFileName(name) {
+			m.prediction = prediction
+			m.state = challengePicking
+			m.status = ""
+			return
+		}
+	}
+	m.prediction = types.BracketPrediction{Name: name, Season: m.season}
+	m.state = challengePicking
+	m.savePrediction()
+}
+
+func (m *BracketChallenge) Init() tea.Cmd { return nil }
+
+func (m *BracketChallenge) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case challengeFetchedMsg:
+		m.loading = false
+		if msg.err != nil {
+			log.Println("could not load bracket challenge:", msg.err)
+			m.status = "Could not load the bracket: " + msg.err.Error()
+			return m, nil
+		}
+		m.bracket = msg.bracket
+		m.predictions = msg.predictions
+		m.buildLeaderboard()
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.leaderboard = m.leaderboard.WithPageSize(calculatePageSize(msg.Height, 1))
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.state == challengeNaming {
+			return m.updateNaming(msg)
+		}
+		if key.Matches(msg, Keymap.Quit) {
+			m.quitting = true
+			return m, tea.Quit
+		}
+		if m.loading {
+			return m, nil
+		}
+		if m.state == challengePicking {
+			return m.updatePicking(msg)
+		}
+		return m.updateLeaderboard(msg)
+	}
+	return m, nil
+}
+
+func (m *BracketChallenge) updateLeaderboard(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, Keymap.Back):
+		pb, cmd, _ := NewPlayoffBracket(m.season, 0, WindowSize)
+		return pb, cmd
+	case key.Matches(msg, Keymap.New):
+		m.state = challengeNaming
+		m.status = ""
+		m.nameInput.SetValue("")
+		return m, m.nameInput.Focus()
+	case key.Matches(msg, Keymap.Enter):
+		if len(m.predictions) == 0 {
+			return m, nil
+		}
+		if name, ok := m.leaderboard.HighlightedRow().Data["NAME"].(string); ok {
+			m.startPrediction(name)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.leaderboard, cmd = m.leaderboard.Update(msg)
+	return m, cmd
+}
+
+func (m *BracketChallenge) updateNaming(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, Keymap.Cancel):
+		m.state = challengeLeaderboard
+		m.nameInput.Blur()
+		return m, nil
+	case key.Matches(msg, Keymap.Enter):
+		m.nameInput.Blur()
+		m.startPrediction(m.nameInput.Value())
+		if m.state != challengePicking {
+			return m, m.nameInput.Focus()
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return m, cmd
+}
+
+func (m *BracketChallenge) updatePicking(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, Keymap.Back):
+		m.state = challengeLeaderboard
+		m.status = ""
+		m.buildLeaderboard()
+	case key.Matches(msg, Keymap.Left):
+		if m.cursorCol > 1 {
+			m.cursorCol--
+			m.cursorRow = min(m.cursorRow, colSeriesCount[m.cursorCol]-1)
+		}
+	case key.Matches(msg, Keymap.Right):
+		if m.cursorCol < 7 {
+			m.cursorCol++
+			m.cursorRow = min(m.cursorRow, colSeriesCount[m.cursorCol]-1)
+		}
+	case key.Matches(msg, Keymap.Up):
+		if m.cursorRow > 0 {
+			m.cursorRow--
+		}
+	case key.Matches(msg, Keymap.Down):
+		if m.cursorRow < colSeriesCount[m.cursorCol]-1 {
+			m.cursorRow++
+		}
+	case key.Matches(msg, Keymap.Space):
+		m.cycleWinner()
+	case key.Matches(msg, Keymap.Games):
+		m.setGames(int(msg.String()[0] - '0'))
+	}
+	return m, nil
+}
+
+func (m *BracketChallenge) pickingView() string {
+	idx, series := m.selectedSeries()
+	predicted := converters.PredictedBracket(m.bracket, m.prediction)
+	bracket := newBracketTableRenderer(predicted.Series, idx).Render()
+
+	score := converters.ScorePrediction(m.prediction, m.bracket)
+	title := lipgloss.NewStyle().Bold(true).
+		Render(fmt.Sprintf("%s's bracket | %d points (max %d)", m.prediction.Name, score.Points, score.MaxPoints))
+
+	var detail string
+	pick, picked := m.prediction.Pick(idx)
+	switch {
+	case series.Status != "pre":
+		detail = "Series already started"
+		if picked {
+			detail += fmt.Sprintf(" | your pick: %s in %d", pick.Winner, pick.Games)
+		}
+	case picked:
+		detail = fmt.Sprintf("Your pick: %s in %d", pick.Winner, pick.Games)
+	default:
+		detail = "No pick yet"
+	}
+	if m.status != "" {
+		detail += "\n" + m.status
+	}
+
+	help := HelpStyle(Keymap.Back.Help().Key + ": leaderboard | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		Keymap.Space.Help().Key + ": pick winner | " +
+		Keymap.Games.Help().Key + ": " + Keymap.Games.Help().Desc)
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", bracket, detail, "", help)
+}
+
+func (m *BracketChallenge) leaderboardView() string {
+	title := lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("Bracket challenge %s", m.season))
+
+	var content string
+	if len(m.predictions) == 0 {
+		content = "No predictions yet. Press n to fill in your bracket."
+	} else {
+		content = m.leaderboard.View()
+	}
+	if m.state == challengeNaming {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", "Name: "+m.nameInput.View())
+	}
+	if m.status != "" {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, m.status)
+	}
+
+	help := HelpStyle(HelpFooter() + " | " + Keymap.New.Help().Key + ": new prediction")
+	if m.state == challengeNaming {
+		help = HelpStyle(Keymap.Enter.Help().Key + ": start picking | " + Keymap.Cancel.Help().Key + ": " + Keymap.Cancel.Help().Desc)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", content, "", help)
+}
+
+func (m *BracketChallenge) View() string {
+	if m.quitting {
+		return ""
+	}
+	if m.loading {
+		return lipgloss.NewStyle().Width(m.width).Height(m.height).
+			Align(lipgloss.Center, lipgloss.Center).Render("Loading bracket challenge...")
+	}
+	if m.state == challengePicking {
+		return DocStyle.Render(m.pickingView())
+	}
+	return DocStyle.Render(m.leaderboardView())
+}
@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func newTestChallenge(t *testing.T) (*BracketChallenge, *filesystemops.PredictionStore) {
+	paths := &pathManager.PathComps{Home: t.TempDir(), Path: "/", PredictionsPath: "predictions/"}
+	store := filesystemops.NewPredictionStore(filesystemops.NewDefaultFsHandler(), paths)
+	m, _, _ := NewBracketChallenge("2024-25", tea.WindowSizeMsg{Width: 140, Height: 40})
+	m.store = store
+
+	bracket := types.PlayoffBracket{Season: "2024-25", Series: makeFullBracket()}
+	for i := range bracket.Series {
+		bracket.Series[i].Status = "pre"
+		bracket.Series[i].TopTeam.Wins, bracket.Series[i].BottomTeam.Wins = 0, 0
+	}
+	m.Update(challengeFetchedMsg{bracket: bracket})
+	return m, store
+}
+
+func TestBracketChallenge_PickIsSaved(t *testing.T) {
+	m, store := newTestChallenge(t)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if m.state != challengeNaming {
+		t.Fatalf("state after n = %d, want naming", m.state)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ann")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.state != challengePicking {
+		t.Fatalf("state after entering a name = %d, want picking (status %q)", m.state, m.status)
+	}
+
+	// the cursor starts at East R1 1v8; space picks the top team, 5 sets the series length
+	m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+
+	saved, err := store.Load("2024-25", "ann")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if pick, ok := saved.Pick(0); !ok || pick.Winner != "E1T" || pick.Games != 5 {
+		t.Errorf("saved pick = %+v, want E1T in 5", pick)
+	}
+
+	// back to the leaderboard, which now lists the prediction
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if m.state != challengeLeaderboard || len(m.predictions) != 1 {
+		t.Errorf("state = %d with %d predictions, want leaderboard with 1", m.state, len(m.predictions))
+	}
+}
+
+func TestBracketChallenge_StartedSeriesCannotBePicked(t *testing.T) {
+	m, _ := newTestChallenge(t)
+	m.bracket.Series[0].Status = "active"
+	m.startPrediction("bob")
+
+	m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+
+	if _, ok := m.prediction.Pick(0); ok {
+		t.Error("expected no pick for a series which already started")
+	}
+	if m.status == "" {
+		t.Error("expected a status message explaining why the pick was rejected")
+	}
+}
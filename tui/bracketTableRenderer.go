@@ -17,8 +17,10 @@ var (
 )
 
 type bracketTableRenderer struct {
-	series [15]types.PlayoffSeries
-	cursor int
+	series    [15]types.PlayoffSeries
+	playIn    [6]types.PlayoffSeries
+	hasPlayIn bool
+	cursor    int
 }
 
 func newBracketTableRenderer(series []types.PlayoffSeries, cursor int) bracketTableRenderer {
@@ -29,6 +31,19 @@ func newBracketTableRenderer(series []types.PlayoffSeries, cursor int) bracketTa
 	return br
 }
 
+// withPlayIn adds the play-in columns (outermost on both sides) to the rendered bracket.
+// Cursor indexes 15-17 select the East play-in games, 18-20 the West ones.
+func (br bracketTableRenderer) withPlayIn(playIn []types.PlayoffSeries) bracketTableRenderer {
+	if len(playIn) == 0 {
+		return br
+	}
+	br.hasPlayIn = true
+	for i := 0; i < 6 && i < len(playIn); i++ {
+		br.playIn[i] = playIn[i]
+	}
+	return br
+}
+
 // renderTeamCell formats a single team slot, applying highlighting or dim style.
 func renderTeamCell(t types.PlayoffTeam, highlighted bool) string {
 	if t.Tricode == "" || t.Tricode == "TBD" {
@@ -50,6 +65,8 @@ func renderTeamCell(t types.PlayoffTeam, highlighted bool) string {
 // Render produces the columnar bracket table as a multi-line string.
 //
 // Column order (left to right): W R1 | W Semis | W CF | Finals | E CF | E Semis | E R1
+// With play-in data, a play-in column is added on the outside of each conference: W Play-In ... E Play-In.
+// The play-in games (7v8, 9v10, final) take lines 0-1, 2-3 and 4-5 of their column.
 //
 // Row placement (8 data lines, 2 per R1 slot):
 //
@@ -125,26 +142,51 @@ func (br bracketTableRenderer) Render() string {
 		return
 	}
 
+	// playInAt returns the West and East play-in cells for a given line.
+	playInAt := func(line int) (wPIc, ePIc string) {
+		game := line / 2
+		if game >= types.PlayInGamesPerConference {
+			return blank, blank
+		}
+		wGame := br.playIn[types.PlayInGamesPerConference+game]
+		eGame := br.playIn[game]
+		wSel := c == 15+types.PlayInGamesPerConference+game
+		eSel := c == 15+game
+		if line%2 == 0 {
+			return renderTeamCell(wGame.TopTeam, wSel), renderTeamCell(eGame.TopTeam, eSel)
+		}
+		return renderTeamCell(wGame.BottomTeam, wSel), renderTeamCell(eGame.BottomTeam, eSel)
+	}
+
 	var sb strings.Builder
-	sb.WriteString(buildTableHeader())
+	sb.WriteString(buildTableHeader(br.hasPlayIn))
 	sb.WriteByte('\n')
 	for line := 0; line < 8; line++ {
 		wR1c, wSc, wCFc, fc, eCFc, eSc, eR1c := cellAt(line)
-		sb.WriteString(wR1c + " " + wSc + " " + wCFc + sep + fc + sep + eCFc + " " + eSc + " " + eR1c)
+		row := wR1c + " " + wSc + " " + wCFc + sep + fc + sep + eCFc + " " + eSc + " " + eR1c
+		if br.hasPlayIn {
+			wPIc, ePIc := playInAt(line)
+			row = wPIc + " " + row + " " + ePIc
+		}
+		sb.WriteString(row)
 		sb.WriteByte('\n')
 	}
 	return sb.String()
 }
 
-func buildTableHeader() string {
+func buildTableHeader(withPlayIn bool) string {
 	n := tableNodeWidth
 	blank := strings.Repeat(" ", n)
 	sep   := " │ "
 
 	// Label widths must match column widths used in data rows.
 	// Each column is tableNodeWidth chars. Columns: W_R1, W_Semis, W_CF | Finals | E_CF, E_Semis, E_R1
-	westLabel := fmt.Sprintf("%-*s", n*3+2, "WEST")
-	eastLabel := fmt.Sprintf("%-*s", n*3+2, "EAST")
+	confWidth := n*3 + 2
+	if withPlayIn {
+		confWidth += n + 1
+	}
+	westLabel := fmt.Sprintf("%-*s", confWidth, "WEST")
+	eastLabel := fmt.Sprintf("%-*s", confWidth, "EAST")
 	finLabel  := fmt.Sprintf("%-*s", n, "FINALS")
 
 	header1 := westLabel + sep + finLabel + sep + eastLabel
@@ -155,6 +197,9 @@ func buildTableHeader() string {
 		fmt.Sprintf("%-*s", n, "CF") + " " +
 		fmt.Sprintf("%-*s", n, "Semis") + " " +
 		fmt.Sprintf("%-*s", n, "R1")
+	if withPlayIn {
+		header2 = fmt.Sprintf("%-*s", n, "Play-In") + " " + header2 + " " + fmt.Sprintf("%-*s", n, "Play-In")
+	}
 	separator := strings.Repeat("─", len([]rune(header2)))
 
 	return header1 + "\n" + header2 + "\n" + separator
@@ -134,6 +134,34 @@ func TestBracketTableRenderer_TBDCellsDimmed(t *testing.T) {
     }
 }
 
+func TestBracketTableRenderer_PlayInColumns(t *testing.T) {
+    playIn := make([]types.PlayoffSeries, 6)
+    playIn[0] = makeTableSeries("E7", 7, 1)  // East 7v8
+    playIn[4] = makeTableSeries("W9", 9, 0)  // West 9v10
+    br := newBracketTableRenderer(makeFullBracket(), 15).withPlayIn(playIn)
+    output := br.Render()
+    if !strings.Contains(output, "Play-In") {
+        t.Error("Render() with play-in missing Play-In header")
+    }
+    dataLines := extractDataLines(output)
+    if !strings.Contains(dataLines[0], "E7T") || !strings.Contains(dataLines[1], "E7B") {
+        t.Errorf("East 7v8 play-in missing from data lines 0-1: %q / %q", dataLines[0], dataLines[1])
+    }
+    if !strings.Contains(dataLines[2], "W9T") {
+        t.Errorf("West 9v10 play-in missing from data line 2: %q", dataLines[2])
+    }
+    if !strings.HasPrefix(strings.TrimSpace(dataLines[2]), "(9)W9T") {
+        t.Errorf("West play-in should be the leftmost column: %q", dataLines[2])
+    }
+}
+
+func TestBracketTableRenderer_NoPlayInColumnsByDefault(t *testing.T) {
+    br := newBracketTableRenderer(makeFullBracket(), 0).withPlayIn(nil)
+    if strings.Contains(br.Render(), "Play-In") {
+        t.Error("Render() without play-in data should not show play-in columns")
+    }
+}
+
 // extractDataLines skips header and separator lines, returns the 8 data lines.
 func extractDataLines(output string) []string {
     lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
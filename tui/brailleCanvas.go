@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// brailleDotBits maps the dot position within a cell (x 0-1, y 0-3) to its bit in the braille code point
+var brailleDotBits = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// brailleCanvas is a dot matrix drawn with braille characters, every terminal cell holds 2x4 dots.
+// Cells can be colored and can be replaced by a regular character, which is used for markers on top of the dots.
+type brailleCanvas struct {
+	cols, rows  int
+	dots        [][]rune
+	colors      [][]lipgloss.Color
+	backgrounds [][]lipgloss.Color
+	markers     [][]rune
+}
+
+func newBrailleCanvas(cols, rows int) *brailleCanvas {
+	c := &brailleCanvas{cols: cols, rows: rows}
+	c.dots = make([][]rune, rows)
+	c.colors = make([][]lipgloss.Color, rows)
+	c.backgrounds = make([][]lipgloss.Color, rows)
+	c.markers = make([][]rune, rows)
+	for r := 0; r < rows; r++ {
+		c.dots[r] = make([]rune, cols)
+		c.colors[r] = make([]lipgloss.Color, cols)
+		c.backgrounds[r] = make([]lipgloss.Color, cols)
+		c.markers[r] = make([]rune, cols)
+	}
+	return c
+}
+
+// dotSize returns the width and height of the canvas in dots
+func (c *brailleCanvas) dotSize() (int, int) {
+	return c.cols * 2, c.rows * 4
+}
+
+// set turns on the dot at x, y. Dots outside the canvas are ignored.
+func (c *brailleCanvas) set(x, y int) {
+	if x < 0 || y < 0 || x >= c.cols*2 || y >= c.rows*4 {
+		return
+	}
+	c.dots[y/4][x/2] |= brailleDotBits[x%2][y%4]
+}
+
+// line draws a straight line between two dots
+func (c *brailleCanvas) line(x0, y0, x1, y1 int) {
+	walkLine(x0, y0, x1, y1, c.set)
+}
+
+// colorLine draws a straight line between two dots and colors the cells it passes through
+func (c *brailleCanvas) colorLine(x0, y0, x1, y1 int, clr lipgloss.Color) {
+	walkLine(x0, y0, x1, y1, func(x, y int) {
+		c.set(x, y)
+		c.cellColor(x/2, y/4, clr)
+	})
+}
+
+// walkLine calls plot for every dot on the line between two dots (Bresenham)
+func walkLine(x0, y0, x1, y1 int, plot func(x, y int)) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		plot(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// cellColor sets the foreground color of a cell
+func (c *brailleCanvas) cellColor(col, row int, clr lipgloss.Color) {
+	if c.inside(col, row) {
+		c.colors[row][col] = clr
+	}
+}
+
+// cellBackground sets the background color of a cell
+func (c *brailleCanvas) cellBackground(col, row int, clr lipgloss.Color) {
+	if c.inside(col, row) {
+		c.backgrounds[row][col] = clr
+	}
+}
+
+// marker replaces the dots of a cell with a character in the given color
+func (c *brailleCanvas) marker(col, row int, r rune, clr lipgloss.Color) {
+	if c.inside(col, row) {
+		c.markers[row][col] = r
+		c.colors[row][col] = clr
+	}
+}
+
+func (c *brailleCanvas) inside(col, row int) bool {
+	return col >= 0 && row >= 0 && col < c.cols && row < c.rows
+}
+
+// String renders the canvas, cells sharing the same colors are styled together
+func (c *brailleCanvas) String() string {
+	var sb strings.Builder
+	for r := 0; r < c.rows; r++ {
+		var run strings.Builder
+		var runFg, runBg lipgloss.Color
+		flush := func() {
+			if run.Len() == 0 {
+				return
+			}
+			style := lipgloss.NewStyle()
+			if runFg != "" {
+				style = style.Foreground(runFg)
+			}
+			if runBg != "" {
+				style = style.Background(runBg)
+			}
+			sb.WriteString(style.Render(run.String()))
+			run.Reset()
+		}
+
+		for col := 0; col < c.cols; col++ {
+			fg, bg := c.colors[r][col], c.backgrounds[r][col]
+			if fg != runFg || bg != runBg {
+				flush()
+				runFg, runBg = fg, bg
+			}
+			switch {
+			case c.markers[r][col] != 0:
+				run.WriteRune(c.markers[r][col])
+			case c.dots[r][col] != 0:
+				run.WriteRune(0x2800 + c.dots[r][col])
+			default:
+				run.WriteRune(' ')
+			}
+		}
+		flush()
+		if r < c.rows-1 {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -185,8 +185,8 @@ func (m DailyView) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			gameStatus, _ := strconv.Atoi(score[9])
 			if gameStatus > 0 {
 				rows := []table.Row{
-					table.NewRow(table.RowData{"teams": score[4], "scores": score[3], "gameID": score[0], "gameStatus": gameStatus}),
-					table.NewRow(table.RowData{"teams": score[8], "scores": score[7], "gameID": score[0], "gameStatus": gameStatus}),
+					table.NewRow(table.RowData{"teams": score[4], "scores": score[3], "gameID": score[0], "gameStatus": gameStatus, "teamName": score[2]}),
+					table.NewRow(table.RowData{"teams": score[8], "scores": score[7], "gameID": score[0], "gameStatus": gameStatus, "teamName": score[6]}),
 				}
 				gameCard, err := newGameCard(rows)
 				if err != nil {
@@ -210,8 +210,8 @@ func (m DailyView) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			gameStatus, _ := strconv.Atoi(score[9])
 			if gameStatus > 0 {
 				rows := []table.Row{
-					table.NewRow(table.RowData{"teams": score[4], "scores": score[3], "gameID": score[0], "gameStatus": gameStatus}),
-					table.NewRow(table.RowData{"teams": score[8], "scores": score[7], "gameID": score[0], "gameStatus": gameStatus}),
+					table.NewRow(table.RowData{"teams": score[4], "scores": score[3], "gameID": score[0], "gameStatus": gameStatus, "teamName": score[2]}),
+					table.NewRow(table.RowData{"teams": score[8], "scores": score[7], "gameID": score[0], "gameStatus": gameStatus, "teamName": score[6]}),
 				}
 				gameCard, err := newGameCard(rows)
 				if err != nil {
@@ -291,6 +291,10 @@ func (m DailyView) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 					}
 					return bx, cmd
 				}
+				if len(rows) == 2 {
+					gp, cmd := NewGamePreview(gameID, m.dateSelector.date, rows[1].Data, rows[0].Data, WindowSize)
+					return gp, cmd
+				}
 			}
 		case key.Matches(msg, Keymap.Up):
 			if m.focusIndex < m.numCols {
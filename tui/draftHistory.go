@@ -0,0 +1,300 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// firstDraftYear is the year of the first draft, held by the BAA in 1947
+const firstDraftYear = 1947
+
+// DraftHistory lists every pick of a draft, colored by the team that made it. The picks can be filtered by team
+// and by college, and each drafted player links to their profile.
+type DraftHistory struct {
+	year         int
+	latestYear   int
+	picks        []types.DraftPick
+	headers      []string
+	teams        []string
+	teamIdx      int
+	college      string
+	collegeInput textinput.Model
+	editing      bool
+	picksTable   table.Model
+	loading      bool
+	err          error
+	width        int
+	height       int
+	quitting     bool
+}
+
+type draftHistoryFetchedMsg struct {
+	err     error
+	year    int
+	picks   []types.DraftPick
+	headers []string
+}
+
+// NewDraftHistory instantiates the draft view on the given year, the latest draft when year is 0
+func NewDraftHistory(year int, size tea.WindowSizeMsg) (*DraftHistory, tea.Cmd, error) {
+	latest := parseSeasonYear(nbaAPI.NewClient().Dates.GetCurrentSeason())
+	if latest < firstDraftYear {
+		return nil, nil, fmt.Errorf("could not determine the latest draft year")
+	}
+	if year < firstDraftYear || year > latest {
+		year = latest
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = "college or club"
+	ti.CharLimit = 40
+	ti.Width = 24
+
+	m := &DraftHistory{
+		year:         year,
+		latestYear:   latest,
+		collegeInput: ti,
+		loading:      true,
+		width:        size.Width,
+		height:       size.Height,
+	}
+	return m, fetchDraftHistoryCmd(year), nil
+}
+
+func fetchDraftHistoryCmd(year int) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		y := strconv.Itoa(year)
+		if err := cl.FetchDraftHistory(y); err != nil {
+			return draftHistoryFetchedMsg{year: year, err: fmt.Errorf("could not fetch the %d draft: %w", year, err)}
+		}
+		rs, err := cl.Loader.LoadDraftHistory(y)
+		if err != nil {
+			return draftHistoryFetchedMsg{year: year, err: err}
+		}
+		picks, headers, err := converters.PopulateDraftHistory(rs)
+		return draftHistoryFetchedMsg{year: year, picks: picks, headers: headers, err: err}
+	}
+}
+
+// team returns the abbreviation of the team filter, empty when the picks of all teams are shown
+func (m DraftHistory) team() string {
+	if m.teamIdx == 0 || m.teamIdx > len(m.teams) {
+		return ""
+	}
+	return m.teams[m.teamIdx-1]
+}
+
+// buildTable builds the table of the picks matching the filters, the team column in the color of the team
+func (m DraftHistory) buildTable() table.Model {
+	picks := converters.FilterDraftPicks(m.picks, m.team(), m.college)
+	t := buildTables(m.headers, types.ConvertToStringMatrix(picks), types.DraftPick{})
+
+	rows := t.GetVisibleRows()
+	for i, pick := range picks {
+		if i >= len(rows) {
+			break
+		}
+		style := lipgloss.NewStyle().Bold(true).Foreground(TeamColor(strings.ReplaceAll(pick.TeamName, " ", "")))
+		rows[i].Data["TEAM_ABBREVIATION"] = table.NewStyledCell(pick.TeamAbbreviation, style)
+	}
+
+	return t.WithRows(rows).
+		Focused(true).
+		WithPageSize(calculatePageSize(m.height-3, 1)).
+		WithFooterVisibility(len(picks) > calculatePageSize(m.height-3, 1))
+}
+
+// changeYear moves to another draft and fetches it, the filters are kept
+func (m *DraftHistory) changeYear(year int) tea.Cmd {
+	if year < firstDraftYear || year > m.latestYear || year == m.year {
+		return nil
+	}
+	m.year = year
+	m.loading = true
+	return fetchDraftHistoryCmd(year)
+}
+
+func (m DraftHistory) Init() tea.Cmd { return nil }
+
+func (m DraftHistory) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case draftHistoryFetchedMsg:
+		if msg.year != m.year {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			log.Println("could not load draft history:", msg.err)
+			m.picks = nil
+			return m, nil
+		}
+		team := m.team()
+		m.picks = msg.picks
+		m.headers = msg.headers
+		m.teams = converters.DraftTeams(msg.picks)
+		m.teamIdx = 0
+		for i, t := range m.teams {
+			if t == team {
+				m.teamIdx = i + 1
+			}
+		}
+		m.picksTable = m.buildTable()
+		return m, nil
+
+	case playerProfileDownloadedMsg:
+		if msg.err != nil {
+			log.Println("could not download player profile:", msg.err)
+			return m, nil
+		}
+		pp, cmd, err := NewPlayerProfile(msg.playerID, msg.backView, msg.sourceDate, WindowSize)
+		if err != nil {
+			log.Println("could not load player profile:", err)
+			return m, nil
+		}
+		return pp, cmd
+
+	case tea.KeyMsg:
+		if m.editing {
+			return m.updateCollegeFilter(msg)
+		}
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			return InitMenu()
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, Keymap.Left):
+			return m, m.changeYear(m.year - 1)
+		case key.Matches(msg, Keymap.Right):
+			return m, m.changeYear(m.year + 1)
+		case m.loading || m.err != nil:
+			return m, nil
+		case key.Matches(msg, Keymap.Tab):
+			m.teamIdx = (m.teamIdx + 1) % (len(m.teams) + 1)
+			m.picksTable = m.buildTable()
+			return m, nil
+		case key.Matches(msg, Keymap.Filter):
+			m.editing = true
+			m.collegeInput.SetValue(m.college)
+			return m, m.collegeInput.Focus()
+		case key.Matches(msg, Keymap.Enter):
+			playerID, ok := m.picksTable.HighlightedRow().Data["PERSON_ID"].(string)
+			if !ok || playerID == "0" {
+				return m, nil
+			}
+			return m, downloadPlayerProfile(playerID, "draftHistory", strconv.Itoa(m.year))
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		if !m.loading && m.err == nil {
+			m.picksTable = m.buildTable()
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.picksTable, cmd = m.picksTable.Update(msg)
+	return m, cmd
+}
+
+// updateCollegeFilter handles the keys while the college filter is being typed
+func (m DraftHistory) updateCollegeFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, Keymap.Cancel):
+		m.editing = false
+		m.collegeInput.Blur()
+		return m, nil
+	case key.Matches(msg, Keymap.Enter):
+		m.editing = false
+		m.collegeInput.Blur()
+		m.college = m.collegeInput.Value()
+		m.picksTable = m.buildTable()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.collegeInput, cmd = m.collegeInput.Update(msg)
+	return m, cmd
+}
+
+// filterView renders the year selector and the active filters
+func (m DraftHistory) filterView() string {
+	active := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("5"))
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	arrows := func(enabled bool, s string) string {
+		if enabled {
+			return active.Render(s)
+		}
+		return dim.Render(s)
+	}
+	year := arrows(m.year > firstDraftYear, "◀") + "  " +
+		lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%d NBA DRAFT", m.year)) + "  " +
+		arrows(m.year < m.latestYear, "▶")
+
+	team := "all teams"
+	if t := m.team(); t != "" {
+		team = t
+	}
+	college := dim.Render("any")
+	if m.editing {
+		college = m.collegeInput.View()
+	} else if m.college != "" {
+		college = active.Render(m.college)
+	}
+	filters := dim.Render("Team: ") + active.Render(team) + dim.Render("   College: ") + college
+
+	return lipgloss.JoinVertical(lipgloss.Left, year, filters)
+}
+
+func (m DraftHistory) helpView() string {
+	if m.editing {
+		return HelpStyle(Keymap.Enter.Help().Key + ": apply | " + Keymap.Cancel.Help().Key + ": " + Keymap.Cancel.Help().Desc +
+			" | an empty filter shows all colleges")
+	}
+	return HelpStyle(Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		"<- ->: year | " +
+		Keymap.Tab.Help().Key + ": team | " +
+		Keymap.Filter.Help().Key + ": college | " +
+		Keymap.Enter.Help().Key + ": player profile")
+}
+
+func (m DraftHistory) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var body string
+	switch {
+	case m.loading:
+		body = fmt.Sprintf("Loading the %d draft...", m.year)
+	case m.err != nil:
+		body = "Could not load the draft: " + m.err.Error()
+	case len(m.picksTable.GetVisibleRows()) == 0:
+		body = "No picks match the filters"
+	default:
+		body = m.picksTable.View()
+	}
+
+	return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		m.filterView(),
+		"",
+		body,
+		m.helpView()))
+}
@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func testDraftPicks() []types.DraftPick {
+	return []types.DraftPick{
+		{PlayerID: 2544, OverallPick: 1, PlayerName: "LeBron James", TeamAbbreviation: "CLE", TeamName: "Cavaliers",
+			Organization: "St. Vincent-St. Mary HS (OH)"},
+		{PlayerID: 2546, OverallPick: 3, PlayerName: "Carmelo Anthony", TeamAbbreviation: "DEN", TeamName: "Nuggets",
+			Organization: "Syracuse"},
+		{PlayerID: 2548, OverallPick: 5, PlayerName: "Dwyane Wade", TeamAbbreviation: "MIA", TeamName: "Heat",
+			Organization: "Marquette"},
+	}
+}
+
+func TestDraftHistory_Filters(t *testing.T) {
+	// tables are sized to the terminal
+	defer func(size tea.WindowSizeMsg) { WindowSize = size }(WindowSize)
+	WindowSize = tea.WindowSizeMsg{Width: 160, Height: 40}
+
+	m := DraftHistory{year: 2003, latestYear: 2024, collegeInput: textinput.New(), loading: true, width: 160, height: 40}
+	model, _ := m.Update(draftHistoryFetchedMsg{year: 2003, picks: testDraftPicks(),
+		headers: converters.DraftHistoryHeaders()})
+	m = model.(DraftHistory)
+	if m.loading || len(m.picksTable.GetVisibleRows()) != 3 {
+		t.Fatalf("expected all 3 picks, got %d", len(m.picksTable.GetVisibleRows()))
+	}
+
+	// the first tab filters by the alphabetically first team
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(DraftHistory)
+	if m.team() != "CLE" || len(m.picksTable.GetVisibleRows()) != 1 {
+		t.Errorf("team filter = %q with %d picks, want CLE with 1", m.team(), len(m.picksTable.GetVisibleRows()))
+	}
+
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(DraftHistory)
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(DraftHistory)
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(DraftHistory)
+	if m.team() != "" {
+		t.Errorf("tabbing past the last team should show all teams, got %q", m.team())
+	}
+
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	m = model.(DraftHistory)
+	if !m.editing {
+		t.Fatal("f should start editing the college filter")
+	}
+	for _, r := range "MARQ" {
+		model, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = model.(DraftHistory)
+	}
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = model.(DraftHistory)
+	if m.editing || m.college != "MARQ" {
+		t.Fatalf("college filter = %q (editing %v), want MARQ", m.college, m.editing)
+	}
+	rows := m.picksTable.GetVisibleRows()
+	if len(rows) != 1 || rows[0].Data["PERSON_ID"] != "2548" {
+		t.Errorf("college filter rows = %+v, want Dwyane Wade only", rows)
+	}
+	if view := m.View(); !strings.Contains(view, "2003 NBA DRAFT") || !strings.Contains(view, "Dwyane Wade") {
+		t.Errorf("View() is missing the year or the pick:\n%s", view)
+	}
+}
+
+func TestDraftHistory_IgnoresStaleYear(t *testing.T) {
+	m := DraftHistory{year: 2004, latestYear: 2024, loading: true}
+	model, _ := m.Update(draftHistoryFetchedMsg{year: 2003, picks: testDraftPicks()})
+	if m = model.(DraftHistory); !m.loading || len(m.picks) != 0 {
+		t.Error("a draft fetched for another year should be ignored")
+	}
+}
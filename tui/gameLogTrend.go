@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// trendStat is a game log stat that can be charted in the GameLogTrendPanel
+type trendStat struct {
+	name  string
+	value func(types.GameLogEntry) float64
+}
+
+var trendStats = []trendStat{
+	{"PTS", func(e types.GameLogEntry) float64 { return float64(e.PTS) }},
+	{"REB", func(e types.GameLogEntry) float64 { return float64(e.REB) }},
+	{"AST", func(e types.GameLogEntry) float64 { return float64(e.AST) }},
+	{"+/-", func(e types.GameLogEntry) float64 { return e.PlusMinus }},
+	{"MIN", func(e types.GameLogEntry) float64 { return float64(e.MIN) }},
+	{"FG%", func(e types.GameLogEntry) float64 { return e.FGPCT * 100 }},
+}
+
+// GameLogTrendPanel charts the season game log of a player: a sparkline per stat and a line chart of the selected
+// stat with its rolling 5 and 10 game averages against the season average
+type GameLogTrendPanel struct {
+	games   []types.GameLogEntry
+	statIdx int
+	width   int
+	color   lipgloss.Color
+}
+
+// NewGameLogTrendPanel creates an empty trend panel
+func NewGameLogTrendPanel() GameLogTrendPanel {
+	return GameLogTrendPanel{width: 80, color: lipgloss.Color("#FFFFFF")}
+}
+
+// SetGames sets the game log, the API lists the games most recent first
+func (p *GameLogTrendPanel) SetGames(entries []types.GameLogEntry) {
+	p.games = make([]types.GameLogEntry, len(entries))
+	for i, e := range entries {
+		p.games[len(entries)-1-i] = e
+	}
+}
+
+// SetWidth sets the available width, the chart is sized to fit into it
+func (p *GameLogTrendPanel) SetWidth(width int) {
+	p.width = width
+}
+
+// SetColor sets the color of the per game line, usually the team color
+func (p *GameLogTrendPanel) SetColor(clr lipgloss.Color) {
+	p.color = clr
+}
+
+func (p GameLogTrendPanel) Update(msg tea.Msg) (GameLogTrendPanel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, Keymap.Right):
+			p.statIdx = (p.statIdx + 1) % len(trendStats)
+		case key.Matches(msg, Keymap.Left):
+			p.statIdx = (p.statIdx + len(trendStats) - 1) % len(trendStats)
+		}
+	}
+	return p, nil
+}
+
+// values returns the chronological values of a stat
+func (p GameLogTrendPanel) values(stat trendStat) []float64 {
+	values := make([]float64, len(p.games))
+	for i, g := range p.games {
+		values[i] = stat.value(g)
+	}
+	return values
+}
+
+// sparklines renders one sparkline per stat, the selected one highlighted
+func (p GameLogTrendPanel) sparklines() string {
+	nameStyle := lipgloss.NewStyle().Width(5)
+	avgStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	var lines []string
+	for i, stat := range trendStats {
+		values := p.values(stat)
+		clr := lipgloss.Color("241")
+		name := nameStyle.Render(stat.name)
+		if i == p.statIdx {
+			clr = lipgloss.Color("5")
+			name = nameStyle.Bold(true).Foreground(clr).Render(stat.name)
+		}
+		lines = append(lines, name+sparkline(values, clr)+avgStyle.Render(fmt.Sprintf("  avg %.1f", mean(values))))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// chart renders the line chart of the selected stat
+func (p GameLogTrendPanel) chart() string {
+	stat := trendStats[p.statIdx]
+	values := p.values(stat)
+
+	chart := NewLineChart(min(max(p.width-20, 20), 120), 12)
+	chart.Series = []ChartSeries{
+		{Name: stat.name, Values: values, Color: p.color},
+		{Name: "5 game avg", Values: rollingAverage(values, 5), Color: lipgloss.Color("#F1C40F")},
+		{Name: "10 game avg", Values: rollingAverage(values, 10), Color: lipgloss.Color("#3498DB")},
+	}
+	chart.References = []ChartReference{{Name: "season avg", Value: mean(values), Color: lipgloss.Color("241")}}
+	chart.XStart = p.games[0].GameDate
+	chart.XEnd = p.games[len(p.games)-1].GameDate
+	return chart.View()
+}
+
+func (p GameLogTrendPanel) HelpView() string {
+	return "<- ->: stat | " + Keymap.Trend.Help().Key + ": back to tables"
+}
+
+// View renders the sparklines and the chart of the selected stat
+func (p GameLogTrendPanel) View() string {
+	if len(p.games) == 0 {
+		return "No games played this season"
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s per game, %d games this season", trendStats[p.statIdx].name, len(p.games)))
+	return lipgloss.JoinVertical(lipgloss.Left,
+		p.sparklines(),
+		"",
+		title,
+		"",
+		p.chart())
+}
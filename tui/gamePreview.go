@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// previewTeam is a team of a scheduled game as shown on its game card
+type previewTeam struct {
+	tricode string
+	name    string
+}
+
+// GamePreview shows the injury report of both teams of a game that has not started yet
+type GamePreview struct {
+	gameID     string
+	sourceDate string
+	away       previewTeam
+	home       previewTeam
+	report     types.InjuryReport
+	headers    []string
+	awayTable  table.Model
+	homeTable  table.Model
+	loading    bool
+	err        error
+	width      int
+	height     int
+	quitting   bool
+}
+
+// NewGamePreview instantiates the preview of a scheduled game from the row data of its game card
+func NewGamePreview(gameID, sourceDate string, away, home table.RowData, size tea.WindowSizeMsg) (*GamePreview, tea.Cmd) {
+	team := func(data table.RowData) previewTeam {
+		tricode, _ := data["teams"].(string)
+		name, _ := data["teamName"].(string)
+		return previewTeam{tricode: tricode, name: name}
+	}
+	m := &GamePreview{
+		gameID:     gameID,
+		sourceDate: sourceDate,
+		away:       team(away),
+		home:       team(home),
+		loading:    true,
+		width:      size.Width,
+		height:     size.Height,
+	}
+	return m, fetchInjuryReportCmd(false)
+}
+
+// teamEntries returns the entries of the game for one of its teams, the report names teams by city and name
+func (m GamePreview) teamEntries(team previewTeam) []types.InjuryReportEntry {
+	var entries []types.InjuryReportEntry
+	for _, e := range m.report.ForMatchup(m.away.tricode, m.home.tricode) {
+		if team.name != "" && strings.HasSuffix(e.Team, team.name) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func (m *GamePreview) buildTables() {
+	m.awayTable = injuryTable(m.headers, m.teamEntries(m.away))
+	m.homeTable = injuryTable(m.headers, m.teamEntries(m.home))
+}
+
+func (m GamePreview) Init() tea.Cmd { return nil }
+
+func (m GamePreview) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case injuryReportFetchedMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			log.Println("could not load injury report:", msg.err)
+			return m, nil
+		}
+		m.report = msg.report
+		m.headers = msg.headers
+		m.buildTables()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			dv, cmd := NewDailyViewForDate(m.sourceDate, WindowSize)
+			return dv, cmd
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, Keymap.Refresh):
+			m.loading = true
+			m.err = nil
+			return m, fetchInjuryReportCmd(true)
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+	}
+	return m, nil
+}
+
+// teamView renders the injury report of a team under its name, in the team's colors
+func (m GamePreview) teamView(team previewTeam, t table.Model) string {
+	color := TeamColor(strings.ReplaceAll(team.name, " ", ""))
+	label := lipgloss.NewStyle().Foreground(color).Bold(true).Render(team.tricode + " " + team.name)
+
+	body := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("Nobody on the injury report")
+	if len(t.GetVisibleRows()) > 0 {
+		body = TeamTableBorderStyle(color).Render(t.View())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, label, body)
+}
+
+func (m GamePreview) helpView() string {
+	return HelpStyle(Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		Keymap.Refresh.Help().Key + ": refresh injury report")
+}
+
+func (m GamePreview) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s @ %s - GAME PREVIEW", m.away.tricode, m.home.tricode))
+
+	var body string
+	switch {
+	case m.loading:
+		body = "Loading the injury report..."
+	case m.err != nil:
+		body = "Could not load the injury report: " + m.err.Error()
+	default:
+		subtitle := "INJURY REPORT"
+		if m.report.Published != "" {
+			subtitle += " - published " + m.report.Published
+		}
+		body = lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(subtitle),
+			"",
+			m.teamView(m.away, m.awayTable),
+			"",
+			m.teamView(m.home, m.homeTable))
+	}
+
+	return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, "", body, m.helpView()))
+}
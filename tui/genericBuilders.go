@@ -3,6 +3,7 @@ package tui
 import (
 	"github.com/evertras/bubble-table/table"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -103,3 +104,66 @@ func buildTables[T any](headers []string, rows interface{}, sampleType T) table.
 		WithMaxTotalWidth(WindowSize.Width - 10).WithBaseStyle(TableStyle).
 		Focused(false).WithHorizontalFreezeColumnCount(2)
 }
+
+// sortColumn is a column a table can be sorted by, the column key and its display name
+type sortColumn struct {
+	key  string
+	name string
+}
+
+// sortableColumns returns the visible columns of sampleType tagged sortable:"true", in field order
+func sortableColumns[T any](sampleType T) []sortColumn {
+	itemType := reflect.TypeOf(sampleType)
+
+	var columns []sortColumn
+	for i := 0; i < itemType.NumField(); i++ {
+		field := itemType.Field(i)
+		if field.Tag.Get("sortable") != "true" || field.Tag.Get("isVisible") != "true" {
+			continue
+		}
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if jsonName == "" {
+			continue
+		}
+		name := field.Tag.Get("display")
+		if name == "" {
+			name = jsonName
+		}
+		columns = append(columns, sortColumn{key: jsonName, name: name})
+	}
+	return columns
+}
+
+// sortRows orders the rows of a table built by buildTables by one column. buildTables stores every cell as a string,
+// so cells that both parse as numbers (percentages included) are compared numerically and all others as text.
+func sortRows(t table.Model, column string, desc bool) table.Model {
+	rows := append([]table.Row(nil), t.GetVisibleRows()...)
+
+	less := func(a, b interface{}) bool {
+		as, bs := sortCellString(a), sortCellString(b)
+		af, aErr := strconv.ParseFloat(strings.TrimSuffix(as, "%"), 64)
+		bf, bErr := strconv.ParseFloat(strings.TrimSuffix(bs, "%"), 64)
+		if aErr == nil && bErr == nil {
+			return af < bf
+		}
+		return as < bs
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i].Data[column], rows[j].Data[column]
+		if desc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+	return t.WithRows(rows)
+}
+
+// sortCellString returns the text of a table cell, styled or not
+func sortCellString(cell interface{}) string {
+	if styled, ok := cell.(table.StyledCell); ok {
+		cell = styled.Data
+	}
+	s, _ := cell.(string)
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestSortableColumns(t *testing.T) {
+	columns := sortableColumns(types.TeamLineup{})
+	if len(columns) == 0 || columns[0].key != "GP" {
+		t.Fatalf("sortableColumns() = %+v, want GP first", columns)
+	}
+	for _, c := range columns {
+		if c.key == "GROUP_NAME" || c.key == "GROUP_ID" {
+			t.Errorf("%s should not be sortable", c.key)
+		}
+	}
+}
+
+func TestSortRows(t *testing.T) {
+	prev := WindowSize
+	WindowSize = tea.WindowSizeMsg{Width: 200, Height: 50}
+	defer func() { WindowSize = prev }()
+
+	lineups := []types.TeamLineup{
+		{GroupID: "1", Lineup: "A", MIN: 9.5, NetRating: 12},
+		{GroupID: "2", Lineup: "B", MIN: 120, NetRating: -3.5},
+		{GroupID: "3", Lineup: "C", MIN: 45, NetRating: 0},
+	}
+	headers := []string{"GROUP_ID", "GROUP_NAME", "GP", "W", "L", "MIN", "OFF_RATING", "DEF_RATING", "NET_RATING",
+		"TS_PCT", "PACE"}
+	tbl := buildTables(headers, types.ConvertToStringMatrix(lineups), types.TeamLineup{})
+
+	order := func(column string, desc bool) string {
+		var s string
+		sorted := sortRows(tbl, column, desc)
+		for _, row := range sorted.GetVisibleRows() {
+			s += row.Data["GROUP_NAME"].(string)
+		}
+		return s
+	}
+
+	// numeric, not lexical: 9.5 < 45 < 120
+	if got := order("MIN", true); got != "BCA" {
+		t.Errorf("MIN desc = %s, want BCA", got)
+	}
+	if got := order("NET_RATING", false); got != "BCA" {
+		t.Errorf("NET_RATING asc = %s, want BCA", got)
+	}
+	if got := order("GROUP_NAME", true); got != "CBA" {
+		t.Errorf("GROUP_NAME desc = %s, want CBA", got)
+	}
+}
@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// InjuryList is the league-wide injury report, filtered by status and by team
+type InjuryList struct {
+	report      types.InjuryReport
+	headers     []string
+	teams       []string
+	teamIdx     int
+	statusIdx   int
+	injuryTable table.Model
+	loading     bool
+	err         error
+	width       int
+	height      int
+	quitting    bool
+}
+
+type injuryReportFetchedMsg struct {
+	err     error
+	report  types.InjuryReport
+	headers []string
+}
+
+// NewInjuryList instantiates the injury report view on the latest report of the day
+func NewInjuryList(size tea.WindowSizeMsg) (*InjuryList, tea.Cmd, error) {
+	m := &InjuryList{
+		loading: true,
+		width:   size.Width,
+		height:  size.Height,
+	}
+	return m, fetchInjuryReportCmd(false), nil
+}
+
+// loadInjuryReport downloads the injury report unless it is cached, or refresh is set, and parses it
+func loadInjuryReport(refresh bool) (types.InjuryReport, []string, error) {
+	cl := nbaAPI.NewClient()
+	if err := cl.FetchInjuryReport(refresh); err != nil {
+		return types.InjuryReport{}, nil, fmt.Errorf("could not fetch the injury report: %w", err)
+	}
+	data, err := cl.Loader.LoadInjuryReport()
+	if err != nil {
+		return types.InjuryReport{}, nil, err
+	}
+	return converters.PopulateInjuryReport(data)
+}
+
+func fetchInjuryReportCmd(refresh bool) tea.Cmd {
+	return func() tea.Msg {
+		report, headers, err := loadInjuryReport(refresh)
+		return injuryReportFetchedMsg{report: report, headers: headers, err: err}
+	}
+}
+
+// injuryStatusStyle colors a status of the injury report by how likely the player is to miss the game
+func injuryStatusStyle(status string) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true)
+	switch status {
+	case types.InjuryStatusOut:
+		return style.Foreground(lipgloss.Color("1"))
+	case types.InjuryStatusDoubtful:
+		return style.Foreground(lipgloss.Color("208"))
+	case types.InjuryStatusQuestionable:
+		return style.Foreground(lipgloss.Color("3"))
+	case types.InjuryStatusProbable, types.InjuryStatusAvailable:
+		return style.Foreground(lipgloss.Color("2"))
+	}
+	return style
+}
+
+// injuryTable builds the table of injury report entries, the teams in their colors and the statuses by severity
+func injuryTable(headers []string, entries []types.InjuryReportEntry) table.Model {
+	t := buildTables(headers, types.ConvertToStringMatrix(entries), types.InjuryReportEntry{})
+
+	rows := t.GetVisibleRows()
+	for i, e := range entries {
+		if i >= len(rows) {
+			break
+		}
+		rows[i].Data["TEAM"] = table.NewStyledCell(e.Team, lipgloss.NewStyle().Bold(true).Foreground(TeamNameColor(e.Team)))
+		rows[i].Data["CURRENT_STATUS"] = table.NewStyledCell(e.Status, injuryStatusStyle(e.Status))
+	}
+	return t.WithRows(rows)
+}
+
+// team returns the team filter, empty when the players of all teams are shown
+func (m InjuryList) team() string {
+	if m.teamIdx == 0 || m.teamIdx > len(m.teams) {
+		return ""
+	}
+	return m.teams[m.teamIdx-1]
+}
+
+// status returns the status filter, empty when all statuses are shown
+func (m InjuryList) status() string {
+	if m.statusIdx == 0 || m.statusIdx > len(types.InjuryStatuses) {
+		return ""
+	}
+	return types.InjuryStatuses[m.statusIdx-1]
+}
+
+func (m InjuryList) buildTable() table.Model {
+	entries := converters.FilterInjuries(m.report.Entries, m.team(), m.status())
+	pageSize := calculatePageSize(m.height-3, 1)
+	return injuryTable(m.headers, entries).
+		Focused(true).
+		WithPageSize(pageSize).
+		WithFooterVisibility(len(entries) > pageSize)
+}
+
+func (m InjuryList) Init() tea.Cmd { return nil }
+
+func (m InjuryList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case injuryReportFetchedMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			log.Println("could not load injury report:", msg.err)
+			return m, nil
+		}
+		team := m.team()
+		m.report = msg.report
+		m.headers = msg.headers
+		m.teams = converters.InjuryTeams(msg.report.Entries)
+		m.teamIdx = 0
+		for i, t := range m.teams {
+			if t == team {
+				m.teamIdx = i + 1
+			}
+		}
+		m.injuryTable = m.buildTable()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			return InitMenu()
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, Keymap.Refresh):
+			m.loading = true
+			m.err = nil
+			return m, fetchInjuryReportCmd(true)
+		case m.loading || m.err != nil:
+			return m, nil
+		case key.Matches(msg, Keymap.Tab):
+			m.statusIdx = (m.statusIdx + 1) % (len(types.InjuryStatuses) + 1)
+			m.injuryTable = m.buildTable()
+			return m, nil
+		case key.Matches(msg, Keymap.Right):
+			m.teamIdx = (m.teamIdx + 1) % (len(m.teams) + 1)
+			m.injuryTable = m.buildTable()
+			return m, nil
+		case key.Matches(msg, Keymap.Left):
+			m.teamIdx = (m.teamIdx + len(m.teams)) % (len(m.teams) + 1)
+			m.injuryTable = m.buildTable()
+			return m, nil
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		if !m.loading && m.err == nil {
+			m.injuryTable = m.buildTable()
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.injuryTable, cmd = m.injuryTable.Update(msg)
+	return m, cmd
+}
+
+// filterView renders the title of the report and the active filters
+func (m InjuryList) filterView() string {
+	active := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("5"))
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	title := lipgloss.NewStyle().Bold(true).Render("INJURY REPORT")
+	if m.report.Published != "" {
+		title += dim.Render("  published " + m.report.Published)
+	}
+
+	team := "all teams"
+	if t := m.team(); t != "" {
+		team = t
+	}
+	status := "all statuses"
+	if s := m.status(); s != "" {
+		status = s
+	}
+	filters := dim.Render("Team: ") + active.Render(team) + dim.Render("   Status: ") + active.Render(status)
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, filters)
+}
+
+func (m InjuryList) helpView() string {
+	return HelpStyle(Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		"<- ->: team | " +
+		Keymap.Tab.Help().Key + ": status | " +
+		Keymap.Refresh.Help().Key + ": " + Keymap.Refresh.Help().Desc)
+}
+
+func (m InjuryList) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var body string
+	switch {
+	case m.loading:
+		body = "Loading the injury report..."
+	case m.err != nil:
+		body = "Could not load the injury report: " + m.err.Error()
+	case len(m.injuryTable.GetVisibleRows()) == 0:
+		body = "No players on the injury report match the filters"
+	default:
+		body = m.injuryTable.View()
+	}
+
+	return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		m.filterView(),
+		"",
+		body,
+		m.helpView()))
+}
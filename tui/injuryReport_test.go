@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func testInjuryReport() types.InjuryReport {
+	return types.InjuryReport{Published: "01/15/25 05:00 PM", Entries: []types.InjuryReportEntry{
+		{Team: "Boston Celtics", Player: "Jayson Tatum", Status: "Questionable", Matchup: "BOS@MIA"},
+		{Team: "Boston Celtics", Player: "Jrue Holiday", Status: "Out", Matchup: "BOS@MIA"},
+		{Team: "Miami Heat", Player: "Nikola Jovic", Status: "Out", Matchup: "BOS@MIA"},
+	}}
+}
+
+func TestInjuryList_Filters(t *testing.T) {
+	defer func(size tea.WindowSizeMsg) { WindowSize = size }(WindowSize)
+	WindowSize = tea.WindowSizeMsg{Width: 200, Height: 40}
+
+	headers := []string{"TEAM", "PLAYER_NAME", "CURRENT_STATUS", "REASON", "MATCHUP", "GAME_TIME", "GAME_DATE"}
+	m := InjuryList{loading: true, width: 200, height: 40}
+	model, _ := m.Update(injuryReportFetchedMsg{report: testInjuryReport(), headers: headers})
+	m = model.(InjuryList)
+	if m.loading || len(m.injuryTable.GetVisibleRows()) != 3 {
+		t.Fatalf("expected all 3 players, got %d", len(m.injuryTable.GetVisibleRows()))
+	}
+
+	// tab filters by status, starting with the players ruled out
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(InjuryList)
+	if m.status() != types.InjuryStatusOut || len(m.injuryTable.GetVisibleRows()) != 2 {
+		t.Errorf("status filter = %q with %d players, want Out with 2", m.status(), len(m.injuryTable.GetVisibleRows()))
+	}
+
+	// right filters by the alphabetically first team
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m = model.(InjuryList)
+	if m.team() != "Boston Celtics" || len(m.injuryTable.GetVisibleRows()) != 1 {
+		t.Errorf("team filter = %q with %d players, want Boston Celtics with 1", m.team(), len(m.injuryTable.GetVisibleRows()))
+	}
+
+	// left from the first team goes back to all teams
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m = model.(InjuryList)
+	if m.team() != "" {
+		t.Errorf("expected all teams, got %q", m.team())
+	}
+	if !strings.Contains(m.View(), "01/15/25 05:00 PM") {
+		t.Error("the view should show when the report was published")
+	}
+}
+
+func TestGamePreview_TeamEntries(t *testing.T) {
+	m, _ := NewGamePreview("0022400555", "2025-01-15",
+		table.RowData{"teams": "BOS", "teamName": "Celtics"},
+		table.RowData{"teams": "MIA", "teamName": "Heat"},
+		tea.WindowSizeMsg{Width: 200, Height: 40})
+	m.report = testInjuryReport()
+
+	if got := len(m.teamEntries(m.away)); got != 2 {
+		t.Errorf("Celtics entries = %d, want 2", got)
+	}
+	if got := len(m.teamEntries(m.home)); got != 1 {
+		t.Errorf("Heat entries = %d, want 1", got)
+	}
+}
+
+func TestTeamProfile_ApplyInjuryStatuses(t *testing.T) {
+	roster := table.New([]table.Column{
+		table.NewColumn("PLAYER_FIRST_NAME", "First Name", 12),
+		table.NewColumn("PLAYER_LAST_NAME", "Last Name", 12),
+		table.NewColumn("INJURY_STATUS", "Status", 14),
+	}).WithRows([]table.Row{
+		table.NewRow(table.RowData{"PLAYER_FIRST_NAME": "Jayson", "PLAYER_LAST_NAME": "Tatum", "INJURY_STATUS": ""}),
+		table.NewRow(table.RowData{"PLAYER_FIRST_NAME": "Jaylen", "PLAYER_LAST_NAME": "Brown", "INJURY_STATUS": ""}),
+	})
+	m := &TeamProfile{tables: make([]table.Model, 6)}
+
+	// the report may arrive before the roster
+	m.injuryReport, m.injuriesLoaded = testInjuryReport(), true
+	m.applyInjuryStatuses()
+	m.tables[2] = roster
+	m.applyInjuryStatuses()
+
+	rows := m.tables[2].GetVisibleRows()
+	if cell, ok := rows[0].Data["INJURY_STATUS"].(table.StyledCell); !ok || cell.Data != "Questionable" {
+		t.Errorf("Tatum's status = %v, want Questionable", rows[0].Data["INJURY_STATUS"])
+	}
+	if rows[1].Data["INJURY_STATUS"] != "" {
+		t.Errorf("Brown's status = %v, want none", rows[1].Data["INJURY_STATUS"])
+	}
+}
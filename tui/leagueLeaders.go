@@ -97,6 +97,13 @@ func (m LeagueLeaders) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 		case key.Matches(msg, Keymap.Quit):
 			m.quitting = true
 			return m, tea.Quit
+		case key.Matches(msg, Keymap.AllTime):
+			at, cmd, err := NewAllTimeLeaders(WindowSize)
+			if err != nil {
+				log.Println(err)
+				return m, nil
+			}
+			return at, cmd
 		case key.Matches(msg, Keymap.Enter):
 			selectedRows := m.leaderboard.SelectedRows()
 			if len(selectedRows) == 1 {
@@ -129,7 +136,7 @@ func (m LeagueLeaders) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 }
 
 func (m LeagueLeaders) helpView() string {
-	return HelpStyle(HelpFooter())
+	return HelpStyle(HelpFooter() + " | " + Keymap.AllTime.Help().Key + ": " + Keymap.AllTime.Help().Desc)
 }
 
 func (m LeagueLeaders) View() string {
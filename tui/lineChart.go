@@ -0,0 +1,237 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sparkBlocks are the eighth blocks used by sparkline, from lowest to highest
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// ChartSeries is one line of a LineChart. NaN values leave a gap in the line.
+type ChartSeries struct {
+	Name   string
+	Values []float64
+	Color  lipgloss.Color
+}
+
+// ChartReference is a dashed horizontal line across a LineChart, e.g. an average
+type ChartReference struct {
+	Name  string
+	Value float64
+	Color lipgloss.Color
+}
+
+// LineChart is a line chart drawn with braille dots, with a labelled y axis and a legend below.
+// All series share the x axis, the n-th value of every series is drawn at the same position.
+type LineChart struct {
+	Width      int
+	Height     int
+	Series     []ChartSeries
+	References []ChartReference
+	// XStart and XEnd label both ends of the x axis
+	XStart, XEnd string
+	// InvertY draws the lowest values at the top, e.g. for ranks
+	InvertY bool
+}
+
+// NewLineChart creates an empty chart with a plot area of width x height cells
+func NewLineChart(width, height int) LineChart {
+	return LineChart{Width: width, Height: height}
+}
+
+// bounds returns the value range of the y axis, covering all series values and reference lines
+func (c LineChart) bounds() (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, s := range c.Series {
+		for _, v := range s.Values {
+			if !math.IsNaN(v) {
+				lo, hi = math.Min(lo, v), math.Max(hi, v)
+			}
+		}
+	}
+	for _, ref := range c.References {
+		lo, hi = math.Min(lo, ref.Value), math.Max(hi, ref.Value)
+	}
+	switch {
+	case math.IsInf(lo, 1):
+		return 0, 1
+	case lo == hi:
+		return lo - 1, hi + 1
+	}
+	return lo, hi
+}
+
+// points returns the number of positions on the x axis
+func (c LineChart) points() int {
+	n := 0
+	for _, s := range c.Series {
+		n = max(n, len(s.Values))
+	}
+	return n
+}
+
+// View renders the chart
+func (c LineChart) View() string {
+	if c.points() == 0 {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("No data")
+	}
+
+	canvas := newBrailleCanvas(c.Width, c.Height)
+	w, h := canvas.dotSize()
+	lo, hi := c.bounds()
+	n := c.points()
+	toX := func(i int) int {
+		if n == 1 {
+			return w / 2
+		}
+		return i * (w - 1) / (n - 1)
+	}
+	toY := func(v float64) int {
+		if c.InvertY {
+			return int(math.Round((v - lo) / (hi - lo) * float64(h-1)))
+		}
+		return int(math.Round((hi - v) / (hi - lo) * float64(h-1)))
+	}
+	valueAt := func(y int) float64 {
+		if c.InvertY {
+			return lo + (hi-lo)*float64(y)/float64(h-1)
+		}
+		return hi - (hi-lo)*float64(y)/float64(h-1)
+	}
+
+	for _, ref := range c.References {
+		y := toY(ref.Value)
+		for x := 0; x < w; x += 4 {
+			canvas.colorLine(x, y, min(x+1, w-1), y, ref.Color)
+		}
+	}
+	for _, s := range c.Series {
+		prev := -1
+		for i, v := range s.Values {
+			if math.IsNaN(v) {
+				prev = -1
+				continue
+			}
+			if prev < 0 {
+				canvas.colorLine(toX(i), toY(v), toX(i), toY(v), s.Color)
+			} else {
+				canvas.colorLine(toX(prev), toY(s.Values[prev]), toX(i), toY(v), s.Color)
+			}
+			prev = i
+		}
+	}
+
+	axisStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	labelWidth := max(len(formatChartValue(lo)), len(formatChartValue(hi)), len(formatChartValue((lo+hi)/2)))
+	rows := strings.Split(canvas.String(), "\n")
+	for r := range rows {
+		label := ""
+		switch r {
+		case 0:
+			label = formatChartValue(valueAt(0))
+		case len(rows) / 2:
+			label = formatChartValue(valueAt(r * 4))
+		case len(rows) - 1:
+			label = formatChartValue(valueAt(h - 1))
+		}
+		tick := "│"
+		if label != "" {
+			tick = "┤"
+		}
+		rows[r] = axisStyle.Render(fmt.Sprintf("%*s %s", labelWidth, label, tick)) + rows[r]
+	}
+
+	pad := strings.Repeat(" ", labelWidth+1)
+	rows = append(rows, axisStyle.Render(pad+"└"+strings.Repeat("─", c.Width)))
+	if c.XStart != "" || c.XEnd != "" {
+		gap := max(1, c.Width-len(c.XStart)-len(c.XEnd))
+		rows = append(rows, axisStyle.Render(pad+" "+c.XStart+strings.Repeat(" ", gap)+c.XEnd))
+	}
+	if legend := c.legend(); legend != "" {
+		rows = append(rows, "", legend)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// legend lists the series and the reference lines with their values
+func (c LineChart) legend() string {
+	var items []string
+	for _, s := range c.Series {
+		if s.Name != "" {
+			items = append(items, lipgloss.NewStyle().Foreground(s.Color).Render("━━ "+s.Name))
+		}
+	}
+	for _, ref := range c.References {
+		if ref.Name != "" {
+			items = append(items, lipgloss.NewStyle().Foreground(ref.Color).
+				Render(fmt.Sprintf("┄┄ %s %s", ref.Name, formatChartValue(ref.Value))))
+		}
+	}
+	return strings.Join(items, "   ")
+}
+
+func formatChartValue(v float64) string {
+	if v == math.Trunc(v) && math.Abs(v) < 1e6 {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+// sparkline renders the values as a single line of block characters, scaled between their minimum and maximum
+func sparkline(values []float64, clr lipgloss.Color) string {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v):
+			sb.WriteRune(' ')
+		case hi == lo:
+			sb.WriteRune(sparkBlocks[len(sparkBlocks)/2])
+		default:
+			idx := int(math.Round((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1)))
+			sb.WriteRune(sparkBlocks[idx])
+		}
+	}
+	return lipgloss.NewStyle().Foreground(clr).Render(sb.String())
+}
+
+// rollingAverage returns the average of every value and the window-1 values before it.
+// Positions without a full window are NaN.
+func rollingAverage(values []float64, window int) []float64 {
+	out := make([]float64, len(values))
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= window {
+			sum -= values[i-window]
+		}
+		if i < window-1 {
+			out[i] = math.NaN()
+		} else {
+			out[i] = sum / float64(window)
+		}
+	}
+	return out
+}
+
+// mean returns the average of the values, 0 when there are none
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
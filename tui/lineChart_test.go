@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestRollingAverage(t *testing.T) {
+	got := rollingAverage([]float64{2, 4, 6, 8}, 3)
+	if !math.IsNaN(got[0]) || !math.IsNaN(got[1]) {
+		t.Errorf("positions without a full window should be NaN, got %v", got[:2])
+	}
+	if got[2] != 4 || got[3] != 6 {
+		t.Errorf("rollingAverage() = %v, want [NaN NaN 4 6]", got)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	got := sparkline([]float64{0, 7, math.NaN(), 14}, lipgloss.Color("5"))
+	if got != "▁▅ █" {
+		t.Errorf("sparkline() = %q, want %q", got, "▁▅ █")
+	}
+	if got := sparkline([]float64{3, 3}, lipgloss.Color("5")); got != "▅▅" {
+		t.Errorf("sparkline() of constant values = %q", got)
+	}
+}
+
+func TestLineChart_View(t *testing.T) {
+	chart := NewLineChart(20, 5)
+	chart.Series = []ChartSeries{{Name: "PTS", Values: []float64{10, 30, 20}, Color: lipgloss.Color("5")}}
+	chart.References = []ChartReference{{Name: "avg", Value: 20, Color: lipgloss.Color("241")}}
+	chart.XStart, chart.XEnd = "OCT 22", "NOV 01"
+
+	lines := strings.Split(chart.View(), "\n")
+	if len(lines) != 9 {
+		t.Fatalf("View() has %d lines, want 5 plot rows, axis, x labels, blank and legend:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if !strings.HasPrefix(lines[0], "30 ┤") || !strings.HasPrefix(lines[4], "10 ┤") {
+		t.Errorf("y axis is not labelled with the value range:\n%s", strings.Join(lines, "\n"))
+	}
+	if !strings.Contains(lines[6], "OCT 22") || !strings.HasSuffix(lines[6], "NOV 01") {
+		t.Errorf("x labels = %q", lines[6])
+	}
+	if !strings.Contains(lines[8], "PTS") || !strings.Contains(lines[8], "avg 20") {
+		t.Errorf("legend = %q", lines[8])
+	}
+}
+
+func TestGameLogTrendPanel_ChronologicalOrder(t *testing.T) {
+	p := NewGameLogTrendPanel()
+	p.SetGames([]types.GameLogEntry{{GameDate: "FEB 10, 2025", PTS: 30}, {GameDate: "FEB 08, 2025", PTS: 10}})
+	if got := p.values(trendStats[0]); got[0] != 10 || got[1] != 30 {
+		t.Errorf("values = %v, want the oldest game first", got)
+	}
+	if view := p.View(); !strings.Contains(view, "FEB 08, 2025") {
+		t.Errorf("View() does not start the x axis at the oldest game:\n%s", view)
+	}
+}
@@ -57,6 +57,18 @@ func createMenuItems() ([]list.Item, error) {
 			index:       4,
 			title:       "Playoff Bracket",
 			description: "Postseason bracket and series history",
+		}, menuItem{
+			index:       5,
+			title:       "Draft History",
+			description: "Every pick of an NBA draft",
+		}, menuItem{
+			index:       6,
+			title:       "Injury Report",
+			description: "Who is out, doubtful or questionable for the next game",
+		}, menuItem{
+			index:       7,
+			title:       "Transactions",
+			description: "Trades, signings and waivers around the league",
 		}}
 	return items, nil
 }
@@ -65,6 +77,15 @@ func createMenuItems() ([]list.Item, error) {
 // It's only ran once when the app starts. Subsequent returns to the main menu do not trigger it again.
 func makeInitialRequests() tea.Cmd {
 	return func() tea.Msg {
+		if lock, err := lockCache(); err != nil {
+			log.Println("could not lock the cache:", err)
+		} else {
+			defer func() {
+				if err := lock.Unlock(); err != nil {
+					log.Println(err)
+				}
+			}()
+		}
 		err := nbaAPI.NewClient().MakeDefaultRequests()
 		return requestsFinishedMsg{err: err}
 	}
@@ -151,6 +172,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					os.Exit(1)
 				}
 				return pb, cmd
+			case selectedItem.FilterValue() == "Draft History":
+				dh, cmd, err := NewDraftHistory(0, WindowSize)
+				if err != nil {
+					log.Println(err)
+					os.Exit(1)
+				}
+				return dh, cmd
+			case selectedItem.FilterValue() == "Injury Report":
+				il, cmd, err := NewInjuryList(WindowSize)
+				if err != nil {
+					log.Println(err)
+					os.Exit(1)
+				}
+				return il, cmd
+			case selectedItem.FilterValue() == "Transactions":
+				tv, cmd, err := NewTransactionsView(WindowSize)
+				if err != nil {
+					log.Println(err)
+					os.Exit(1)
+				}
+				return tv, cmd
 			}
 		case key.Matches(msg, Keymap.Quit):
 			m.quitting = true
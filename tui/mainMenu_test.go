@@ -14,8 +14,8 @@ func TestMenuItemCreation(t *testing.T) {
 		t.Fatalf("createMenuItems() returned unexpected error: %v", err)
 	}
 
-	if len(items) != 5 {
-		t.Errorf("createMenuItems() expected 5 menu items , got  %d", len(items))
+	if len(items) != 8 {
+		t.Errorf("createMenuItems() expected 8 menu items , got  %d", len(items))
 	}
 
 	expectedTitles := []string{
@@ -24,6 +24,9 @@ func TestMenuItemCreation(t *testing.T) {
 		"League Leaders",
 		"Recent News",
 		"Playoff Bracket",
+		"Draft History",
+		"Injury Report",
+		"Transactions",
 	}
 
 	for i, item := range items {
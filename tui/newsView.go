@@ -4,21 +4,29 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
 	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
 	"log"
 	"net/url"
 	"os/exec"
 	"runtime"
+	"strings"
 )
 
 type NewsItem struct {
-	title string
-	url   string
-	date  string
+	title   string
+	url     string
+	date    string
+	source  string
+	summary string
 }
 
 type NewsModel struct {
 	list       list.Model
+	articles   []nbaAPI.NewsArticle
+	teams      []string
+	teamIdx    int
 	quitting   bool
 	width      int
 	height     int
@@ -38,13 +46,62 @@ func (i NewsItem) Title() string {
 	return i.title
 }
 
+// Description shows when and where an article was published, followed by its summary
 func (i NewsItem) Description() string {
-	return i.date
+	var parts []string
+	for _, p := range []string{i.date, i.source, i.summary} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, " · ")
+}
+
+// newsTagger tags news with the team names of the team colors and the players of the league's player index.
+// Without the player index news is tagged with teams only.
+func newsTagger() *nbaAPI.NewsTagger {
+	var teams []string
+	for team := range teamColors {
+		teams = append(teams, team)
+	}
+
+	var players []nbaAPI.NewsPlayer
+	cl := nbaAPI.NewClient()
+	if err := cl.FetchLeaguePlayerIndex(); err != nil {
+		log.Println("tagging news without players:", err)
+		return nbaAPI.NewNewsTagger(teams, nil)
+	}
+	rs, err := cl.Loader.LoadLeaguePlayerIndex()
+	if err == nil {
+		var index types.IndexPlayers
+		if index, _, err = converters.PopulatePlayerIndex(rs); err == nil {
+			for _, p := range index {
+				players = append(players, nbaAPI.NewsPlayer{
+					Name: p.PlayerFirstName + " " + p.PlayerLastName,
+					Team: p.TeamName,
+				})
+			}
+		}
+	}
+	if err != nil {
+		log.Println("tagging news without players:", err)
+	}
+	return nbaAPI.NewNewsTagger(teams, players)
+}
+
+// fetchTaggedNews reads the news and tags it with the teams and players it mentions
+func fetchTaggedNews(nc *nbaAPI.NewsClient) ([]nbaAPI.NewsArticle, error) {
+	articles, err := nc.FetchNews()
+	if err != nil {
+		return nil, err
+	}
+	newsTagger().TagArticles(articles)
+	return articles, nil
 }
 
 func fetchNewsCmd(nc *nbaAPI.NewsClient) tea.Cmd {
 	return func() tea.Msg {
-		articles, err := nc.FetchNews()
+		articles, err := fetchTaggedNews(nc)
 		return newsFetchedMsg{articles: articles, err: err}
 	}
 }
@@ -96,10 +153,18 @@ func (m *NewsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.quitting = true
 			return m, tea.Quit
 		case key.Matches(msg, Keymap.Enter):
+			if item, ok := m.list.SelectedItem().(NewsItem); ok {
+				return NewArticleReader(m, m.newsClient, item.title, item.url, tea.WindowSizeMsg{Width: m.width, Height: m.height})
+			}
+		case key.Matches(msg, Keymap.Browser):
 			if item, ok := m.list.SelectedItem().(NewsItem); ok {
 				log.Printf("Opening URL: %s", item.url)
 				go openURL(item.url)
 			}
+		case key.Matches(msg, Keymap.Tab) && len(m.teams) > 0:
+			m.teamIdx = (m.teamIdx + 1) % (len(m.teams) + 1)
+			m.showArticles()
+			return m, nil
 		}
 	case newsFetchedMsg:
 		if msg.err != nil {
@@ -115,24 +180,16 @@ func (m *NewsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		var items []list.Item
-		for _, article := range msg.articles {
-			items = append(items, NewsItem{
-				title: article.Title,
-				url:   article.URL,
-				date:  article.Timestamp,
-			})
-		}
-
-		if len(items) == 0 {
-			items = append(items, NewsItem{
-				title: "No news found - visit NBA.com for latest news",
-				url:   "https://www.nba.com/news",
-				date:  "Current",
-			})
+		team := m.team()
+		m.articles = msg.articles
+		m.teams = nbaAPI.ArticleTeams(msg.articles)
+		m.teamIdx = 0
+		for i, t := range m.teams {
+			if t == team {
+				m.teamIdx = i + 1
+			}
 		}
-		// Set the items on the list
-		m.list.SetItems(items)
+		m.showArticles()
 	}
 
 	m.list, cmd = m.list.Update(msg)
@@ -141,8 +198,46 @@ func (m *NewsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// team returns the team filter, empty when the news of all teams is shown
+func (m *NewsModel) team() string {
+	if m.teamIdx == 0 || m.teamIdx > len(m.teams) {
+		return ""
+	}
+	return m.teams[m.teamIdx-1]
+}
+
+// showArticles puts the articles of the selected team on the list
+func (m *NewsModel) showArticles() {
+	m.list.Title = "Recent News"
+	if team := m.team(); team != "" {
+		m.list.Title += " | " + team
+	}
+
+	var items []list.Item
+	for _, article := range nbaAPI.FilterArticlesByTeam(m.articles, m.team()) {
+		items = append(items, NewsItem{
+			title:   article.Title,
+			url:     article.URL,
+			date:    article.Timestamp,
+			source:  article.Source,
+			summary: article.Summary,
+		})
+	}
+
+	if len(items) == 0 {
+		items = append(items, NewsItem{
+			title: "No news found - visit NBA.com for latest news",
+			url:   "https://www.nba.com/news",
+			date:  "Current",
+		})
+	}
+	m.list.SetItems(items)
+	m.list.ResetSelected()
+}
+
 func (m *NewsModel) helpView() string {
-	return HelpStyle("\n" + HelpFooter() + "\n")
+	return HelpStyle("\n" + HelpFooter() + " | " + Keymap.Browser.Help().Key + ": " + Keymap.Browser.Help().Desc +
+		" | " + Keymap.Tab.Help().Key + ": team\n")
 }
 
 func (m *NewsModel) View() string {
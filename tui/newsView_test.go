@@ -120,3 +120,36 @@ func TestNewsView_FetchNewsMsg(t *testing.T) {
 		t.Errorf("Expected item title 'Test Article', got: %s", title)
 	}
 }
+
+func TestNewsItem_Description(t *testing.T) {
+	item := NewsItem{title: "Test", date: "2025-01-01 18:30", source: "ESPN", summary: "A summary"}
+	if got := item.Description(); got != "2025-01-01 18:30 · ESPN · A summary" {
+		t.Errorf("unexpected description %q", got)
+	}
+	if got := (NewsItem{date: "Current"}).Description(); got != "Current" {
+		t.Errorf("unexpected description %q", got)
+	}
+}
+
+func TestNewsView_TeamFilter(t *testing.T) {
+	model := &NewsModel{list: list.New([]list.Item{}, list.NewDefaultDelegate(), 80, 24), width: 80, height: 30}
+	model.Update(newsFetchedMsg{articles: []nbaAPI.NewsArticle{
+		{Title: "Celtics win", URL: "a", Teams: []string{"Celtics"}},
+		{Title: "Heat lose", URL: "b", Teams: []string{"Heat"}},
+		{Title: "League news", URL: "c"},
+	}})
+	if len(model.list.Items()) != 3 {
+		t.Fatalf("expected all 3 articles, got %d", len(model.list.Items()))
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.team() != "Celtics" || len(model.list.Items()) != 1 || model.list.Title != "Recent News | Celtics" {
+		t.Errorf("team filter = %q with %d articles, want Celtics with 1", model.team(), len(model.list.Items()))
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.team() != "" || len(model.list.Items()) != 3 {
+		t.Errorf("expected tab to wrap around to all teams, got %q", model.team())
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/evertras/bubble-table/table"
 	"github.com/sLg00/nba-now-tui/cmd/converters"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
 	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
 	"github.com/sLg00/nba-now-tui/cmd/nba/types"
 	"log"
@@ -15,6 +16,7 @@ import (
 )
 
 type PlayerProfile struct {
+	playerID         string
 	width            int
 	height           int
 	mainPort         viewport.Model
@@ -26,6 +28,21 @@ type PlayerProfile struct {
 	backView         string
 	sourceDate       string
 	teamColor        lipgloss.Color
+	shotChart        ShotChartPanel
+	showShotChart    bool
+	shotChartLoaded  bool
+	trend            GameLogTrendPanel
+	showTrend        bool
+	splitTables      []table.Model
+	splitNames       []string
+	splitIdx         int
+	splitsLoaded     bool
+	careerStats      map[nbaAPI.PerMode]types.CareerStats
+	careerModeIdx    int
+	careerView       int
+	awards           *types.PlayerAwardSummary
+	favorites        types.Favorites
+	favoritesStore   *filesystemops.FavoritesStore
 	quitting         bool
 }
 
@@ -49,37 +66,81 @@ type playerBioFetchedMsg struct {
 }
 
 type playerCareerStatsFetchedMsg struct {
-	err          error
-	seasonStats  table.Model
-	currentStats *types.SeasonStats
+	err     error
+	perMode nbaAPI.PerMode
+	career  types.CareerStats
+}
+
+// careerPerModes are the PerModes the career stats can be shown in, toggled with Keymap.Mode
+var careerPerModes = []struct {
+	mode  nbaAPI.PerMode
+	label string
+}{
+	{nbaAPI.PerModePerGame, "PER GAME"},
+	{nbaAPI.PerModeTotals, "TOTALS"},
+	{nbaAPI.PerModePer36, "PER 36"},
+}
+
+// careerViews are the sub-tabs of the career stats table
+var careerViews = []string{"REGULAR SEASON", "PLAYOFFS", "CAREER"}
+
+type playerAwardsFetchedMsg struct {
+	err     error
+	awards  table.Model
+	summary types.PlayerAwardSummary
+	count   int
+}
+
+// awardsPageSize is the number of awards shown per page of the awards list
+const awardsPageSize = 12
+
+type playerSplitsFetchedMsg struct {
+	err    error
+	tables []table.Model
+	names  []string
 }
 
 type playerGameLogFetchedMsg struct {
 	err     error
 	gameLog table.Model
+	entries []types.GameLogEntry
 }
 
 func NewPlayerProfile(playerID string, backView string, sourceDate string, size tea.WindowSizeMsg) (*PlayerProfile, tea.Cmd, error) {
 	vp := viewport.New(size.Width-4, size.Height-8)
 	vp.Style = TeamViewPortStyle(lipgloss.Color("#FFFFFF"))
 
+	cl := nbaAPI.NewClient()
+	store := filesystemops.NewFavoritesStore(cl.FileSystem, cl.Paths)
+	favorites, err := store.Load()
+	if err != nil {
+		log.Println("could not load favorites:", err)
+	}
+
 	m := &PlayerProfile{
+		playerID:         playerID,
 		mainPort:         vp,
 		width:            size.Width,
 		height:           size.Height,
-		tables:           make([]table.Model, 2),
-		tableNames:       []string{"LAST 5 GAMES", "CAREER STATS"},
+		tables:           make([]table.Model, 4),
+		tableNames:       []string{"LAST 5 GAMES", "CAREER STATS", "SPLITS", "AWARDS"},
+		careerStats:      make(map[nbaAPI.PerMode]types.CareerStats),
 		activeTableIndex: 0,
 		backView:         backView,
 		sourceDate:       sourceDate,
 		teamColor:        lipgloss.Color("#FFFFFF"),
+		shotChart:        NewShotChartPanel(playerID, "", cl.Dates.GetCurrentSeason()),
+		trend:            NewGameLogTrendPanel(),
+		favorites:        favorites,
+		favoritesStore:   store,
 		quitting:         false,
 	}
 
 	cmds := tea.Batch(
 		fetchPlayerBioCmd(playerID),
-		fetchPlayerCareerStatsCmd(playerID),
+		fetchPlayerCareerStatsCmd(playerID, nbaAPI.PerModePerGame),
 		fetchPlayerGameLogCmd(playerID),
+		fetchPlayerAwardsCmd(playerID),
 	)
 
 	return m, cmds, nil
@@ -102,32 +163,58 @@ func fetchPlayerBioCmd(playerID string) tea.Cmd {
 	}
 }
 
-func fetchPlayerCareerStatsCmd(playerID string) tea.Cmd {
+func fetchPlayerCareerStatsCmd(playerID string, perMode nbaAPI.PerMode) tea.Cmd {
 	return func() tea.Msg {
 		cl := nbaAPI.NewClient()
-		rs, err := cl.Loader.LoadPlayerCareerStats(playerID)
+		if err := cl.FetchPlayerCareerStats(playerID, perMode); err != nil {
+			return playerCareerStatsFetchedMsg{err: err, perMode: perMode}
+		}
+		rs, err := cl.Loader.LoadPlayerCareerStats(types.CareerStatsCacheID(playerID, string(perMode)))
 		if err != nil {
-			return playerCareerStatsFetchedMsg{err: err}
+			return playerCareerStatsFetchedMsg{err: err, perMode: perMode}
 		}
 
-		stats, headers, err := converters.PopulateSeasonStats(rs)
+		career, err := converters.PopulateCareerStats(rs)
 		if err != nil {
-			return playerCareerStatsFetchedMsg{err: err}
+			return playerCareerStatsFetchedMsg{err: err, perMode: perMode}
 		}
 
-		var currentStats *types.SeasonStats
-		if len(stats) > 0 {
-			currentStats = &stats[0]
-		}
+		return playerCareerStatsFetchedMsg{perMode: perMode, career: career}
+	}
+}
 
-		stringMatrix := types.ConvertToStringMatrix(stats)
-		tableModel := buildTables(headers, stringMatrix, types.SeasonStats{})
+// showCareerStats puts the rows of the selected PerMode and sub-tab into the career stats table,
+// and fetches the PerMode when it hasn't been loaded yet
+func (m *PlayerProfile) showCareerStats() tea.Cmd {
+	perMode := careerPerModes[m.careerModeIdx]
+	title := "CAREER STATS: " + careerViews[m.careerView] + " | " + perMode.label
 
-		return playerCareerStatsFetchedMsg{
-			seasonStats:  tableModel,
-			currentStats: currentStats,
-		}
+	career, ok := m.careerStats[perMode.mode]
+	if !ok {
+		m.tableNames[1] = title + " (loading...)"
+		return fetchPlayerCareerStatsCmd(m.playerID, perMode.mode)
+	}
+	m.tableNames[1] = title
+
+	rows := career.RegularSeason
+	switch m.careerView {
+	case 1:
+		rows = career.Playoffs
+	case 2:
+		rows = career.Career
+	}
+
+	headers := converters.SeasonTotalsHeaders()
+	if perMode.mode == nbaAPI.PerModePerGame {
+		m.tables[1] = buildTables(headers, types.ConvertToStringMatrix(rows), types.SeasonStats{})
+		return nil
+	}
+	totals := make([]types.SeasonTotals, len(rows))
+	for i, row := range rows {
+		totals[i] = types.SeasonTotals(row)
 	}
+	m.tables[1] = buildTables(headers, types.ConvertToStringMatrix(totals), types.SeasonTotals{})
+	return nil
 }
 
 func fetchPlayerGameLogCmd(playerID string) tea.Cmd {
@@ -138,18 +225,94 @@ func fetchPlayerGameLogCmd(playerID string) tea.Cmd {
 			return playerGameLogFetchedMsg{err: err}
 		}
 
-		entries, headers, err := converters.PopulateGameLog(rs)
+		entries, headers, err := converters.PopulateSeasonGameLog(rs)
 		if err != nil {
 			return playerGameLogFetchedMsg{err: err}
 		}
 
-		stringMatrix := types.ConvertToStringMatrix(entries)
+		lastGames := entries
+		if len(lastGames) > 5 {
+			lastGames = lastGames[:5]
+		}
+		stringMatrix := types.ConvertToStringMatrix(lastGames)
 		tableModel := buildTables(headers, stringMatrix, types.GameLogEntry{})
 
-		return playerGameLogFetchedMsg{gameLog: tableModel}
+		return playerGameLogFetchedMsg{gameLog: tableModel, entries: entries}
 	}
 }
 
+// fetchPlayerAwardsCmd downloads the awards of a player, counted for the badges and listed in the awards tab
+func fetchPlayerAwardsCmd(playerID string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		if err := cl.FetchPlayerAwards(playerID); err != nil {
+			return playerAwardsFetchedMsg{err: err}
+		}
+		rs, err := cl.Loader.LoadPlayerAwards(playerID)
+		if err != nil {
+			return playerAwardsFetchedMsg{err: err}
+		}
+
+		awards, headers, err := converters.PopulatePlayerAwards(rs)
+		if err != nil {
+			return playerAwardsFetchedMsg{err: err}
+		}
+
+		tableModel := buildTables(headers, types.ConvertToStringMatrix(awards), types.PlayerAward{}).
+			WithPageSize(awardsPageSize).
+			WithFooterVisibility(len(awards) > awardsPageSize)
+		return playerAwardsFetchedMsg{
+			awards:  tableModel,
+			summary: converters.SummarizePlayerAwards(awards),
+			count:   len(awards),
+		}
+	}
+}
+
+// fetchPlayerSplitsCmd downloads the split dashboards of the current season and builds one table per split group
+func fetchPlayerSplitsCmd(playerID string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		season := cl.Dates.GetCurrentSeason()
+		if err := cl.FetchPlayerSplits(playerID, season); err != nil {
+			return playerSplitsFetchedMsg{err: err}
+		}
+
+		dashboards := make(map[string]types.ResponseSet)
+		for _, dashboard := range nbaAPI.PlayerDashboards {
+			rs, err := cl.Loader.LoadPlayerDashboard(playerID, dashboard, season)
+			if err != nil {
+				return playerSplitsFetchedMsg{err: err}
+			}
+			dashboards[dashboard] = rs
+		}
+
+		groups, headers, err := converters.PopulatePlayerSplits(dashboards)
+		if err != nil {
+			return playerSplitsFetchedMsg{err: err}
+		}
+
+		var tables []table.Model
+		var names []string
+		for _, group := range groups {
+			splitStrings := types.ConvertToStringMatrix(group.Splits)
+			tables = append(tables, buildTables(headers, splitStrings, types.PlayerSplit{}))
+			names = append(names, group.Name)
+		}
+		return playerSplitsFetchedMsg{tables: tables, names: names}
+	}
+}
+
+// showSplitGroup puts the split table of the given group into the splits tab
+func (m *PlayerProfile) showSplitGroup(idx int) {
+	if len(m.splitTables) == 0 {
+		return
+	}
+	m.splitIdx = (idx + len(m.splitTables)) % len(m.splitTables)
+	m.tables[2] = m.splitTables[m.splitIdx]
+	m.tableNames[2] = "SPLITS: " + m.splitNames[m.splitIdx]
+}
+
 func renderStatCard(label, value string, clr lipgloss.Color) string {
 	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Align(lipgloss.Center).Width(10)
 	valueStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("15")).Align(lipgloss.Center).Width(10)
@@ -158,6 +321,31 @@ func renderStatCard(label, value string, clr lipgloss.Color) string {
 	return cardStyle.Render(content)
 }
 
+// renderAwardBadges renders the headline awards of the player as badges, awards the player never won are left out
+func renderAwardBadges(s types.PlayerAwardSummary, clr lipgloss.Color) string {
+	badgeStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("15")).
+		BorderStyle(lipgloss.RoundedBorder()).BorderForeground(clr).Padding(0, 1)
+	badges := []struct {
+		count int
+		label string
+	}{
+		{s.MVP, "MVP"},
+		{s.FinalsMVP, "FINALS MVP"},
+		{s.Championships, "CHAMPION"},
+		{s.AllNBA, "ALL-NBA"},
+		{s.AllStar, "ALL-STAR"},
+		{s.PlayerOfTheWeek, "PLAYER OF THE WEEK"},
+	}
+
+	var rendered []string
+	for _, b := range badges {
+		if b.count > 0 {
+			rendered = append(rendered, badgeStyle.Render(fmt.Sprintf("%d× %s", b.count, b.label)))
+		}
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Center, rendered...)
+}
+
 func (m *PlayerProfile) renderTopSection() string {
 	if m.bio == nil {
 		return ""
@@ -189,6 +377,12 @@ func (m *PlayerProfile) renderTopSection() string {
 		sections = append(sections, cards, "")
 	}
 
+	if m.awards != nil {
+		if badges := renderAwardBadges(*m.awards, m.teamColor); badges != "" {
+			sections = append(sections, badges, "")
+		}
+	}
+
 	sections = append(sections, bioLine)
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
@@ -205,6 +399,23 @@ func (m *PlayerProfile) assembleSections() {
 		sections = append(sections, topSection, "\n")
 	}
 
+	if m.showShotChart {
+		sections = append(sections,
+			centered.Render(activeHeaderStyle.Render(" << SHOT CHART >> ")),
+			centered.Render(m.shotChart.View()))
+		m.mainPort.SetContent(lipgloss.JoinVertical(lipgloss.Left, sections...))
+		return
+	}
+
+	if m.showTrend {
+		m.trend.SetWidth(m.mainPort.Width - 4)
+		sections = append(sections,
+			centered.Render(activeHeaderStyle.Render(" << TRENDS >> ")),
+			centered.Render(m.trend.View()))
+		m.mainPort.SetContent(lipgloss.JoinVertical(lipgloss.Left, sections...))
+		return
+	}
+
 	for i := 0; i < len(m.tables); i++ {
 		if i == m.activeTableIndex {
 			m.tables[i] = m.tables[i].Focused(true)
@@ -243,6 +454,7 @@ func (m *PlayerProfile) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.bio = &msg.bio
 		teamName := msg.bio.TeamName
 		m.teamColor = TeamColor(teamName)
+		m.trend.SetColor(m.teamColor)
 		m.mainPort.Style = TeamViewPortStyle(m.teamColor)
 		m.assembleSections()
 		return m, nil
@@ -250,10 +462,19 @@ func (m *PlayerProfile) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case playerCareerStatsFetchedMsg:
 		if msg.err != nil {
 			log.Println("could not load career stats:", msg.err)
+			if mode := careerPerModes[m.careerModeIdx]; msg.perMode == mode.mode {
+				m.tableNames[1] = "CAREER STATS: " + mode.label + " not available"
+				m.assembleSections()
+			}
 			return m, nil
 		}
-		m.currentStats = msg.currentStats
-		m.tables[1] = msg.seasonStats
+		m.careerStats[msg.perMode] = msg.career
+		if msg.perMode == nbaAPI.PerModePerGame && len(msg.career.RegularSeason) > 0 {
+			m.currentStats = &msg.career.RegularSeason[0]
+		}
+		if msg.perMode == careerPerModes[m.careerModeIdx].mode {
+			m.showCareerStats()
+		}
 		m.assembleSections()
 		return m, nil
 
@@ -263,14 +484,113 @@ func (m *PlayerProfile) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		m.tables[0] = msg.gameLog
+		m.shotChart.SetGames(msg.entries)
+		m.trend.SetGames(msg.entries)
+		m.assembleSections()
+		return m, nil
+
+	case playerAwardsFetchedMsg:
+		if msg.err != nil {
+			log.Println("could not load player awards:", msg.err)
+			m.tableNames[3] = "AWARDS: not available"
+			m.assembleSections()
+			return m, nil
+		}
+		m.awards = &msg.summary
+		m.tables[3] = msg.awards
+		m.tableNames[3] = fmt.Sprintf("AWARDS (%d)", msg.count)
+		m.assembleSections()
+		return m, nil
+
+	case playerSplitsFetchedMsg:
+		if msg.err != nil {
+			log.Println("could not load player splits:", msg.err)
+			m.tableNames[2] = "SPLITS: not available"
+			m.assembleSections()
+			return m, nil
+		}
+		m.splitTables = msg.tables
+		m.splitNames = msg.names
+		m.showSplitGroup(0)
 		m.assembleSections()
 		return m, nil
 
+	case shotChartFetchedMsg, seasonChangedMsg:
+		var cmd tea.Cmd
+		m.shotChart, cmd = m.shotChart.Update(msg)
+		m.assembleSections()
+		return m, cmd
+
 	case tea.KeyMsg:
 		switch {
+		case key.Matches(msg, Keymap.Shots):
+			m.showShotChart = !m.showShotChart
+			m.showTrend = false
+			var cmd tea.Cmd
+			if m.showShotChart && !m.shotChartLoaded {
+				m.shotChartLoaded = true
+				cmd = m.shotChart.Fetch()
+			}
+			m.assembleSections()
+			return m, cmd
+		case key.Matches(msg, Keymap.Favorite):
+			// the profiles of favorite players are kept fresh by the daemon
+			m.favorites.TogglePlayer(m.playerID)
+			if err := m.favoritesStore.Save(m.favorites); err != nil {
+				log.Println("could not save favorites:", err)
+			}
+			return m, nil
+		case m.showShotChart && !key.Matches(msg, Keymap.Back) && !key.Matches(msg, Keymap.Quit) &&
+			!key.Matches(msg, Keymap.Up) && !key.Matches(msg, Keymap.Down):
+			var cmd tea.Cmd
+			m.shotChart, cmd = m.shotChart.Update(msg)
+			m.assembleSections()
+			return m, cmd
+		case key.Matches(msg, Keymap.Trend):
+			m.showTrend = !m.showTrend
+			m.showShotChart = false
+			m.assembleSections()
+			return m, nil
+		case m.showTrend && (key.Matches(msg, Keymap.Left) || key.Matches(msg, Keymap.Right)):
+			var cmd tea.Cmd
+			m.trend, cmd = m.trend.Update(msg)
+			m.assembleSections()
+			return m, cmd
 		case key.Matches(msg, Keymap.Tab):
 			m.activeTableIndex = (m.activeTableIndex + 1) % len(m.tables)
+			var cmd tea.Cmd
+			if m.activeTableIndex == 2 && !m.splitsLoaded {
+				m.splitsLoaded = true
+				m.tableNames[2] = "SPLITS: loading..."
+				cmd = fetchPlayerSplitsCmd(m.playerID)
+			}
+			m.assembleSections()
+			if cmd != nil {
+				return m, cmd
+			}
+		case m.activeTableIndex == 1 && key.Matches(msg, Keymap.Right):
+			m.careerView = (m.careerView + 1) % len(careerViews)
+			cmd := m.showCareerStats()
+			m.assembleSections()
+			return m, cmd
+		case m.activeTableIndex == 1 && key.Matches(msg, Keymap.Left):
+			m.careerView = (m.careerView + len(careerViews) - 1) % len(careerViews)
+			cmd := m.showCareerStats()
+			m.assembleSections()
+			return m, cmd
+		case m.activeTableIndex == 1 && key.Matches(msg, Keymap.Mode):
+			m.careerModeIdx = (m.careerModeIdx + 1) % len(careerPerModes)
+			cmd := m.showCareerStats()
 			m.assembleSections()
+			return m, cmd
+		case m.activeTableIndex == 2 && key.Matches(msg, Keymap.Right):
+			m.showSplitGroup(m.splitIdx + 1)
+			m.assembleSections()
+			return m, nil
+		case m.activeTableIndex == 2 && key.Matches(msg, Keymap.Left):
+			m.showSplitGroup(m.splitIdx - 1)
+			m.assembleSections()
+			return m, nil
 		case key.Matches(msg, Keymap.Back):
 			switch m.backView {
 			case "boxscore":
@@ -279,6 +599,18 @@ func (m *PlayerProfile) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "leagueLeaders":
 				ll, cmd, _ := NewLeagueLeaders(WindowSize)
 				return ll, cmd
+			case "allTimeLeaders":
+				at, cmd, _ := NewAllTimeLeaders(WindowSize)
+				return at, cmd
+			case "draftHistory":
+				// the source date of a drafted player is the year of the draft
+				year, _ := strconv.Atoi(m.sourceDate)
+				dh, cmd, err := NewDraftHistory(year, WindowSize)
+				if err != nil {
+					log.Println(err)
+					return InitMenu()
+				}
+				return dh, cmd
 			default:
 				return InitMenu()
 			}
@@ -310,7 +642,26 @@ func (m *PlayerProfile) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *PlayerProfile) helpView() string {
-	return HelpStyle("\n" + HelpFooter() + "\n")
+	help := HelpFooter() + " | " + Keymap.Shots.Help().Key + ": " + Keymap.Shots.Help().Desc +
+		" | " + Keymap.Trend.Help().Key + ": " + Keymap.Trend.Help().Desc + " | " + Keymap.Favorite.Help().Key + ": "
+	if m.favorites.HasPlayer(m.playerID) {
+		help += "★ unfavorite"
+	} else {
+		help += Keymap.Favorite.Help().Desc
+	}
+	switch {
+	case m.showShotChart:
+		help += "\n" + m.shotChart.HelpView()
+	case m.showTrend:
+		help += "\n" + m.trend.HelpView()
+	case m.activeTableIndex == 1:
+		help += "\n<- ->: regular season/playoffs/career | " + Keymap.Mode.Help().Key + ": per game/totals/per 36"
+	case m.activeTableIndex == 2 && len(m.splitTables) > 0:
+		help += "\n<- ->: split"
+	case m.activeTableIndex == 3:
+		help += "\n<- ->: page"
+	}
+	return HelpStyle("\n" + help + "\n")
 }
 
 func (m *PlayerProfile) View() string {
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/pathManager"
+)
+
+// favoritesTestPaths puts favorites.json in a temporary directory
+type favoritesTestPaths struct {
+	pathManager.PathManager
+	dir string
+}
+
+func (p favoritesTestPaths) GetFullPath(fileType, id string) string {
+	return filepath.Join(p.dir, fileType+".json")
+}
+
+func TestPlayerProfile_ToggleFavorite(t *testing.T) {
+	store := filesystemops.NewFavoritesStore(&filesystemops.DefaultFsHandler{}, favoritesTestPaths{dir: t.TempDir()})
+	m := &PlayerProfile{playerID: "1628369", favoritesStore: store}
+	star := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")}
+
+	model, _ := m.Update(star)
+	m = model.(*PlayerProfile)
+	saved, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !m.favorites.HasPlayer("1628369") || !saved.HasPlayer("1628369") {
+		t.Fatalf("expected the player to be saved as a favorite, got %+v", saved)
+	}
+
+	model, _ = m.Update(star)
+	m = model.(*PlayerProfile)
+	if saved, _ = store.Load(); saved.HasPlayer("1628369") || m.favorites.HasPlayer("1628369") {
+		t.Errorf("expected the player to be unfavorited, got %+v", saved)
+	}
+}
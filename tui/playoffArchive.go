@@ -0,0 +1,196 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// PlayoffArchive lists the champions and Finals results of past seasons. Seasons are loaded one after another,
+// newest first, so the list fills up while older seasons are still being fetched.
+type PlayoffArchive struct {
+	seasons  table.Model
+	entries  []types.PlayoffArchiveEntry
+	pending  []string
+	loading  bool
+	width    int
+	height   int
+	quitting bool
+}
+
+type archiveSeasonFetchedMsg struct {
+	entry types.PlayoffArchiveEntry
+	err   error
+}
+
+// NewPlayoffArchive instantiates the archive view with all completed seasons since minSeasonYear
+func NewPlayoffArchive(size tea.WindowSizeMsg) (*PlayoffArchive, tea.Cmd, error) {
+	currentYear := parseSeasonYear(nbaAPI.NewClient().Dates.GetCurrentSeason())
+	var seasons []string
+	for year := currentYear - 1; year >= minSeasonYear; year-- {
+		seasons = append(seasons, formatSeasonFromYear(year))
+	}
+	if len(seasons) == 0 {
+		return nil, nil, fmt.Errorf("no past seasons to show")
+	}
+
+	m := &PlayoffArchive{
+		pending: seasons[1:],
+		loading: true,
+		width:   size.Width,
+		height:  size.Height,
+	}
+	m.seasons = m.buildTable()
+	return m, fetchArchiveSeasonCmd(seasons[0]), nil
+}
+
+// archiveRequestDelay spaces out the requests of seasons which are not cached yet, a first visit of the archive
+// would otherwise send stats.nba.com two requests per season back to back. A failed season is retried up to
+// archiveMaxAttempts times, the pause doubling every time.
+var archiveRequestDelay = 2 * time.Second
+
+const archiveMaxAttempts = 3
+
+// fetchArchiveSeasonCmd fetches the playoff series games and the schedule (for the results) of a single season.
+// Past seasons are cached permanently, so only the first visit of the archive hits the API.
+func fetchArchiveSeasonCmd(season string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		entry := types.PlayoffArchiveEntry{Season: season}
+		cached := cl.FileSystem.FileExists(cl.Paths.GetFullPath("playoffSeriesGames", season)) &&
+			cl.FileSystem.FileExists(cl.Paths.GetFullPath("seasonSchedule", season))
+
+		var err error
+		wait := archiveRequestDelay
+		for attempt := 1; attempt <= archiveMaxAttempts; attempt++ {
+			if !cached {
+				time.Sleep(wait)
+			}
+			if err = fetchArchiveSeason(cl, season); err == nil {
+				break
+			}
+			wait *= 2
+		}
+		if err != nil {
+			return archiveSeasonFetchedMsg{entry: entry, err: err}
+		}
+
+		rs, err := cl.Loader.LoadCommonPlayoffSeries(season)
+		if err != nil {
+			return archiveSeasonFetchedMsg{entry: entry, err: err}
+		}
+		scheduleRs, err := cl.Loader.LoadSeasonSchedule(season)
+		if err != nil {
+			return archiveSeasonFetchedMsg{entry: entry, err: err}
+		}
+		schedule, err := converters.PopulateSeasonSchedule(scheduleRs)
+		if err != nil {
+			return archiveSeasonFetchedMsg{entry: entry, err: err}
+		}
+		entry, err = converters.PopulatePlayoffArchiveEntry(rs, schedule, season)
+		return archiveSeasonFetchedMsg{entry: entry, err: err}
+	}
+}
+
+// fetchArchiveSeason caches the playoff series games and the schedule of a season
+func fetchArchiveSeason(cl *nbaAPI.Client, season string) error {
+	if err := cl.FetchCommonPlayoffSeries(season); err != nil {
+		return err
+	}
+	return cl.FetchSeasonSchedule(season)
+}
+
+func (m PlayoffArchive) buildTable() table.Model {
+	headers := converters.PlayoffArchiveHeaders()
+	t := buildTables(headers, types.ConvertToStringMatrix(m.entries), types.PlayoffArchiveEntry{})
+	return t.Focused(true).
+		WithPageSize(calculatePageSize(m.height, 1)).
+		WithFooterVisibility(false)
+}
+
+func (m PlayoffArchive) Init() tea.Cmd { return nil }
+
+func (m PlayoffArchive) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case archiveSeasonFetchedMsg:
+		entry := msg.entry
+		if msg.err != nil {
+			log.Printf("playoff archive: season %s unavailable: %v", entry.Season, msg.err)
+			entry = types.PlayoffArchiveEntry{Season: entry.Season, Champion: "n/a", FinalsResult: "-",
+				RunnerUp: "-", FinalsMVP: "-", EastChampion: "-", WestChampion: "-"}
+		}
+		m.entries = append(m.entries, entry)
+		highlighted := m.seasons.GetHighlightedRowIndex()
+		m.seasons = m.buildTable().WithHighlightedRow(highlighted)
+
+		if len(m.pending) == 0 {
+			m.loading = false
+			return m, nil
+		}
+		next := m.pending[0]
+		m.pending = m.pending[1:]
+		return m, fetchArchiveSeasonCmd(next)
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			pb, cmd, _ := NewPlayoffBracket(nbaAPI.NewClient().Dates.GetCurrentSeason(), 0, WindowSize)
+			return pb, cmd
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, Keymap.Enter):
+			if len(m.entries) == 0 {
+				return m, nil
+			}
+			season, ok := m.seasons.HighlightedRow().Data["SEASON"].(string)
+			if !ok {
+				return m, nil
+			}
+			// open the bracket on the Finals
+			pb, cmd, err := NewPlayoffBracket(season, 7, WindowSize)
+			if err != nil {
+				log.Println(err)
+				return m, nil
+			}
+			return pb, cmd
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.seasons = m.seasons.WithPageSize(calculatePageSize(msg.Height, 1))
+	}
+
+	m.seasons, cmd = m.seasons.Update(msg)
+	return m, cmd
+}
+
+func (m PlayoffArchive) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	status := fmt.Sprintf("%d seasons loaded", len(m.entries))
+	if m.loading {
+		status = fmt.Sprintf("Loading... %d seasons loaded, %d to go", len(m.entries), len(m.pending)+1)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Bold(true).Render("PLAYOFF ARCHIVE"),
+		m.seasons.View(),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(status),
+		HelpStyle(HelpFooter()+" | "+Keymap.Enter.Help().Key+": open bracket"),
+	)
+	return DocStyle.Render(content)
+}
@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestPlayoffArchive_LoadsSeasonsOneByOne(t *testing.T) {
+	pa, cmd, err := NewPlayoffArchive(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if err != nil {
+		t.Fatalf("NewPlayoffArchive() error: %v", err)
+	}
+	if cmd == nil {
+		t.Fatal("NewPlayoffArchive() returned nil cmd")
+	}
+	total := len(pa.pending) + 1
+
+	model, next := pa.Update(archiveSeasonFetchedMsg{entry: types.PlayoffArchiveEntry{Season: "2023-24", Champion: "BOS"}})
+	loaded := model.(PlayoffArchive)
+	if len(loaded.entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(loaded.entries))
+	}
+	if len(loaded.pending) != total-2 {
+		t.Errorf("pending = %d, want %d", len(loaded.pending), total-2)
+	}
+	if total > 1 && next == nil {
+		t.Error("expected the next season to be fetched")
+	}
+
+	model, _ = loaded.Update(archiveSeasonFetchedMsg{entry: types.PlayoffArchiveEntry{Season: "2022-23"}, err: errors.New("offline")})
+	failed := model.(PlayoffArchive)
+	if got := failed.entries[1].Champion; got != "n/a" {
+		t.Errorf("unavailable season champion = %q, want n/a", got)
+	}
+}
+
+func TestPlayoffArchive_StopsWhenAllSeasonsLoaded(t *testing.T) {
+	pa, _, _ := NewPlayoffArchive(tea.WindowSizeMsg{Width: 120, Height: 40})
+	pa.pending = nil
+
+	model, cmd := pa.Update(archiveSeasonFetchedMsg{entry: types.PlayoffArchiveEntry{Season: "2000-01"}})
+	if cmd != nil {
+		t.Error("expected no further fetch after the last season")
+	}
+	if model.(PlayoffArchive).loading {
+		t.Error("archive still loading after the last season")
+	}
+}
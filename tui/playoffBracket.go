@@ -12,27 +12,33 @@ import (
 )
 
 // Column layout (left to right):
-// 0=West R1 (4 series), 1=West Semis (2), 2=West Finals (1),
-// 3=Finals (1), 4=East Finals (1), 5=East Semis (2), 6=East R1 (4)
-var colSeriesCount = [7]int{4, 2, 1, 1, 1, 2, 4}
+// 0=West Play-In (3 games), 1=West R1 (4 series), 2=West Semis (2), 3=West Finals (1),
+// 4=Finals (1), 5=East Finals (1), 6=East Semis (2), 7=East R1 (4), 8=East Play-In (3)
+// The play-in columns are only reachable when the season had a play-in tournament.
+var colSeriesCount = [9]int{3, 4, 2, 1, 1, 1, 2, 4, 3}
 
-// cursorIndexForColRow maps (column, row) to a series index in PlayoffBracket.Series
+// cursorIndexForColRow maps (column, row) to a series index in PlayoffBracket.Series.
+// Play-in games continue after the 15 series: 15-17 East, 18-20 West (index into PlayoffBracket.PlayIn + 15)
 func cursorIndexForColRow(col, row int) int {
 	switch col {
 	case 0:
-		return 11 + row // West R1
+		return 18 + row // West Play-In
 	case 1:
-		return 9 + row // West Semis
+		return 11 + row // West R1
 	case 2:
-		return 8 // West Finals
+		return 9 + row // West Semis
 	case 3:
-		return 7 // NBA Finals
+		return 8 // West Finals
 	case 4:
-		return 6 // East Finals
+		return 7 // NBA Finals
 	case 5:
-		return 4 + row // East Semis
+		return 6 // East Finals
 	case 6:
+		return 4 + row // East Semis
+	case 7:
 		return row // East R1
+	case 8:
+		return 15 + row // East Play-In
 	}
 	return 0
 }
@@ -41,20 +47,32 @@ func cursorIndexForColRow(col, row int) int {
 func colRowForIndex(idx int) (col, row int) {
 	switch {
 	case idx <= 3: // East R1
-		return 6, idx
+		return 7, idx
 	case idx <= 5: // East Semis
-		return 5, idx - 4
+		return 6, idx - 4
 	case idx == 6: // East Finals
-		return 4, 0
+		return 5, 0
 	case idx == 7: // NBA Finals
-		return 3, 0
+		return 4, 0
 	case idx == 8: // West Finals
-		return 2, 0
+		return 3, 0
 	case idx <= 10: // West Semis
-		return 1, idx - 9
-	default: // West R1
-		return 0, idx - 11
+		return 2, idx - 9
+	case idx <= 14: // West R1
+		return 1, idx - 11
+	case idx <= 17: // East Play-In
+		return 8, idx - 15
+	default: // West Play-In
+		return 0, idx - 18
+	}
+}
+
+// colBounds returns the first and last column the cursor can move to
+func (m PlayoffBracket) colBounds() (int, int) {
+	if len(m.bracket.PlayIn) == 0 {
+		return 1, 7
 	}
+	return 0, 8
 }
 
 type PlayoffBracket struct {
@@ -96,31 +114,68 @@ func NewPlayoffBracket(season string, restoreCursorIdx int, size tea.WindowSizeM
 
 func fetchPlayoffBracketCmd(season string) tea.Cmd {
 	return func() tea.Msg {
-		cl := nbaAPI.NewClient()
-
-		if err := cl.FetchCommonPlayoffSeries(season); err != nil {
-			log.Printf("fetchPlayoffBracket: FetchCommonPlayoffSeries(%s) failed: %v", season, err)
-		} else if rs, err := cl.Loader.LoadCommonPlayoffSeries(season); err != nil {
-			log.Printf("fetchPlayoffBracket: LoadCommonPlayoffSeries(%s) failed: %v", season, err)
-		} else if bracket, err := converters.PopulatePlayoffBracket(rs, season); err != nil {
-			log.Printf("fetchPlayoffBracket: PopulatePlayoffBracket(%s) failed: %v", season, err)
-		} else {
-			return bracketFetchedMsg{bracket: bracket}
-		}
+		bracket, err := loadPlayoffBracket(season)
+		return bracketFetchedMsg{bracket: bracket, err: err}
+	}
+}
 
-		// Fallback: project bracket from current standings.
-		log.Printf("fetchPlayoffBracket: using standings projection for %s", season)
-		rs, err := cl.Loader.LoadSeasonStandings()
-		if err != nil {
-			return bracketFetchedMsg{err: err}
-		}
-		teams, _, err := converters.PopulateTeamStats(rs)
-		if err != nil {
-			return bracketFetchedMsg{err: err}
-		}
-		east, west := teams.SplitStandingsPerConference()
-		return bracketFetchedMsg{bracket: converters.ProjectedBracketFromStandings(east, west, season)}
+// loadPlayoffBracket builds the bracket of a season from the playoff series data. Before the playoffs start,
+// the bracket is projected from the (simulated) standings instead.
+func loadPlayoffBracket(season string) (types.PlayoffBracket, error) {
+	cl := nbaAPI.NewClient()
+
+	if err := cl.FetchCommonPlayoffSeries(season); err != nil {
+		log.Printf("fetchPlayoffBracket: FetchCommonPlayoffSeries(%s) failed: %v", season, err)
+	} else if rs, err := cl.Loader.LoadCommonPlayoffSeries(season); err != nil {
+		log.Printf("fetchPlayoffBracket: LoadCommonPlayoffSeries(%s) failed: %v", season, err)
+	} else if bracket, err := converters.PopulatePlayoffBracket(rs, season); err != nil {
+		log.Printf("fetchPlayoffBracket: PopulatePlayoffBracket(%s) failed: %v", season, err)
+	} else {
+		return withPlayIn(cl, bracket, season), nil
+	}
+
+	// Fallback: project bracket from the simulated end of season standings.
+	log.Printf("fetchPlayoffBracket: using standings projection for %s", season)
+	if teams, odds, err := loadPlayoffPicture(season); err == nil {
+		east, west := converters.ApplyProjectedSeeding(teams, odds).SplitStandingsPerConference()
+		bracket := converters.ProjectedBracketFromStandings(east, west, season)
+		return withPlayIn(cl, bracket, season), nil
+	} else {
+		log.Printf("fetchPlayoffBracket: simulation failed, using current standings: %v", err)
+	}
+
+	// Fallback: project bracket from current standings.
+	rs, err := cl.Loader.LoadSeasonStandings()
+	if err != nil {
+		return types.PlayoffBracket{}, err
 	}
+	teams, _, err := converters.PopulateTeamStats(rs)
+	if err != nil {
+		return types.PlayoffBracket{}, err
+	}
+	east, west := teams.SplitStandingsPerConference()
+	bracket := converters.ProjectedBracketFromStandings(east, west, season)
+	return withPlayIn(cl, bracket, season), nil
+}
+
+// withPlayIn adds the play-in games of the season schedule to the bracket. The bracket is returned
+// unchanged when the schedule can't be loaded.
+func withPlayIn(cl *nbaAPI.Client, bracket types.PlayoffBracket, season string) types.PlayoffBracket {
+	if err := cl.FetchSeasonSchedule(season); err != nil {
+		log.Printf("fetchPlayoffBracket: FetchSeasonSchedule(%s) failed: %v", season, err)
+		return bracket
+	}
+	rs, err := cl.Loader.LoadSeasonSchedule(season)
+	if err != nil {
+		log.Printf("fetchPlayoffBracket: LoadSeasonSchedule(%s) failed: %v", season, err)
+		return bracket
+	}
+	schedule, err := converters.PopulateSeasonSchedule(rs)
+	if err != nil {
+		log.Printf("fetchPlayoffBracket: PopulateSeasonSchedule(%s) failed: %v", season, err)
+		return bracket
+	}
+	return converters.ApplyPlayIn(bracket, converters.PopulatePlayInGames(schedule))
 }
 
 func (m PlayoffBracket) Init() tea.Cmd { return nil }
@@ -134,12 +189,18 @@ func (m PlayoffBracket) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		m.bracket = msg.bracket
+		// the restored cursor may point at a play-in game of a season without a play-in
+		if minCol, maxCol := m.colBounds(); m.cursorCol < minCol {
+			m.cursorCol = minCol
+		} else if m.cursorCol > maxCol {
+			m.cursorCol = maxCol
+		}
 		return m, nil
 
 	case seasonChangedMsg:
 		m.season = msg.season
 		m.loading = true
-		m.cursorCol, m.cursorRow = 0, 0
+		m.cursorCol, m.cursorRow = 1, 0
 		return m, fetchPlayoffBracketCmd(msg.season)
 
 	case tea.KeyMsg:
@@ -152,6 +213,20 @@ func (m PlayoffBracket) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, Keymap.Quit):
 			m.quitting = true
 			return m, tea.Quit
+		case key.Matches(msg, Keymap.Challenge):
+			bc, cmd, err := NewBracketChallenge(m.season, WindowSize)
+			if err != nil {
+				log.Println(err)
+				return m, nil
+			}
+			return bc, cmd
+		case key.Matches(msg, Keymap.Archive):
+			pa, cmd, err := NewPlayoffArchive(WindowSize)
+			if err != nil {
+				log.Println(err)
+				return m, nil
+			}
+			return pa, cmd
 		case key.Matches(msg, Keymap.Tab):
 			if m.seasonSelector.focused {
 				m.seasonSelector.Blur()
@@ -164,7 +239,7 @@ func (m PlayoffBracket) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.seasonSelector, ssCmd = m.seasonSelector.Update(msg)
 				return m, ssCmd
 			}
-			if m.cursorCol > 0 {
+			if minCol, _ := m.colBounds(); m.cursorCol > minCol {
 				m.cursorCol--
 				maxRow := colSeriesCount[m.cursorCol] - 1
 				if m.cursorRow > maxRow {
@@ -177,7 +252,7 @@ func (m PlayoffBracket) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.seasonSelector, ssCmd = m.seasonSelector.Update(msg)
 				return m, ssCmd
 			}
-			if m.cursorCol < 6 {
+			if _, maxCol := m.colBounds(); m.cursorCol < maxCol {
 				m.cursorCol++
 				maxRow := colSeriesCount[m.cursorCol] - 1
 				if m.cursorRow > maxRow {
@@ -194,7 +269,8 @@ func (m PlayoffBracket) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case key.Matches(msg, Keymap.Enter):
 			idx := cursorIndexForColRow(m.cursorCol, m.cursorRow)
-			if idx < len(m.bracket.Series) {
+			// play-in games are single games without a series view
+			if idx < len(m.bracket.Series) && idx < 15 {
 				series := m.bracket.Series[idx]
 				if series.Status != "pre" {
 					ps, cmd, err := NewPlayoffSeries(series, idx, m.season, WindowSize)
@@ -226,7 +302,7 @@ func (m PlayoffBracket) View() string {
 	}
 
 	cursorIdx := cursorIndexForColRow(m.cursorCol, m.cursorRow)
-	br := newBracketTableRenderer(m.bracket.Series, cursorIdx)
+	br := newBracketTableRenderer(m.bracket.Series, cursorIdx).withPlayIn(m.bracket.PlayIn)
 	bracket := br.Render()
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
@@ -234,7 +310,8 @@ func (m PlayoffBracket) View() string {
 		"",
 		bracket,
 		"",
-		HelpStyle(HelpFooter()),
+		HelpStyle(HelpFooter()+" | "+Keymap.Challenge.Help().Key+": "+Keymap.Challenge.Help().Desc+
+			" | "+Keymap.Archive.Help().Key+": "+Keymap.Archive.Help().Desc),
 	)
 	return DocStyle.Render(content)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
 )
 
 func TestPlayoffBracket_Init_IssuesCmd(t *testing.T) {
@@ -26,17 +27,52 @@ func TestPlayoffBracket_CursorNavigation(t *testing.T) {
 	loaded, _ := pb.Update(bracketFetchedMsg{})
 	ready := loaded.(PlayoffBracket)
 
-	// Move left from East R1 (col 6) to East Semis (col 5)
+	// Move left from East R1 (col 7) to East Semis (col 6)
 	model, _ := ready.Update(tea.KeyMsg{Type: tea.KeyLeft})
 	updated := model.(PlayoffBracket)
-	if updated.cursorCol != 5 {
-		t.Errorf("after Left from col 6, cursorCol = %d, want 5", updated.cursorCol)
+	if updated.cursorCol != 6 {
+		t.Errorf("after Left from col 7, cursorCol = %d, want 6", updated.cursorCol)
 	}
 
 	// Move right back
 	model, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRight})
 	back := model.(PlayoffBracket)
-	if back.cursorCol != 6 {
-		t.Errorf("after Right from col 5, cursorCol = %d, want 6", back.cursorCol)
+	if back.cursorCol != 7 {
+		t.Errorf("after Right from col 6, cursorCol = %d, want 7", back.cursorCol)
+	}
+
+	// Without play-in games East R1 is the last column
+	model, _ = back.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if col := model.(PlayoffBracket).cursorCol; col != 7 {
+		t.Errorf("after Right from col 7 without play-in, cursorCol = %d, want 7", col)
+	}
+}
+
+func TestPlayoffBracket_PlayInNavigation(t *testing.T) {
+	pb, _, _ := NewPlayoffBracket("2024-25", 0, tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	bracket := types.PlayoffBracket{
+		Series: make([]types.PlayoffSeries, 15),
+		PlayIn: make([]types.PlayoffSeries, 6),
+	}
+	loaded, _ := pb.Update(bracketFetchedMsg{bracket: bracket})
+	ready := loaded.(PlayoffBracket)
+
+	model, _ := ready.Update(tea.KeyMsg{Type: tea.KeyRight})
+	updated := model.(PlayoffBracket)
+	if updated.cursorCol != 8 {
+		t.Fatalf("after Right from col 7 with play-in, cursorCol = %d, want 8", updated.cursorCol)
+	}
+	if idx := cursorIndexForColRow(updated.cursorCol, updated.cursorRow); idx != 15 {
+		t.Errorf("East play-in cursor index = %d, want 15", idx)
+	}
+}
+
+func TestPlayoffBracket_ColRowRoundTrip(t *testing.T) {
+	for idx := 0; idx < 21; idx++ {
+		col, row := colRowForIndex(idx)
+		if got := cursorIndexForColRow(col, row); got != idx {
+			t.Errorf("cursorIndexForColRow(colRowForIndex(%d)) = %d", idx, got)
+		}
 	}
 }
@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+	"log"
+	"math/rand"
+)
+
+// simulationSeed keeps the simulation reproducible, so the picture and the projected bracket always agree
+const simulationSeed = 82
+
+// PlayoffPicture shows the simulated playoff odds of every team, per conference
+type PlayoffPicture struct {
+	eastTeams   table.Model
+	westTeams   table.Model
+	activeTable int
+	season      string
+	loading     bool
+	err         error
+	width       int
+	height      int
+	quitting    bool
+}
+
+type playoffPictureFetchedMsg struct {
+	err       error
+	eastTable table.Model
+	westTable table.Model
+}
+
+// NewPlayoffPicture instantiates the playoff picture view of the current season
+func NewPlayoffPicture(size tea.WindowSizeMsg) (*PlayoffPicture, tea.Cmd, error) {
+	season := nbaAPI.NewClient().Dates.GetCurrentSeason()
+	m := &PlayoffPicture{
+		season:  season,
+		loading: true,
+		width:   size.Width,
+		height:  size.Height,
+	}
+	return m, fetchPlayoffPictureCmd(season), nil
+}
+
+// loadPlayoffPicture loads the current standings, fetches the season schedule and runs the playoff simulation
+func loadPlayoffPicture(season string) (types.Teams, []types.PlayoffOdds, error) {
+	cl := nbaAPI.NewClient()
+
+	rs, err := cl.Loader.LoadSeasonStandings()
+	if err != nil {
+		return nil, nil, err
+	}
+	teams, _, err := converters.PopulateTeamStats(rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = cl.FetchSeasonSchedule(season); err != nil {
+		return nil, nil, fmt.Errorf("could not fetch season schedule: %w", err)
+	}
+	rs, err = cl.Loader.LoadSeasonSchedule(season)
+	if err != nil {
+		return nil, nil, err
+	}
+	schedule, err := converters.PopulateSeasonSchedule(rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rng := rand.New(rand.NewSource(simulationSeed))
+	odds := converters.SimulatePlayoffPicture(teams, schedule, converters.PlayoffSimulations, rng)
+	return teams, odds, nil
+}
+
+func fetchPlayoffPictureCmd(season string) tea.Cmd {
+	return func() tea.Msg {
+		_, odds, err := loadPlayoffPicture(season)
+		if err != nil {
+			return playoffPictureFetchedMsg{err: err}
+		}
+
+		var east, west []types.PlayoffOdds
+		for _, o := range odds {
+			switch o.Conference {
+			case "East":
+				east = append(east, o)
+			case "West":
+				west = append(west, o)
+			}
+		}
+
+		headers := converters.PlayoffOddsHeaders()
+		eastTable := buildTables(headers, types.ConvertToStringMatrix(east), types.PlayoffOdds{}).Focused(true)
+		westTable := buildTables(headers, types.ConvertToStringMatrix(west), types.PlayoffOdds{})
+
+		return playoffPictureFetchedMsg{eastTable: eastTable, westTable: westTable}
+	}
+}
+
+func (m PlayoffPicture) Init() tea.Cmd { return nil }
+
+func (m PlayoffPicture) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case playoffPictureFetchedMsg:
+		m.loading = false
+		if msg.err != nil {
+			log.Println("could not simulate playoff picture:", msg.err)
+			m.err = msg.err
+			return m, nil
+		}
+		pageSize := calculatePageSize(m.height, 2)
+		m.eastTeams = msg.eastTable.WithPageSize(pageSize).WithFooterVisibility(false)
+		m.westTeams = msg.westTable.WithPageSize(pageSize).WithFooterVisibility(false)
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			ss, cmd, _ := NewSeasonStandings(WindowSize)
+			return ss, cmd
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case m.loading:
+			return m, nil
+		case key.Matches(msg, Keymap.Tab):
+			m.activeTable = (m.activeTable + 1) % 2
+			m.eastTeams = m.eastTeams.Focused(m.activeTable == 0)
+			m.westTeams = m.westTeams.Focused(m.activeTable == 1)
+		case key.Matches(msg, Keymap.Enter):
+			pb, cmd, err := NewPlayoffBracket(m.season, 0, WindowSize)
+			if err != nil {
+				log.Println(err)
+				return m, nil
+			}
+			return pb, cmd
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		pageSize := calculatePageSize(msg.Height, 2)
+		m.eastTeams = m.eastTeams.WithPageSize(pageSize).WithFooterVisibility(false)
+		m.westTeams = m.westTeams.WithPageSize(pageSize).WithFooterVisibility(false)
+	}
+
+	m.eastTeams, cmd = m.eastTeams.Update(msg)
+	cmds = append(cmds, cmd)
+	m.westTeams, cmd = m.westTeams.Update(msg)
+	cmds = append(cmds, cmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m PlayoffPicture) helpView() string {
+	return HelpStyle(Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		Keymap.Tab.Help().Key + ": " + Keymap.Tab.Help().Desc + " | " +
+		Keymap.Enter.Help().Key + ": projected bracket")
+}
+
+func (m PlayoffPicture) View() string {
+	if m.quitting {
+		return ""
+	}
+	if m.loading {
+		return lipgloss.NewStyle().Width(m.width).Height(m.height).
+			Align(lipgloss.Center, lipgloss.Center).
+			Render(fmt.Sprintf("Simulating the rest of the %s season...", m.season))
+	}
+	if m.err != nil {
+		return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+			"Could not build the playoff picture: "+m.err.Error(),
+			m.helpView()))
+	}
+
+	titleStyle := lipgloss.NewStyle().Bold(true)
+	note := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).
+		Render(fmt.Sprintf("%d simulated seasons based on current win percentages", converters.PlayoffSimulations))
+
+	comboView := lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render("EAST"),
+		m.eastTeams.View(),
+		titleStyle.Render("WEST"),
+		m.westTeams.View(),
+		note,
+		m.helpView())
+	return DocStyle.Render(comboView)
+}
@@ -121,6 +121,40 @@ func (m SeasonStandings) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 				m.westTeams = m.westTeams.Focused(true)
 				m.focused = !m.focused
 			}
+		case key.Matches(msg, Keymap.Picture):
+			pp, cmd, err := NewPlayoffPicture(WindowSize)
+			if err != nil {
+				log.Println("could not load playoff picture:", err)
+				return m, nil
+			}
+			return pp, cmd
+		case key.Matches(msg, Keymap.History):
+			conference := "East"
+			if m.activeTable == 1 {
+				conference = "West"
+			}
+			sh, cmd, err := NewStandingsHistory(conference, WindowSize)
+			if err != nil {
+				log.Println("could not load standings history:", err)
+				return m, nil
+			}
+			return sh, cmd
+		case key.Matches(msg, Keymap.Splits):
+			if m.activeTable == 0 {
+				selectedRows = m.eastTeams.SelectedRows()
+			} else {
+				selectedRows = m.westTeams.SelectedRows()
+			}
+			if len(selectedRows) == 1 {
+				teamID := selectedRows[0].Data["TeamID"].(string)
+				sp, cmd, err := NewStandingsSplits(teamID, WindowSize)
+				if err != nil {
+					log.Println("could not load team splits:", err)
+					return m, nil
+				}
+				return sp, cmd
+			}
+			log.Println("Either 0 rows or more than 1 row were selected")
 		case key.Matches(msg, Keymap.Enter):
 			if m.activeTable == 0 {
 				selectedRows = m.eastTeams.SelectedRows()
@@ -172,7 +206,9 @@ func (m SeasonStandings) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 }
 
 func (m SeasonStandings) helpView() string {
-	return HelpStyle(HelpFooter())
+	return HelpStyle(HelpFooter() + " | " + Keymap.Splits.Help().Key + ": " + Keymap.Splits.Help().Desc + " | " +
+		Keymap.Picture.Help().Key + ": " + Keymap.Picture.Help().Desc + " | " +
+		Keymap.History.Help().Key + ": " + Keymap.History.Help().Desc)
 }
 
 func (m SeasonStandings) View() string {
@@ -0,0 +1,425 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// Half court in shotchartdetail coordinates (tenths of a foot, basket at 0,0): the baseline is at y -47.5
+// and half court at y 422.5, the sidelines at x -250 and 250
+const (
+	courtMinX, courtMaxX = -250.0, 250.0
+	courtMinY, courtMaxY = -47.5, 422.5
+
+	// the court is 50 cells wide and 24 high, which keeps the proportions of a 50x47ft half court
+	// in terminals whose cells are about twice as high as they are wide
+	shotChartCols = 50
+	shotChartRows = 24
+)
+
+type shotChartMode int
+
+const (
+	shotChartMakes shotChartMode = iota
+	shotChartHeat
+)
+
+// shotChartLastNGames are the "last N games" filters to cycle through, 0 meaning the whole season
+var shotChartLastNGames = []int{0, 5, 10, 20}
+
+var (
+	courtLineColor = lipgloss.Color("240")
+	shotMadeColor  = lipgloss.Color("#2ECC71")
+	shotMissColor  = lipgloss.Color("#E74C3C")
+	shotMixedColor = lipgloss.Color("#F1C40F")
+)
+
+// ShotChartPanel draws the shots of a player or team on a half court, either as makes and misses or as a heat map
+// of the FG% per zone compared to the league average. It is embedded in the profile views.
+type ShotChartPanel struct {
+	playerID       string
+	teamID         string
+	seasonSelector SeasonSelector
+	lastNIdx       int
+	games          []types.GameLogEntry
+	gamesSeason    string
+	gameIdx        int
+	mode           shotChartMode
+	chart          types.ShotChart
+	zones          table.Model
+	loading        bool
+	err            error
+}
+
+type shotChartFetchedMsg struct {
+	playerID string
+	teamID   string
+	filter   types.ShotChartFilter
+	chart    types.ShotChart
+	err      error
+}
+
+// NewShotChartPanel creates the shot chart of a player, or of a team when playerID is empty, for the given season
+func NewShotChartPanel(playerID, teamID, season string) ShotChartPanel {
+	ss := NewSeasonSelector(season)
+	ss.Focus()
+	return ShotChartPanel{
+		playerID:       playerID,
+		teamID:         teamID,
+		seasonSelector: ss,
+		gamesSeason:    season,
+		gameIdx:        -1,
+	}
+}
+
+// SetGames sets the games which can be picked as a filter, they belong to the season the panel was created with
+func (p *ShotChartPanel) SetGames(games []types.GameLogEntry) {
+	p.games = games
+	if p.gameIdx >= len(games) {
+		p.gameIdx = -1
+	}
+}
+
+func (p ShotChartPanel) filter() types.ShotChartFilter {
+	if p.gameIdx >= 0 {
+		return types.ShotChartFilter{Season: p.gamesSeason, GameID: p.games[p.gameIdx].GameID}
+	}
+	return types.ShotChartFilter{Season: p.seasonSelector.season, LastNGames: shotChartLastNGames[p.lastNIdx]}
+}
+
+// Fetch loads the shot chart for the current filters
+func (p *ShotChartPanel) Fetch() tea.Cmd {
+	p.loading = true
+	p.err = nil
+	return fetchShotChartCmd(p.playerID, p.teamID, p.filter())
+}
+
+func fetchShotChartCmd(playerID, teamID string, filter types.ShotChartFilter) tea.Cmd {
+	return func() tea.Msg {
+		msg := shotChartFetchedMsg{playerID: playerID, teamID: teamID, filter: filter}
+		cl := nbaAPI.NewClient()
+		if err := cl.FetchShotChart(playerID, teamID, filter); err != nil {
+			msg.err = err
+			return msg
+		}
+		rs, err := cl.Loader.LoadShotChart(filter.CacheID(playerID, teamID))
+		if err != nil {
+			msg.err = err
+			return msg
+		}
+		msg.chart, msg.err = converters.PopulateShotChart(rs)
+		return msg
+	}
+}
+
+func (p ShotChartPanel) Update(msg tea.Msg) (ShotChartPanel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case shotChartFetchedMsg:
+		// drop responses of other panels and of filters which have been changed in the meantime
+		if msg.playerID != p.playerID || msg.teamID != p.teamID || msg.filter != p.filter() {
+			return p, nil
+		}
+		p.loading = false
+		p.err = msg.err
+		p.chart = msg.chart
+		p.zones = buildTables(converters.ShotZoneHeaders(), types.ConvertToStringMatrix(msg.chart.Zones),
+			types.ShotZoneStats{}).WithFooterVisibility(false)
+		return p, nil
+
+	case seasonChangedMsg:
+		p.gameIdx = -1
+		return p, p.Fetch()
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Left), key.Matches(msg, Keymap.Right):
+			var cmd tea.Cmd
+			p.seasonSelector, cmd = p.seasonSelector.Update(msg)
+			return p, cmd
+		case key.Matches(msg, Keymap.LastN):
+			p.gameIdx = -1
+			p.lastNIdx = (p.lastNIdx + 1) % len(shotChartLastNGames)
+			return p, p.Fetch()
+		case key.Matches(msg, Keymap.Game):
+			if len(p.games) == 0 {
+				return p, nil
+			}
+			// cycles through the games of the log and back to no game filter
+			p.gameIdx++
+			if p.gameIdx >= len(p.games) {
+				p.gameIdx = -1
+			}
+			return p, p.Fetch()
+		case key.Matches(msg, Keymap.Mode):
+			p.mode = (p.mode + 1) % 2
+		}
+	}
+	return p, nil
+}
+
+// courtDot converts shot chart coordinates to a dot of the canvas
+func courtDot(c *brailleCanvas, x, y float64) (int, int) {
+	w, h := c.dotSize()
+	dx := (x - courtMinX) / (courtMaxX - courtMinX) * float64(w-1)
+	dy := (y - courtMinY) / (courtMaxY - courtMinY) * float64(h-1)
+	return int(math.Round(dx)), int(math.Round(dy))
+}
+
+// courtCell converts shot chart coordinates to a cell of the canvas
+func courtCell(c *brailleCanvas, x, y float64) (int, int) {
+	dx, dy := courtDot(c, x, y)
+	return dx / 2, dy / 4
+}
+
+func drawCourtLine(c *brailleCanvas, x0, y0, x1, y1 float64) {
+	ax, ay := courtDot(c, x0, y0)
+	bx, by := courtDot(c, x1, y1)
+	c.line(ax, ay, bx, by)
+}
+
+// drawCourtArc draws the part of a circle between two angles (radians, counterclockwise from the positive x axis)
+func drawCourtArc(c *brailleCanvas, cx, cy, r, from, to float64) {
+	const steps = 90
+	px, py := courtDot(c, cx+r*math.Cos(from), cy+r*math.Sin(from))
+	for i := 1; i <= steps; i++ {
+		t := from + (to-from)*float64(i)/steps
+		x, y := courtDot(c, cx+r*math.Cos(t), cy+r*math.Sin(t))
+		c.line(px, py, x, y)
+		px, py = x, y
+	}
+}
+
+// drawCourt draws the lines of a half court, with the basket at the top
+func drawCourt(c *brailleCanvas) {
+	// boundaries
+	drawCourtLine(c, courtMinX, courtMinY, courtMaxX, courtMinY)
+	drawCourtLine(c, courtMinX, courtMinY, courtMinX, courtMaxY)
+	drawCourtLine(c, courtMaxX, courtMinY, courtMaxX, courtMaxY)
+	drawCourtLine(c, courtMinX, courtMaxY, courtMaxX, courtMaxY)
+	// paint and free throw circle
+	drawCourtLine(c, -80, courtMinY, -80, 142.5)
+	drawCourtLine(c, 80, courtMinY, 80, 142.5)
+	drawCourtLine(c, -80, 142.5, 80, 142.5)
+	drawCourtArc(c, 0, 142.5, 60, 0, 2*math.Pi)
+	// backboard, rim and restricted area
+	drawCourtLine(c, -30, -7.5, 30, -7.5)
+	drawCourtArc(c, 0, 0, 7.5, 0, 2*math.Pi)
+	drawCourtArc(c, 0, 0, 40, 0, math.Pi)
+	// three point line: corners up to where they meet the arc
+	cornerY := math.Sqrt(237.5*237.5 - 220*220)
+	drawCourtLine(c, -220, courtMinY, -220, cornerY)
+	drawCourtLine(c, 220, courtMinY, 220, cornerY)
+	start := math.Acos(220 / 237.5)
+	drawCourtArc(c, 0, 0, 237.5, start, math.Pi-start)
+	// center circle
+	drawCourtArc(c, 0, courtMaxY, 60, math.Pi, 2*math.Pi)
+
+	for r := 0; r < c.rows; r++ {
+		for col := 0; col < c.cols; col++ {
+			c.cellColor(col, r, courtLineColor)
+		}
+	}
+}
+
+// courtZone returns the basic zone of a spot on the court. leftSign is the sign of LocX on the left corner,
+// which is taken from the shots themselves.
+func courtZone(x, y, leftSign float64) string {
+	switch {
+	case math.Hypot(x, y) <= 40:
+		return "Restricted Area"
+	case y <= 92.5 && math.Abs(x) >= 220:
+		if x*leftSign > 0 {
+			return "Left Corner 3"
+		}
+		return "Right Corner 3"
+	case math.Hypot(x, y) >= 237.5:
+		return "Above the Break 3"
+	case math.Abs(x) <= 80 && y <= 142.5:
+		return "In The Paint (Non-RA)"
+	}
+	return "Mid-Range"
+}
+
+// heatColor colors a zone by how its FG% compares to the league average
+func heatColor(zone types.ShotZoneStats) lipgloss.Color {
+	diff := zone.FGPct - zone.LeagueFGPct
+	switch {
+	case zone.LeagueFGPct == 0:
+		return lipgloss.Color("238")
+	case diff >= 0.05:
+		return lipgloss.Color("#1E8449")
+	case diff >= 0:
+		return lipgloss.Color("#52BE80")
+	case diff >= -0.05:
+		return lipgloss.Color("#E67E22")
+	}
+	return lipgloss.Color("#C0392B")
+}
+
+// zoneLabelSpots are the court spots where the FG% of a zone is written on the heat map
+var zoneLabelSpots = map[string][2]float64{
+	"Restricted Area":       {0, 20},
+	"In The Paint (Non-RA)": {0, 100},
+	"Mid-Range":             {0, 195},
+	"Left Corner 3":         {-235, 20},
+	"Right Corner 3":        {235, 20},
+	"Above the Break 3":     {0, 320},
+}
+
+// drawZoneHeat colors every cell of the court by the zone it belongs to and writes the zone FG% onto it
+func drawZoneHeat(c *brailleCanvas, zones []types.ShotZoneStats, leftSign float64) {
+	chart := types.ShotChart{Zones: zones}
+	w, h := c.dotSize()
+	for r := 0; r < c.rows; r++ {
+		for col := 0; col < c.cols; col++ {
+			// center of the cell in court coordinates
+			x := courtMinX + (float64(col*2)+1)/float64(w-1)*(courtMaxX-courtMinX)
+			y := courtMinY + (float64(r*4)+2)/float64(h-1)*(courtMaxY-courtMinY)
+			if zone, ok := chart.Zone(courtZone(x, y, leftSign)); ok {
+				c.cellBackground(col, r, heatColor(zone))
+			}
+		}
+	}
+
+	for _, zone := range zones {
+		spot, ok := zoneLabelSpots[zone.Zone]
+		if !ok {
+			continue
+		}
+		// the spots are placed for a left corner on the negative side
+		x := spot[0] * -leftSign
+		label := []rune(types.FloatToPercent(zone.FGPct))
+		col, r := courtCell(c, x, spot[1])
+		col -= len(label) / 2
+		if col < 0 {
+			col = 0
+		}
+		if col+len(label) > c.cols {
+			col = c.cols - len(label)
+		}
+		for i, ch := range label {
+			c.marker(col+i, r, ch, lipgloss.Color("15"))
+		}
+	}
+}
+
+// renderZoneCourt draws a half court heat map of the given zones
+func renderZoneCourt(zones []types.ShotZoneStats, leftSign float64) string {
+	c := newBrailleCanvas(shotChartCols, shotChartRows)
+	drawCourt(c)
+	drawZoneHeat(c, zones, leftSign)
+	return c.String()
+}
+
+func (p ShotChartPanel) renderCourt() string {
+	if p.mode == shotChartHeat {
+		leftSign := -1.0
+		for _, shot := range p.chart.Shots {
+			if shot.ZoneBasic == "Left Corner 3" && shot.LocX != 0 {
+				leftSign = math.Copysign(1, float64(shot.LocX))
+				break
+			}
+		}
+		return renderZoneCourt(p.chart.Zones, leftSign)
+	}
+
+	c := newBrailleCanvas(shotChartCols, shotChartRows)
+	drawCourt(c)
+	made := make(map[[2]int]int)
+	missed := make(map[[2]int]int)
+	for _, shot := range p.chart.Shots {
+		col, r := courtCell(c, float64(shot.LocX), float64(shot.LocY))
+		if shot.Made() {
+			made[[2]int{col, r}]++
+		} else {
+			missed[[2]int{col, r}]++
+		}
+	}
+	for cell := range missed {
+		if made[cell] == 0 {
+			c.marker(cell[0], cell[1], '×', shotMissColor)
+		}
+	}
+	for cell, n := range made {
+		if missed[cell] > 0 {
+			c.marker(cell[0], cell[1], '◐', shotMixedColor)
+		} else if n > 0 {
+			c.marker(cell[0], cell[1], '●', shotMadeColor)
+		}
+	}
+	return c.String()
+}
+
+func (p ShotChartPanel) filterLabel() string {
+	if p.gameIdx >= 0 {
+		game := p.games[p.gameIdx]
+		return fmt.Sprintf("%s %s (%s)", game.GameDate, game.Matchup, game.WL)
+	}
+	if n := shotChartLastNGames[p.lastNIdx]; n > 0 {
+		return fmt.Sprintf("last %d games", n)
+	}
+	return "full season"
+}
+
+func (p ShotChartPanel) legend() string {
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	if p.mode == shotChartHeat {
+		return dim.Render("FG% vs league: ") +
+			lipgloss.NewStyle().Background(lipgloss.Color("#1E8449")).Render(" +5% ") + " " +
+			lipgloss.NewStyle().Background(lipgloss.Color("#52BE80")).Render(" +0-5% ") + " " +
+			lipgloss.NewStyle().Background(lipgloss.Color("#E67E22")).Render(" -0-5% ") + " " +
+			lipgloss.NewStyle().Background(lipgloss.Color("#C0392B")).Render(" -5% ")
+	}
+	return lipgloss.NewStyle().Foreground(shotMadeColor).Render("● made") + "  " +
+		lipgloss.NewStyle().Foreground(shotMissColor).Render("× missed") + "  " +
+		lipgloss.NewStyle().Foreground(shotMixedColor).Render("◐ both")
+}
+
+// HelpView lists the keys of the panel
+func (p ShotChartPanel) HelpView() string {
+	return "←/→: season | " +
+		Keymap.LastN.Help().Key + ": " + Keymap.LastN.Help().Desc + " | " +
+		Keymap.Game.Help().Key + ": " + Keymap.Game.Help().Desc + " | " +
+		Keymap.Mode.Help().Key + ": " + Keymap.Mode.Help().Desc
+}
+
+// View renders the filters, the court and the zone table
+func (p ShotChartPanel) View() string {
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	header := lipgloss.JoinVertical(lipgloss.Center,
+		p.seasonSelector.View(),
+		dim.Render(p.filterLabel()))
+
+	switch {
+	case p.loading:
+		return lipgloss.JoinVertical(lipgloss.Center, header, "", "Loading shot chart...")
+	case p.err != nil:
+		return lipgloss.JoinVertical(lipgloss.Center, header, "", "Could not load the shot chart: "+p.err.Error())
+	case len(p.chart.Shots) == 0:
+		return lipgloss.JoinVertical(lipgloss.Center, header, "", "No shots for this selection")
+	}
+
+	made := 0
+	for _, shot := range p.chart.Shots {
+		if shot.Made() {
+			made++
+		}
+	}
+	summary := fmt.Sprintf("%d/%d FG (%s)", made, len(p.chart.Shots),
+		types.FloatToPercent(float64(made)/float64(len(p.chart.Shots))))
+
+	court := lipgloss.JoinVertical(lipgloss.Center, p.renderCourt(), p.legend())
+	side := lipgloss.JoinVertical(lipgloss.Left, lipgloss.NewStyle().Bold(true).Render(summary), "",
+		TableStyle.Render(p.zones.View()))
+
+	return lipgloss.JoinVertical(lipgloss.Center, header, "",
+		lipgloss.JoinHorizontal(lipgloss.Top, court, "   ", side))
+}
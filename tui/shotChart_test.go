@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func TestBrailleCanvas_SetDots(t *testing.T) {
+	c := newBrailleCanvas(2, 1)
+	c.set(0, 0)
+	c.set(1, 3)
+	c.set(10, 10) // outside, ignored
+
+	if got := c.dots[0][0]; got != 0x01|0x80 {
+		t.Errorf("dots = %#x, want %#x", got, 0x81)
+	}
+	if out := c.String(); !strings.HasPrefix(out, string(rune(0x2881))) {
+		t.Errorf("String() = %q, want braille U+2881 first", out)
+	}
+}
+
+func TestBrailleCanvas_MarkerReplacesDots(t *testing.T) {
+	c := newBrailleCanvas(1, 1)
+	c.line(0, 0, 1, 3)
+	c.marker(0, 0, '●', shotMadeColor)
+	if out := c.String(); !strings.Contains(out, "●") {
+		t.Errorf("String() = %q, want the marker", out)
+	}
+}
+
+func TestCourtZone(t *testing.T) {
+	tests := []struct {
+		x, y float64
+		want string
+	}{
+		{0, 10, "Restricted Area"},
+		{50, 100, "In The Paint (Non-RA)"},
+		{150, 100, "Mid-Range"},
+		{-230, 20, "Left Corner 3"},
+		{230, 20, "Right Corner 3"},
+		{0, 280, "Above the Break 3"},
+	}
+	for _, tt := range tests {
+		if got := courtZone(tt.x, tt.y, -1); got != tt.want {
+			t.Errorf("courtZone(%v, %v) = %s, want %s", tt.x, tt.y, got, tt.want)
+		}
+	}
+	if got := courtZone(230, 20, 1); got != "Left Corner 3" {
+		t.Errorf("courtZone with mirrored corners = %s, want Left Corner 3", got)
+	}
+}
+
+func TestShotChartPanel_Filters(t *testing.T) {
+	p := NewShotChartPanel("1628389", "", "2024-25")
+	p.SetGames([]types.GameLogEntry{{GameID: "0022400100"}, {GameID: "0022400090"}})
+
+	if f := p.filter(); f.Season != "2024-25" || f.LastNGames != 0 || f.GameID != "" {
+		t.Errorf("default filter = %+v, want full season", f)
+	}
+
+	p, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if cmd == nil || p.filter().LastNGames != 5 {
+		t.Errorf("after l: filter = %+v, want last 5 games and a fetch", p.filter())
+	}
+
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if f := p.filter(); f.GameID != "0022400100" {
+		t.Errorf("after g: filter = %+v, want the first game", f)
+	}
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if f := p.filter(); f.GameID != "" || f.LastNGames != 5 {
+		t.Errorf("after cycling through the games: filter = %+v, want last 5 games again", f)
+	}
+}
+
+func TestShotChartPanel_DropsStaleResults(t *testing.T) {
+	p := NewShotChartPanel("1628389", "", "2024-25")
+	p.Fetch()
+
+	stale := types.ShotChartFilter{Season: "2023-24"}
+	p, _ = p.Update(shotChartFetchedMsg{playerID: "1628389", filter: stale,
+		chart: types.ShotChart{Shots: []types.Shot{{MadeFlag: 1}}}})
+	if !p.loading || len(p.chart.Shots) != 0 {
+		t.Error("result of an outdated filter was applied")
+	}
+
+	p, _ = p.Update(shotChartFetchedMsg{playerID: "1628389", filter: p.filter(),
+		chart: types.ShotChart{Shots: []types.Shot{{MadeFlag: 1, ZoneBasic: "Restricted Area"}}}})
+	if p.loading || len(p.chart.Shots) != 1 {
+		t.Error("result of the current filter was not applied")
+	}
+	if view := p.View(); !strings.Contains(view, "1/1 FG") {
+		t.Errorf("View() does not show the FG summary:\n%s", view)
+	}
+}
+
+func TestTeamShotZonesPanel_Update(t *testing.T) {
+	p := NewTeamShotZonesPanel("1610612744", "2024-25")
+	p.Fetch()
+
+	p, _ = p.Update(teamShotZonesFetchedMsg{teamID: "1610612738"})
+	if !p.loading {
+		t.Error("shot zones of another team were applied")
+	}
+
+	zones := []types.ShotZoneStats{{Zone: "Restricted Area", FGM: 6, FGA: 10, FGPct: 0.6, LeagueFGPct: 0.65, Frequency: 1}}
+	p, _ = p.Update(teamShotZonesFetchedMsg{teamID: "1610612744", team: zones, opponents: zones})
+	if p.loading || len(p.team) != 1 || len(p.opponents) != 1 {
+		t.Fatal("shot zones of the team were not applied")
+	}
+	if view := p.View(); !strings.Contains(view, "OPPONENTS") || !strings.Contains(view, "60%") {
+		t.Errorf("View() is missing the opponents court or the zone FG%%:\n%s", view)
+	}
+}
@@ -0,0 +1,239 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+	"log"
+	"sort"
+	"strings"
+)
+
+// StandingsHistory charts how the records of a conference's teams evolved over the season, as win% or
+// conference rank by date. A few teams are drawn at once, by default the ones fighting for the 6th seed.
+type StandingsHistory struct {
+	histories  []types.TeamStandingsHistory
+	conference string
+	cursor     int
+	selected   map[int]bool
+	showRank   bool
+	season     string
+	loading    bool
+	err        error
+	width      int
+	height     int
+	quitting   bool
+}
+
+type standingsHistoryFetchedMsg struct {
+	err       error
+	histories []types.TeamStandingsHistory
+}
+
+// NewStandingsHistory instantiates the standings history of the current season for the East or West conference
+func NewStandingsHistory(conference string, size tea.WindowSizeMsg) (*StandingsHistory, tea.Cmd, error) {
+	season := nbaAPI.NewClient().Dates.GetCurrentSeason()
+	m := &StandingsHistory{
+		conference: conference,
+		selected:   make(map[int]bool),
+		season:     season,
+		loading:    true,
+		width:      size.Width,
+		height:     size.Height,
+	}
+	return m, fetchStandingsHistoryCmd(season), nil
+}
+
+func fetchStandingsHistoryCmd(season string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		if err := cl.FetchLeagueGameLog(season); err != nil {
+			return standingsHistoryFetchedMsg{err: fmt.Errorf("could not fetch league game log: %w", err)}
+		}
+		rs, err := cl.Loader.LoadLeagueGameLog(season)
+		if err != nil {
+			return standingsHistoryFetchedMsg{err: err}
+		}
+		histories, err := converters.PopulateStandingsHistory(rs)
+		return standingsHistoryFetchedMsg{histories: histories, err: err}
+	}
+}
+
+// conferenceTeams returns the teams of the active conference, ordered by their current rank
+func (m StandingsHistory) conferenceTeams() []types.TeamStandingsHistory {
+	var teams []types.TeamStandingsHistory
+	for _, h := range m.histories {
+		if h.Conference == m.conference {
+			teams = append(teams, h)
+		}
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Latest().ConfRank < teams[j].Latest().ConfRank })
+	return teams
+}
+
+// selectSeedRace selects the teams currently ranked 5th to 8th, the race for the last guaranteed playoff spot
+func (m *StandingsHistory) selectSeedRace() {
+	m.selected = make(map[int]bool)
+	for _, t := range m.conferenceTeams() {
+		if rank := t.Latest().ConfRank; rank >= 5 && rank <= 8 {
+			m.selected[t.TeamID] = true
+		}
+	}
+}
+
+func (m StandingsHistory) Init() tea.Cmd { return nil }
+
+func (m StandingsHistory) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case standingsHistoryFetchedMsg:
+		m.loading = false
+		if msg.err != nil {
+			log.Println("could not load standings history:", msg.err)
+			m.err = msg.err
+			return m, nil
+		}
+		m.histories = msg.histories
+		m.selectSeedRace()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			ss, cmd, _ := NewSeasonStandings(WindowSize)
+			return ss, cmd
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case m.loading:
+			return m, nil
+		case key.Matches(msg, Keymap.Tab):
+			if m.conference == "East" {
+				m.conference = "West"
+			} else {
+				m.conference = "East"
+			}
+			m.cursor = 0
+			m.selectSeedRace()
+		case key.Matches(msg, Keymap.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, Keymap.Down):
+			if m.cursor < len(m.conferenceTeams())-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, Keymap.Space):
+			if teams := m.conferenceTeams(); m.cursor < len(teams) {
+				id := teams[m.cursor].TeamID
+				m.selected[id] = !m.selected[id]
+			}
+		case key.Matches(msg, Keymap.Mode):
+			m.showRank = !m.showRank
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+
+	return m, nil
+}
+
+// teamList renders the teams of the conference with their record and whether they are charted
+func (m StandingsHistory) teamList() string {
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("5"))
+	var lines []string
+	for i, t := range m.conferenceTeams() {
+		check := "[ ]"
+		if m.selected[t.TeamID] {
+			check = lipgloss.NewStyle().Foreground(TeamNameColor(t.Nickname)).Render("[x]")
+		}
+		latest := t.Latest()
+		line := fmt.Sprintf("%2d %-4s %2d-%-2d", latest.ConfRank, t.Tricode, latest.Wins, latest.Losses)
+		if i == m.cursor {
+			line = cursorStyle.Render(line)
+		}
+		lines = append(lines, check+" "+line)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// chart renders the selected teams as one line each
+func (m StandingsHistory) chart() string {
+	chart := NewLineChart(max(m.width-40, 20), max(m.height-16, 8))
+	for _, t := range m.conferenceTeams() {
+		if !m.selected[t.TeamID] {
+			continue
+		}
+		values := make([]float64, len(t.Points))
+		for i, p := range t.Points {
+			if m.showRank {
+				values[i] = float64(p.ConfRank)
+			} else {
+				values[i] = p.WinPct() * 100
+			}
+		}
+		chart.Series = append(chart.Series, ChartSeries{Name: t.Tricode, Values: values, Color: TeamNameColor(t.Nickname)})
+		chart.XStart = t.Points[0].Date
+		chart.XEnd = t.Latest().Date
+	}
+
+	refColor := lipgloss.Color("241")
+	if m.showRank {
+		chart.InvertY = true
+		chart.References = []ChartReference{
+			{Name: "top 6", Value: 6.5, Color: refColor},
+			{Name: "play-in", Value: 10.5, Color: refColor},
+		}
+	} else {
+		chart.References = []ChartReference{{Name: ".500", Value: 50, Color: refColor}}
+	}
+	return chart.View()
+}
+
+func (m StandingsHistory) helpView() string {
+	metric := "conference rank"
+	if m.showRank {
+		metric = "win%"
+	}
+	return HelpStyle(Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		Keymap.Tab.Help().Key + ": conference | " +
+		Keymap.Space.Help().Key + ": toggle team | " +
+		Keymap.Mode.Help().Key + ": " + metric)
+}
+
+func (m StandingsHistory) View() string {
+	if m.quitting {
+		return ""
+	}
+	if m.loading {
+		return lipgloss.NewStyle().Width(m.width).Height(m.height).
+			Align(lipgloss.Center, lipgloss.Center).
+			Render(fmt.Sprintf("Replaying the %s season...", m.season))
+	}
+	if m.err != nil {
+		return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+			"Could not build the standings history: "+m.err.Error(),
+			m.helpView()))
+	}
+
+	metric := "WIN%"
+	if m.showRank {
+		metric = "CONFERENCE RANK"
+	}
+	title := lipgloss.NewStyle().Bold(true).
+		Render(fmt.Sprintf("%s - %s BY DATE, %s SEASON", strings.ToUpper(m.conference), metric, m.season))
+
+	comboView := lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Top, m.teamList(), "    ", m.chart()),
+		"",
+		m.helpView())
+	return DocStyle.Render(comboView)
+}
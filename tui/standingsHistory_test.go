@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func testHistories() []types.TeamStandingsHistory {
+	var histories []types.TeamStandingsHistory
+	for rank := 1; rank <= 10; rank++ {
+		for i, conf := range []string{"East", "West"} {
+			histories = append(histories, types.TeamStandingsHistory{
+				TeamID:     rank*10 + i,
+				Tricode:    conf[:1] + string(rune('A'+rank-1)),
+				Conference: conf,
+				Points: []types.StandingsPoint{
+					{Date: "2024-10-22", Wins: 1, ConfRank: 11 - rank},
+					{Date: "2024-10-24", Wins: 20 - rank, Losses: rank, ConfRank: rank},
+				},
+			})
+		}
+	}
+	return histories
+}
+
+func TestStandingsHistory_SelectsSeedRace(t *testing.T) {
+	m := StandingsHistory{conference: "East", selected: map[int]bool{}, loading: true, width: 120, height: 40}
+	model, _ := m.Update(standingsHistoryFetchedMsg{histories: testHistories()})
+	m = model.(StandingsHistory)
+
+	var selected []string
+	for _, team := range m.conferenceTeams() {
+		if m.selected[team.TeamID] {
+			selected = append(selected, team.Tricode)
+		}
+	}
+	if got := strings.Join(selected, ","); got != "EE,EF,EG,EH" {
+		t.Errorf("selected = %s, want the teams ranked 5-8", got)
+	}
+
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(StandingsHistory)
+	if m.conference != "West" || len(m.selected) != 4 || !m.selected[51] {
+		t.Errorf("after tab: conference %s, selected %v", m.conference, m.selected)
+	}
+
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	m = model.(StandingsHistory)
+	if !m.selected[11] {
+		t.Error("space did not select the team under the cursor")
+	}
+	if view := m.View(); !strings.Contains(view, "WEST - WIN% BY DATE") || !strings.Contains(view, "WA") {
+		t.Errorf("View() is missing the title or the selected team:\n%s", view)
+	}
+}
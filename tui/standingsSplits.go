@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+	"log"
+	"strconv"
+)
+
+// StandingsSplits is the drill-down view of SeasonStandings, which shows the split records of a single team
+type StandingsSplits struct {
+	width            int
+	height           int
+	mainPort         viewport.Model
+	teamName         string
+	record           string
+	teamColor        lipgloss.Color
+	tables           []table.Model
+	tableNames       []string
+	activeTableIndex int
+	quitting         bool
+}
+
+type teamSplitsFetchedMsg struct {
+	err        error
+	teamName   string
+	record     string
+	tables     []table.Model
+	tableNames []string
+}
+
+// NewStandingsSplits instantiates the splits view for the given team
+func NewStandingsSplits(teamID string, size tea.WindowSizeMsg) (*StandingsSplits, tea.Cmd, error) {
+	vp := viewport.New(size.Width-4, size.Height-8)
+	vp.Style = TeamViewPortStyle(lipgloss.Color("#FFFFFF"))
+
+	m := &StandingsSplits{
+		mainPort:  vp,
+		width:     size.Width,
+		height:    size.Height,
+		teamColor: lipgloss.Color("#FFFFFF"),
+	}
+
+	return m, fetchTeamSplitsCmd(teamID), nil
+}
+
+// fetchTeamSplitsCmd loads the cached season standings, finds the team and builds one table per split group
+func fetchTeamSplitsCmd(teamID string) tea.Cmd {
+	return func() tea.Msg {
+		id, err := strconv.Atoi(teamID)
+		if err != nil {
+			return teamSplitsFetchedMsg{err: fmt.Errorf("invalid team id %s: %w", teamID, err)}
+		}
+
+		cl, err := nbaAPI.NewClient().Loader.LoadSeasonStandings()
+		if err != nil {
+			return teamSplitsFetchedMsg{err: err}
+		}
+		teams, _, err := converters.PopulateTeamStats(cl)
+		if err != nil {
+			return teamSplitsFetchedMsg{err: err}
+		}
+
+		team, ok := teams.FindByID(id)
+		if !ok {
+			return teamSplitsFetchedMsg{err: fmt.Errorf("team %s not found in standings", teamID)}
+		}
+
+		groups, headers := converters.PopulateTeamSplits(team)
+
+		var tables []table.Model
+		var tableNames []string
+		for _, group := range groups {
+			splitStrings := types.ConvertToStringMatrix(group.Splits)
+			tables = append(tables, buildTables(headers, splitStrings, types.TeamSplit{}))
+			tableNames = append(tableNames, group.Name)
+		}
+
+		return teamSplitsFetchedMsg{
+			teamName:   team.TeamName,
+			record:     fmt.Sprintf("%s %s | %d-%d", team.TeamCity, team.TeamName, team.Wins, team.Losses),
+			tables:     tables,
+			tableNames: tableNames,
+		}
+	}
+}
+
+func (m *StandingsSplits) assembleTables() {
+	centered := CenterStyle(m.mainPort.Width - 4)
+	headerStyle := lipgloss.NewStyle().Bold(true)
+	activeHeaderStyle := lipgloss.NewStyle().Bold(true).Foreground(m.teamColor)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("15")).Background(m.teamColor).Padding(0, 2)
+
+	sections := []string{centered.Render(titleStyle.Render(m.record)), "\n"}
+
+	for i := range m.tables {
+		var headerContent string
+		if i == m.activeTableIndex {
+			m.tables[i] = m.tables[i].Focused(true)
+			headerContent = activeHeaderStyle.Render(" << " + m.tableNames[i] + " >> ")
+		} else {
+			m.tables[i] = m.tables[i].Focused(false)
+			headerContent = headerStyle.Render(" << " + m.tableNames[i] + " >> ")
+		}
+
+		sections = append(sections,
+			centered.Render(headerContent),
+			centered.Render(TableStyle.Render(m.tables[i].View())),
+			"\n")
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, sections...)
+	m.mainPort.SetContent(content)
+}
+
+func (m *StandingsSplits) Init() tea.Cmd { return nil }
+
+func (m *StandingsSplits) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case teamSplitsFetchedMsg:
+		if msg.err != nil {
+			log.Println("could not load team splits:", msg.err)
+			return m, nil
+		}
+		m.teamName = msg.teamName
+		m.record = msg.record
+		m.tables = msg.tables
+		m.tableNames = msg.tableNames
+		m.teamColor = TeamColor(msg.teamName)
+		m.mainPort.Style = TeamViewPortStyle(m.teamColor)
+		m.assembleTables()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Tab):
+			if len(m.tables) > 0 {
+				m.activeTableIndex = (m.activeTableIndex + 1) % len(m.tables)
+				m.assembleTables()
+			}
+		case key.Matches(msg, Keymap.Back):
+			ss, cmd, _ := NewSeasonStandings(WindowSize)
+			return ss, cmd
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.mainPort.Width = msg.Width - 4
+		m.mainPort.Height = msg.Height - 8
+		m.width = msg.Width
+		m.height = msg.Height
+		m.assembleTables()
+	}
+
+	var cmd tea.Cmd
+	m.mainPort, cmd = m.mainPort.Update(msg)
+	cmds = append(cmds, cmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *StandingsSplits) helpView() string {
+	return HelpStyle("\n" + HelpFooter() + " | " + Keymap.Tab.Help().Key + ": " + Keymap.Tab.Help().Desc + "\n")
+}
+
+func (m *StandingsSplits) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	if m.record == "" {
+		return DocStyle.Render("Loading team splits...")
+	}
+
+	comboView := lipgloss.JoinVertical(lipgloss.Left, m.mainPort.View(), m.helpView())
+	return DocStyle.Render(comboView)
+}
@@ -16,16 +16,35 @@ var (
 type errMsg struct{ error }
 
 type keymap struct {
-	Back    key.Binding
-	Quit    key.Binding
-	Enter   key.Binding
-	Up      key.Binding
-	Down    key.Binding
-	Left    key.Binding
-	Right   key.Binding
-	Tab     key.Binding
-	Space   key.Binding
-	Refresh key.Binding
+	Back      key.Binding
+	Quit      key.Binding
+	Enter     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	Tab       key.Binding
+	Space     key.Binding
+	Refresh   key.Binding
+	Splits    key.Binding
+	Picture   key.Binding
+	Challenge key.Binding
+	New       key.Binding
+	Games     key.Binding
+	Cancel    key.Binding
+	Archive   key.Binding
+	Shots     key.Binding
+	LastN     key.Binding
+	Game      key.Binding
+	Mode      key.Binding
+	Trend     key.Binding
+	History   key.Binding
+	Filter    key.Binding
+	AllTime   key.Binding
+	Sort      key.Binding
+	Reverse   key.Binding
+	Favorite  key.Binding
+	Browser   key.Binding
 }
 
 var DocStyle = lipgloss.NewStyle().Margin(2, 2).BorderStyle(lipgloss.HiddenBorder())
@@ -69,11 +88,68 @@ var Keymap = keymap{
 		key.WithKeys("tab"),
 		key.WithHelp("tab", "switch")),
 	Space: key.NewBinding(
-		key.WithKeys("space"),
+		key.WithKeys("space", " "),
 		key.WithHelp("space", "mark for selection")),
 	Refresh: key.NewBinding(
 		key.WithKeys("r"),
 		key.WithHelp("r", "refresh")),
+	Splits: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "team splits")),
+	Picture: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "playoff picture")),
+	Challenge: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "bracket challenge")),
+	New: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "new")),
+	Games: key.NewBinding(
+		key.WithKeys("4", "5", "6", "7"),
+		key.WithHelp("4-7", "series length")),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "cancel")),
+	Archive: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "playoff archive")),
+	Shots: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "shot chart")),
+	LastN: key.NewBinding(
+		key.WithKeys("l"),
+		key.WithHelp("l", "last n games")),
+	Game: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "single game")),
+	Mode: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "makes/heat map")),
+	Trend: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "trends")),
+	History: key.NewBinding(
+		key.WithKeys("h"),
+		key.WithHelp("h", "standings history")),
+	Filter: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "filter")),
+	AllTime: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "all-time leaders")),
+	Sort: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "sort column")),
+	Reverse: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "reverse sort")),
+	Favorite: key.NewBinding(
+		key.WithKeys("*"),
+		key.WithHelp("*", "favorite")),
+	Browser: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "open in browser")),
 }
 
 // CenterStyle takes a variable width and returns a centered style based on that. Used to align content in viewports
@@ -81,49 +157,62 @@ func CenterStyle(w int) lipgloss.Style {
 	return lipgloss.NewStyle().Width(w).Align(lipgloss.Center)
 }
 
+// teamColors maps the team names to their dominant colors, the names are also what news is tagged with
+var teamColors = map[string]lipgloss.Color{
+	"76ers":        lipgloss.Color("#ED174C"),
+	"Bucks":        lipgloss.Color("#00471B"),
+	"Bulls":        lipgloss.Color("#CE1141"),
+	"Cavaliers":    lipgloss.Color("#860038"),
+	"Celtics":      lipgloss.Color("#007A33"),
+	"Clippers":     lipgloss.Color("#1d428a"),
+	"Grizzlies":    lipgloss.Color("#5D76A9"),
+	"Hawks":        lipgloss.Color("#C8102E"),
+	"Heat":         lipgloss.Color("#db3eb1"),
+	"Hornets":      lipgloss.Color("#00788C"),
+	"Jazz":         lipgloss.Color("#F9A01B"),
+	"Kings":        lipgloss.Color("#5a2d81"),
+	"Knicks":       lipgloss.Color("#F58426"),
+	"Lakers":       lipgloss.Color("#552583"),
+	"Magic":        lipgloss.Color("#C4ced4"),
+	"Mavericks":    lipgloss.Color("#00538C"),
+	"Nets":         lipgloss.Color("#FFFFFF"),
+	"Nuggets":      lipgloss.Color("#1D428A"),
+	"Pacers":       lipgloss.Color("#FDBB30"),
+	"Pelicans":     lipgloss.Color("#85714D"),
+	"Pistons":      lipgloss.Color("#1d42ba"),
+	"Raptors":      lipgloss.Color("#ce1141"),
+	"Rockets":      lipgloss.Color("#CE1141"),
+	"Spurs":        lipgloss.Color("#c4ced4"),
+	"Suns":         lipgloss.Color("#e56020"),
+	"Thunder":      lipgloss.Color("#007ac1"),
+	"Timberwolves": lipgloss.Color("#78BE20"),
+	"TrailBlazers": lipgloss.Color("#E03A3E"),
+	"Warriors":     lipgloss.Color("#ffc72c"),
+	"Wizards":      lipgloss.Color("#002B5C"),
+}
+
 // TeamColor returns a lipgloss.Color object, representing the dominant color for the specific team
 func TeamColor(s string) lipgloss.Color {
-
-	//this map contains the names:colors mappings
-	clrs := map[string]lipgloss.Color{
-		"76ers":        lipgloss.Color("#ED174C"),
-		"Bucks":        lipgloss.Color("#00471B"),
-		"Bulls":        lipgloss.Color("#CE1141"),
-		"Cavaliers":    lipgloss.Color("#860038"),
-		"Celtics":      lipgloss.Color("#007A33"),
-		"Clippers":     lipgloss.Color("#1d428a"),
-		"Grizzlies":    lipgloss.Color("#5D76A9"),
-		"Hawks":        lipgloss.Color("#C8102E"),
-		"Heat":         lipgloss.Color("#db3eb1"),
-		"Hornets":      lipgloss.Color("#00788C"),
-		"Jazz":         lipgloss.Color("#F9A01B"),
-		"Kings":        lipgloss.Color("#5a2d81"),
-		"Knicks":       lipgloss.Color("#F58426"),
-		"Lakers":       lipgloss.Color("#552583"),
-		"Magic":        lipgloss.Color("#C4ced4"),
-		"Mavericks":    lipgloss.Color("#00538C"),
-		"Nets":         lipgloss.Color("#FFFFFF"),
-		"Nuggets":      lipgloss.Color("#1D428A"),
-		"Pacers":       lipgloss.Color("#FDBB30"),
-		"Pelicans":     lipgloss.Color("#85714D"),
-		"Pistons":      lipgloss.Color("#1d42ba"),
-		"Raptors":      lipgloss.Color("#ce1141"),
-		"Rockets":      lipgloss.Color("#CE1141"),
-		"Spurs":        lipgloss.Color("#c4ced4"),
-		"Suns":         lipgloss.Color("#e56020"),
-		"Thunder":      lipgloss.Color("#007ac1"),
-		"Timberwolves": lipgloss.Color("#78BE20"),
-		"TrailBlazers": lipgloss.Color("#E03A3E"),
-		"Warriors":     lipgloss.Color("#ffc72c"),
-		"Wizards":      lipgloss.Color("#002B5C"),
-	}
-	if clr, ok := clrs[s]; ok {
+	if clr, ok := teamColors[s]; ok {
 		return clr
 	}
 	//default is white
 	return lipgloss.Color("#FFFFFF")
 }
 
+// TeamNameColor returns the TeamColor of a team written with spaces, either the nickname ("Trail Blazers") or the
+// full name ("Portland Trail Blazers"), as in the injury report, the transactions and the standings history
+func TeamNameColor(name string) lipgloss.Color {
+	if strings.HasSuffix(name, "Trail Blazers") {
+		return TeamColor("TrailBlazers")
+	}
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return TeamColor("")
+	}
+	return TeamColor(fields[len(fields)-1])
+}
+
 // TeamTableBorderStyle returns a lipgloss style with the team's border color, for wrapping tables.
 func TeamTableBorderStyle(clr lipgloss.Color) lipgloss.Style {
 	return lipgloss.NewStyle().
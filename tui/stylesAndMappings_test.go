@@ -29,3 +29,17 @@ func TestCalculatePageSize_MinimumEnforced(t *testing.T) {
 		t.Errorf("expected minimum 3, got %d", result)
 	}
 }
+
+func TestTeamNameColor(t *testing.T) {
+	for _, name := range []string{"Trail Blazers", "Portland Trail Blazers"} {
+		if got := TeamNameColor(name); got != teamColors["TrailBlazers"] {
+			t.Errorf("TeamNameColor(%q) = %s, want the Trail Blazers color", name, got)
+		}
+	}
+	if got := TeamNameColor("Boston Celtics"); got != teamColors["Celtics"] {
+		t.Errorf("TeamNameColor(Boston Celtics) = %s, want the Celtics color", got)
+	}
+	if got := TeamNameColor(""); got != TeamColor("") {
+		t.Errorf("TeamNameColor(\"\") = %s, want the default color", got)
+	}
+}
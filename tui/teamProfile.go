@@ -9,21 +9,80 @@ import (
 	"github.com/evertras/bubble-table/table"
 	"github.com/sLg00/nba-now-tui/assets/logos"
 	"github.com/sLg00/nba-now-tui/cmd/converters"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
 	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
 	"github.com/sLg00/nba-now-tui/cmd/nba/types"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type TeamProfile struct {
+	teamID           string
 	width            int
 	height           int
 	mainPort         viewport.Model
 	tables           []table.Model
 	tableNames       []string
 	activeTableIndex int
+	shotZones        TeamShotZonesPanel
+	showShotZones    bool
+	shotZonesLoaded  bool
+	franchise        types.FranchiseHistory
+	franchiseTables  []table.Model
+	franchiseIdx     int
+	franchiseLoaded  bool
+	lineupTables     []table.Model
+	lineupsIdx       int
+	lineupSort       int
+	lineupSortDesc   bool
+	lineupsLoaded    bool
+	injuryReport     types.InjuryReport
+	injuriesLoaded   bool
+	favorites        types.Favorites
+	favoritesStore   *filesystemops.FavoritesStore
+	teamName         string
+	newsClient       *nbaAPI.NewsClient
+	newsLoaded       bool
 	quitting         bool
 }
 
+// recentMoveDays is how far back the roster flags the transactions of its players
+const recentMoveDays = 30
+
+// franchiseTab is the index of the franchise tab in the team profile tables
+const franchiseTab = 3
+
+// franchiseViews are the sub-tabs of the franchise tab
+var franchiseViews = []string{"LEADERS", "PLAYERS"}
+
+type teamFranchiseFetchedMsg struct {
+	err     error
+	history types.FranchiseHistory
+	leaders table.Model
+	players table.Model
+}
+
+// lineupsTab is the index of the lineups tab in the team profile tables
+const lineupsTab = 4
+
+type teamLineupsFetchedMsg struct {
+	err           error
+	groups        []types.TeamLineupGroup
+	onOff         []types.PlayerOnOff
+	lineupHeaders []string
+	onOffHeaders  []string
+}
+
+// newsTab is the index of the news tab in the team profile tables
+const newsTab = 5
+
+type teamNewsFetchedMsg struct {
+	err       error
+	headlines table.Model
+}
+
 type teamBasicInfoFetchedMsg struct {
 	err           error
 	teamBasicInfo table.Model
@@ -39,6 +98,11 @@ type playerIndexFetchedMsg struct {
 	roster table.Model
 }
 
+type rosterInjuriesFetchedMsg struct {
+	err    error
+	report types.InjuryReport
+}
+
 func NewTeamProfile(teamID string, size tea.WindowSizeMsg) (*TeamProfile, tea.Cmd, error) {
 	vp := viewport.New(size.Width-4, size.Height-8)
 
@@ -51,19 +115,33 @@ func NewTeamProfile(teamID string, size tea.WindowSizeMsg) (*TeamProfile, tea.Cm
 	teamStyle := TeamViewPortStyle(TeamColor(name))
 	vp.Style = teamStyle
 
+	cl := nbaAPI.NewClient()
+	store := filesystemops.NewFavoritesStore(cl.FileSystem, cl.Paths)
+	favorites, err := store.Load()
+	if err != nil {
+		log.Println("could not load favorites:", err)
+	}
+
 	m := &TeamProfile{
+		teamID:           teamID,
 		mainPort:         vp,
 		width:            size.Width,
 		height:           size.Height,
-		tables:           make([]table.Model, 3),
-		tableNames:       []string{"Team Info", "SEASON STATS", "ROSTER"},
+		tables:           make([]table.Model, 6),
+		tableNames:       []string{"Team Info", "SEASON STATS", "ROSTER", "FRANCHISE", "LINEUPS", "NEWS"},
 		activeTableIndex: 1,
+		shotZones:        NewTeamShotZonesPanel(teamID, nbaAPI.NewClient().Dates.GetCurrentSeason()),
+		favorites:        favorites,
+		favoritesStore:   store,
+		teamName:         strings.ReplaceAll(name, " ", ""),
+		newsClient:       nbaAPI.NewNewsClient(cl.FileSystem, cl.Paths),
 		quitting:         false,
 	}
 
 	cmds := tea.Batch(fetchBasicTeamInfoMsg(teamID),
 		fetchTeamSeasonSnapshotMsg(teamID),
-		fetchPlayerIndexMsg(teamID))
+		fetchPlayerIndexMsg(teamID, favorites),
+		fetchRosterInjuriesCmd())
 
 	return m, cmds, nil
 }
@@ -126,7 +204,23 @@ func fetchTeamSeasonSnapshotMsg(teamID string) tea.Cmd {
 	}
 }
 
-func fetchPlayerIndexMsg(teamID string) tea.Cmd {
+// rosterMoves returns the recent transactions of the players of a roster made by the team or by a favorite team
+func rosterMoves(teamID string, favorites types.Favorites) map[int]types.Transaction {
+	transactions, _, err := loadTransactions()
+	if err != nil {
+		log.Println("roster without transactions:", err)
+		return nil
+	}
+	var teamIDs []int
+	for _, id := range append([]string{teamID}, favorites.Teams...) {
+		if n, err := strconv.Atoi(id); err == nil {
+			teamIDs = append(teamIDs, n)
+		}
+	}
+	return converters.RecentPlayerMoves(transactions, teamIDs, time.Now().AddDate(0, 0, -recentMoveDays))
+}
+
+func fetchPlayerIndexMsg(teamID string, favorites types.Favorites) tea.Cmd {
 	return func() tea.Msg {
 		cl, err := nbaAPI.NewClient().Loader.LoadPlayerIndex(teamID)
 		if err != nil {
@@ -138,13 +232,229 @@ func fetchPlayerIndexMsg(teamID string) tea.Cmd {
 			return playerIndexFetchedMsg{err: err}
 		}
 
+		// the statuses are filled in from the injury report once it is loaded, see fetchRosterInjuriesCmd
+		headers = append(headers, "INJURY_STATUS")
+
+		// players moved lately by the team or a favorite team are flagged with the move
+		moves := rosterMoves(teamID, favorites)
+		for i, p := range players {
+			if t, ok := moves[p.PlayerID]; ok {
+				players[i].Transaction = t.Type + " " + t.Date
+			}
+		}
+		headers = append(headers, "TRANSACTION")
+
 		playerStrings := types.ConvertToStringMatrix(players)
 		tableModel := buildTables(headers, playerStrings, types.IndexPlayer{}).Focused(true)
 
+		rows := tableModel.GetVisibleRows()
+		for i, p := range players {
+			if i < len(rows) {
+				rows[i].Data["TRANSACTION"] = table.NewStyledCell(p.Transaction,
+					lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6")))
+			}
+		}
+		tableModel = tableModel.WithRows(rows)
+
 		return playerIndexFetchedMsg{roster: tableModel, err: nil}
 	}
 }
 
+// fetchRosterInjuriesCmd loads the injury report apart from the roster, finding the latest report can take a
+// while and the roster is shown without the statuses meanwhile
+func fetchRosterInjuriesCmd() tea.Cmd {
+	return func() tea.Msg {
+		report, _, err := loadInjuryReport(false)
+		return rosterInjuriesFetchedMsg{report: report, err: err}
+	}
+}
+
+// applyInjuryStatuses fills in the status column of the roster, whichever of the roster and the injury report
+// was loaded last
+func (m *TeamProfile) applyInjuryStatuses() {
+	if !m.injuriesLoaded {
+		return
+	}
+	rows := m.tables[2].GetVisibleRows()
+	if len(rows) == 0 {
+		return
+	}
+	for i, row := range rows {
+		first, _ := row.Data["PLAYER_FIRST_NAME"].(string)
+		last, _ := row.Data["PLAYER_LAST_NAME"].(string)
+		if e, ok := m.injuryReport.Lookup(first, last); ok {
+			rows[i].Data["INJURY_STATUS"] = table.NewStyledCell(e.Status, injuryStatusStyle(e.Status))
+		}
+	}
+	m.tables[2] = m.tables[2].WithRows(rows)
+}
+
+// fetchTeamFranchiseCmd downloads the franchise leaders and players of a team. The seasons of the franchise
+// come from the team info, which is part of the team profile.
+func fetchTeamFranchiseCmd(teamID string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		if err := cl.FetchFranchise(teamID); err != nil {
+			return teamFranchiseFetchedMsg{err: err}
+		}
+
+		var history types.FranchiseHistory
+		if rs, err := cl.Loader.LoadTeamInfo(teamID); err == nil && len(rs.ResultSets) > 0 {
+			if info, _, err := converters.PopulateTeamInfo(rs); err == nil {
+				history.FirstSeason = info.MinYear
+				history.LastSeason = info.MaxYear
+			}
+		}
+
+		rs, err := cl.Loader.LoadFranchiseLeaders(teamID)
+		if err != nil {
+			return teamFranchiseFetchedMsg{err: err}
+		}
+		leaders, leaderHeaders, err := converters.PopulateFranchiseLeaders(rs)
+		if err != nil {
+			return teamFranchiseFetchedMsg{err: err}
+		}
+
+		rs, err = cl.Loader.LoadFranchisePlayers(teamID)
+		if err != nil {
+			return teamFranchiseFetchedMsg{err: err}
+		}
+		players, playerHeaders, err := converters.PopulateFranchisePlayers(rs)
+		if err != nil {
+			return teamFranchiseFetchedMsg{err: err}
+		}
+		history.Leaders = leaders
+		history.Players = players
+
+		const pageSize = 15
+		return teamFranchiseFetchedMsg{
+			history: history,
+			leaders: buildTables(leaderHeaders, types.ConvertToStringMatrix(leaders), types.FranchiseLeader{}),
+			players: buildTables(playerHeaders, types.ConvertToStringMatrix(players), types.FranchisePlayer{}).
+				WithPageSize(pageSize).
+				WithFooterVisibility(len(players) > pageSize),
+		}
+	}
+}
+
+// showFranchiseView puts the selected sub-tab into the franchise tab, titled with the seasons of the franchise
+func (m *TeamProfile) showFranchiseView(idx int) {
+	if len(m.franchiseTables) == 0 {
+		return
+	}
+	m.franchiseIdx = (idx + len(m.franchiseTables)) % len(m.franchiseTables)
+	m.tables[franchiseTab] = m.franchiseTables[m.franchiseIdx]
+
+	title := "FRANCHISE"
+	if seasons := m.franchise.Seasons(); seasons > 0 {
+		title += fmt.Sprintf(": %s-%s, %d SEASONS", m.franchise.FirstSeason, m.franchise.LastSeason, seasons)
+	}
+	m.tableNames[franchiseTab] = title + " | " + franchiseViews[m.franchiseIdx]
+}
+
+// fetchTeamLineupsCmd downloads the two-, three- and five-man lineups and the on/off summary of a team for a season
+func fetchTeamLineupsCmd(teamID, season string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		if err := cl.FetchTeamLineups(teamID, season); err != nil {
+			return teamLineupsFetchedMsg{err: err}
+		}
+
+		var msg teamLineupsFetchedMsg
+		for _, size := range nbaAPI.LineupGroupSizes {
+			rs, err := cl.Loader.LoadTeamLineups(teamID, size, season)
+			if err != nil {
+				return teamLineupsFetchedMsg{err: err}
+			}
+			lineups, headers, err := converters.PopulateTeamLineups(rs)
+			if err != nil {
+				return teamLineupsFetchedMsg{err: err}
+			}
+			msg.groups = append(msg.groups, types.TeamLineupGroup{Size: size, Lineups: lineups})
+			msg.lineupHeaders = headers
+		}
+
+		rs, err := cl.Loader.LoadTeamOnOff(teamID, season)
+		if err != nil {
+			return teamLineupsFetchedMsg{err: err}
+		}
+		msg.onOff, msg.onOffHeaders, err = converters.PopulatePlayerOnOff(rs)
+		if err != nil {
+			return teamLineupsFetchedMsg{err: err}
+		}
+		return msg
+	}
+}
+
+// fetchTeamNewsCmd reads the news and lists the headlines mentioning the team, the team name is written
+// without spaces like the tags of the news
+func fetchTeamNewsCmd(nc *nbaAPI.NewsClient, teamName string) tea.Cmd {
+	return func() tea.Msg {
+		articles, err := fetchTaggedNews(nc)
+		if err != nil {
+			return teamNewsFetchedMsg{err: err}
+		}
+
+		var headlines []types.NewsHeadline
+		for _, a := range nbaAPI.FilterArticlesByTeam(articles, teamName) {
+			headlines = append(headlines, types.NewsHeadline{Date: a.Timestamp, Source: a.Source, Title: a.Title, URL: a.URL})
+		}
+
+		const pageSize = 15
+		headers := []string{"DATE", "SOURCE", "TITLE", "URL"}
+		t := buildTables(headers, types.ConvertToStringMatrix(headlines), types.NewsHeadline{}).
+			WithPageSize(pageSize).
+			WithFooterVisibility(len(headlines) > pageSize)
+		return teamNewsFetchedMsg{headlines: t}
+	}
+}
+
+// lineupViewNames returns the names of the sub-tabs of the lineups tab, one per lineup size and the on/off view
+func lineupViewNames() []string {
+	var names []string
+	for _, size := range nbaAPI.LineupGroupSizes {
+		names = append(names, fmt.Sprintf("%d-MAN", size))
+	}
+	return append(names, "ON/OFF")
+}
+
+// lineupSortColumns returns the columns the selected lineups sub-tab can be sorted by
+func (m *TeamProfile) lineupSortColumns() []sortColumn {
+	if m.lineupsIdx == len(nbaAPI.LineupGroupSizes) {
+		return sortableColumns(types.PlayerOnOff{})
+	}
+	return sortableColumns(types.TeamLineup{})
+}
+
+// defaultLineupSort sorts the selected lineups sub-tab by minutes played, most first
+func (m *TeamProfile) defaultLineupSort() {
+	m.lineupSort = 0
+	m.lineupSortDesc = true
+	for i, c := range m.lineupSortColumns() {
+		if c.key == "MIN" || c.key == "ON_MIN" {
+			m.lineupSort = i
+			break
+		}
+	}
+}
+
+// showLineupView puts the selected sub-tab into the lineups tab, sorted by the selected column
+func (m *TeamProfile) showLineupView() {
+	if len(m.lineupTables) == 0 {
+		return
+	}
+	columns := m.lineupSortColumns()
+	column := columns[m.lineupSort%len(columns)]
+	m.tables[lineupsTab] = sortRows(m.lineupTables[m.lineupsIdx], column.key, m.lineupSortDesc).PageFirst()
+
+	arrow := "▲"
+	if m.lineupSortDesc {
+		arrow = "▼"
+	}
+	m.tableNames[lineupsTab] = fmt.Sprintf("LINEUPS | %s | sorted by %s %s",
+		lineupViewNames()[m.lineupsIdx], column.name, arrow)
+}
+
 func (m *TeamProfile) assembleTables() {
 	if len(m.tables) == 0 {
 		return
@@ -168,6 +478,14 @@ func (m *TeamProfile) assembleTables() {
 	var sections []string
 	sections = append(sections, InvisibleTableStyle.Render(m.tables[0].View()), "\n\n")
 
+	if m.showShotZones {
+		sections = append(sections,
+			centered.Render(activeHeaderStyle.Render(" << SHOT ZONES >> ")),
+			centered.Render(m.shotZones.View()))
+		m.mainPort.SetContent(lipgloss.JoinVertical(lipgloss.Left, sections...))
+		return
+	}
+
 	for i := 1; i < len(m.tables); i++ {
 		var headerContent string
 		if i == m.activeTableIndex {
@@ -218,18 +536,162 @@ func (m *TeamProfile) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			return m, nil
 		}
 		m.tables[2] = msg.roster
+		m.applyInjuryStatuses()
+		m.assembleTables()
+		return m, nil
+	case rosterInjuriesFetchedMsg:
+		// a missing report just leaves the statuses empty
+		if msg.err != nil {
+			log.Println("roster without injury statuses:", msg.err)
+			return m, nil
+		}
+		m.injuryReport = msg.report
+		m.injuriesLoaded = true
+		m.applyInjuryStatuses()
+		m.assembleTables()
+		return m, nil
+	case teamFranchiseFetchedMsg:
+		if msg.err != nil {
+			log.Println("could not load franchise history:", msg.err)
+			m.tableNames[franchiseTab] = "FRANCHISE: not available"
+			m.assembleTables()
+			return m, nil
+		}
+		m.franchise = msg.history
+		m.franchiseTables = []table.Model{msg.leaders, msg.players}
+		m.showFranchiseView(0)
+		m.assembleTables()
+		return m, nil
+	case teamLineupsFetchedMsg:
+		if msg.err != nil {
+			log.Println("could not load lineups:", msg.err)
+			m.tableNames[lineupsTab] = "LINEUPS: not available"
+			m.assembleTables()
+			return m, nil
+		}
+		const pageSize = 15
+		m.lineupTables = nil
+		for _, group := range msg.groups {
+			m.lineupTables = append(m.lineupTables,
+				buildTables(msg.lineupHeaders, types.ConvertToStringMatrix(group.Lineups), types.TeamLineup{}).
+					WithPageSize(pageSize).
+					WithFooterVisibility(len(group.Lineups) > pageSize))
+		}
+		m.lineupTables = append(m.lineupTables,
+			buildTables(msg.onOffHeaders, types.ConvertToStringMatrix(msg.onOff), types.PlayerOnOff{}).
+				WithPageSize(pageSize).
+				WithFooterVisibility(len(msg.onOff) > pageSize))
+		m.lineupsIdx = 0
+		m.defaultLineupSort()
+		m.showLineupView()
+		m.assembleTables()
+		return m, nil
+	case teamNewsFetchedMsg:
+		if msg.err != nil {
+			log.Println("could not load team news:", msg.err)
+			m.tableNames[newsTab] = "NEWS: not available"
+			m.assembleTables()
+			return m, nil
+		}
+		m.tables[newsTab] = msg.headlines
+		m.tableNames[newsTab] = "NEWS"
+		if len(msg.headlines.GetVisibleRows()) == 0 {
+			m.tableNames[newsTab] = "NEWS: no headlines today"
+		}
 		m.assembleTables()
 		return m, nil
+	case teamShotZonesFetchedMsg:
+		m.shotZones, cmd = m.shotZones.Update(msg)
+		if msg.err != nil {
+			log.Println("could not load shot zones:", msg.err)
+		}
+		m.assembleTables()
+		return m, cmd
 	case tea.KeyMsg:
 		switch {
+		case key.Matches(msg, Keymap.Shots):
+			m.showShotZones = !m.showShotZones
+			if m.showShotZones && !m.shotZonesLoaded {
+				m.shotZonesLoaded = true
+				cmd = m.shotZones.Fetch()
+			}
+			m.assembleTables()
+			return m, cmd
 		case key.Matches(msg, Keymap.Tab):
 			if len(m.tables) > 1 {
 				m.activeTableIndex = (m.activeTableIndex + 1) % len(m.tables)
 				if m.activeTableIndex == 0 {
 					m.activeTableIndex = 1
 				}
+				if m.activeTableIndex == franchiseTab && !m.franchiseLoaded {
+					m.franchiseLoaded = true
+					m.tableNames[franchiseTab] = "FRANCHISE: loading..."
+					cmd = fetchTeamFranchiseCmd(m.teamID)
+				}
+				if m.activeTableIndex == newsTab && !m.newsLoaded {
+					m.newsLoaded = true
+					m.tableNames[newsTab] = "NEWS: loading..."
+					cmd = fetchTeamNewsCmd(m.newsClient, m.teamName)
+				}
+				if m.activeTableIndex == lineupsTab && !m.lineupsLoaded {
+					m.lineupsLoaded = true
+					m.tableNames[lineupsTab] = "LINEUPS: loading..."
+					cmd = fetchTeamLineupsCmd(m.teamID, nbaAPI.NewClient().Dates.GetCurrentSeason())
+				}
 				m.assembleTables()
+				if cmd != nil {
+					return m, cmd
+				}
+			}
+		case m.activeTableIndex == franchiseTab && key.Matches(msg, Keymap.Right):
+			m.showFranchiseView(m.franchiseIdx + 1)
+			m.assembleTables()
+			return m, nil
+		case m.activeTableIndex == franchiseTab && key.Matches(msg, Keymap.Left):
+			m.showFranchiseView(m.franchiseIdx - 1)
+			m.assembleTables()
+			return m, nil
+		case m.activeTableIndex == lineupsTab && len(m.lineupTables) > 0 &&
+			(key.Matches(msg, Keymap.Right) || key.Matches(msg, Keymap.Left)):
+			step := 1
+			if key.Matches(msg, Keymap.Left) {
+				step = -1
+			}
+			m.lineupsIdx = (m.lineupsIdx + step + len(m.lineupTables)) % len(m.lineupTables)
+			m.defaultLineupSort()
+			m.showLineupView()
+			m.assembleTables()
+			return m, nil
+		case m.activeTableIndex == lineupsTab && len(m.lineupTables) > 0 && key.Matches(msg, Keymap.Sort):
+			m.lineupSort = (m.lineupSort + 1) % len(m.lineupSortColumns())
+			m.lineupSortDesc = true
+			m.showLineupView()
+			m.assembleTables()
+			return m, nil
+		case m.activeTableIndex == lineupsTab && len(m.lineupTables) > 0 && key.Matches(msg, Keymap.Reverse):
+			m.lineupSortDesc = !m.lineupSortDesc
+			m.showLineupView()
+			m.assembleTables()
+			return m, nil
+		case m.activeTableIndex == newsTab && !m.showShotZones &&
+			(key.Matches(msg, Keymap.Enter) || key.Matches(msg, Keymap.Browser)):
+			row := m.tables[newsTab].HighlightedRow()
+			url, _ := row.Data["URL"].(string)
+			title, _ := row.Data["TITLE"].(string)
+			if url == "" {
+				return m, nil
 			}
+			if key.Matches(msg, Keymap.Browser) {
+				go openURL(url)
+				return m, nil
+			}
+			return NewArticleReader(m, m.newsClient, title, url, tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		case key.Matches(msg, Keymap.Favorite):
+			m.favorites.ToggleTeam(m.teamID)
+			if err := m.favoritesStore.Save(m.favorites); err != nil {
+				log.Println("could not save favorites:", err)
+			}
+			return m, nil
 		case key.Matches(msg, Keymap.Back):
 			ss, cmd, _ := NewSeasonStandings(WindowSize)
 			return ss, cmd
@@ -259,8 +721,28 @@ func (m *TeamProfile) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 }
 
 func (m *TeamProfile) helpView() string {
-
-	return HelpStyle("\n" + HelpFooter() + "\n")
+	help := HelpFooter() + " | " + Keymap.Shots.Help().Key + ": shot zones | " + Keymap.Favorite.Help().Key + ": "
+	if m.favorites.HasTeam(m.teamID) {
+		help += "★ unfavorite"
+	} else {
+		help += Keymap.Favorite.Help().Desc
+	}
+	if m.activeTableIndex == franchiseTab && len(m.franchiseTables) > 0 && !m.showShotZones {
+		help += "\n<- ->: leaders/players"
+		if m.franchiseIdx == 1 {
+			help += " | pgup/pgdown: page"
+		}
+	}
+	if m.activeTableIndex == lineupsTab && len(m.lineupTables) > 0 && !m.showShotZones {
+		help += "\n<- ->: 2-/3-/5-man/on-off | " +
+			Keymap.Sort.Help().Key + ": " + Keymap.Sort.Help().Desc + " | " +
+			Keymap.Reverse.Help().Key + ": " + Keymap.Reverse.Help().Desc + " | pgup/pgdown: page"
+	}
+	if m.activeTableIndex == newsTab && len(m.tables[newsTab].GetVisibleRows()) > 0 && !m.showShotZones {
+		help += "\n" + Keymap.Enter.Help().Key + ": read article | " +
+			Keymap.Browser.Help().Key + ": " + Keymap.Browser.Help().Desc + " | pgup/pgdown: page"
+	}
+	return HelpStyle("\n" + help + "\n")
 }
 
 func (m *TeamProfile) View() string {
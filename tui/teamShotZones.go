@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// TeamShotZonesPanel shows the shooting of a team and of its opponents per court zone, as two heat map courts
+// with the zone FG% compared to the league average
+type TeamShotZonesPanel struct {
+	teamID    string
+	season    string
+	team      []types.ShotZoneStats
+	opponents []types.ShotZoneStats
+	teamTable table.Model
+	oppTable  table.Model
+	loading   bool
+	err       error
+}
+
+type teamShotZonesFetchedMsg struct {
+	teamID    string
+	team      []types.ShotZoneStats
+	opponents []types.ShotZoneStats
+	err       error
+}
+
+// NewTeamShotZonesPanel creates the shot zone panel of a team for the given season
+func NewTeamShotZonesPanel(teamID, season string) TeamShotZonesPanel {
+	return TeamShotZonesPanel{teamID: teamID, season: season}
+}
+
+// Fetch loads the shot zones of the team and its opponents
+func (p *TeamShotZonesPanel) Fetch() tea.Cmd {
+	p.loading = true
+	p.err = nil
+	return fetchTeamShotZonesCmd(p.teamID, p.season)
+}
+
+func fetchTeamShotZonesCmd(teamID, season string) tea.Cmd {
+	return func() tea.Msg {
+		cl := nbaAPI.NewClient()
+		zones := make(map[string][]types.ShotZoneStats, 2)
+		for _, measure := range []string{"Base", "Opponent"} {
+			if err := cl.FetchTeamShotLocations(season, measure); err != nil {
+				return teamShotZonesFetchedMsg{teamID: teamID, err: err}
+			}
+			locations, err := cl.Loader.LoadTeamShotLocations(season, measure)
+			if err != nil {
+				return teamShotZonesFetchedMsg{teamID: teamID, err: err}
+			}
+			zones[measure], err = converters.PopulateTeamShotZones(locations, teamID)
+			if err != nil {
+				return teamShotZonesFetchedMsg{teamID: teamID, err: err}
+			}
+		}
+		return teamShotZonesFetchedMsg{teamID: teamID, team: zones["Base"], opponents: zones["Opponent"]}
+	}
+}
+
+func (p TeamShotZonesPanel) Update(msg tea.Msg) (TeamShotZonesPanel, tea.Cmd) {
+	if msg, ok := msg.(teamShotZonesFetchedMsg); ok && msg.teamID == p.teamID {
+		p.loading = false
+		p.err = msg.err
+		p.team = msg.team
+		p.opponents = msg.opponents
+		headers := converters.ShotZoneHeaders()
+		p.teamTable = buildTables(headers, types.ConvertToStringMatrix(msg.team), types.ShotZoneStats{}).
+			WithFooterVisibility(false)
+		p.oppTable = buildTables(headers, types.ConvertToStringMatrix(msg.opponents), types.ShotZoneStats{}).
+			WithFooterVisibility(false)
+	}
+	return p, nil
+}
+
+func (p TeamShotZonesPanel) legend() string {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("FG% vs league: ") +
+		lipgloss.NewStyle().Background(lipgloss.Color("#1E8449")).Render(" +5% ") + " " +
+		lipgloss.NewStyle().Background(lipgloss.Color("#52BE80")).Render(" +0-5% ") + " " +
+		lipgloss.NewStyle().Background(lipgloss.Color("#E67E22")).Render(" -0-5% ") + " " +
+		lipgloss.NewStyle().Background(lipgloss.Color("#C0392B")).Render(" -5% ")
+}
+
+// View renders both courts next to each other, each with its zone table below
+func (p TeamShotZonesPanel) View() string {
+	switch {
+	case p.loading:
+		return "Loading shot zones..."
+	case p.err != nil:
+		return "Could not load the shot zones: " + p.err.Error()
+	}
+
+	title := lipgloss.NewStyle().Bold(true)
+	column := func(name string, zones []types.ShotZoneStats, t table.Model) string {
+		return lipgloss.JoinVertical(lipgloss.Center,
+			title.Render(name),
+			renderZoneCourt(zones, -1),
+			TableStyle.Render(t.View()))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(fmt.Sprintf("%s season", p.season)),
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Top,
+			column("TEAM", p.team, p.teamTable),
+			"    ",
+			column("OPPONENTS", p.opponents, p.oppTable)),
+		p.legend())
+}
@@ -0,0 +1,288 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/evertras/bubble-table/table"
+	"github.com/sLg00/nba-now-tui/cmd/converters"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+// favoritesFilter is the team filter option showing the transactions of the favorite teams
+const favoritesFilter = "favorites"
+
+// TransactionsView is the league's transactions feed, filtered by team and by type of transaction
+type TransactionsView struct {
+	transactions []types.Transaction
+	headers      []string
+	favorites    types.Favorites
+	store        *filesystemops.FavoritesStore
+	teams        []string
+	teamIdx      int
+	typeIdx      int
+	txTypes      []string
+	feedTable    table.Model
+	loading      bool
+	err          error
+	width        int
+	height       int
+	quitting     bool
+}
+
+type transactionsFetchedMsg struct {
+	err          error
+	transactions []types.Transaction
+	headers      []string
+}
+
+// NewTransactionsView instantiates the transactions view on the feed of the day
+func NewTransactionsView(size tea.WindowSizeMsg) (*TransactionsView, tea.Cmd, error) {
+	cl := nbaAPI.NewClient()
+	store := filesystemops.NewFavoritesStore(cl.FileSystem, cl.Paths)
+	favorites, err := store.Load()
+	if err != nil {
+		log.Println("could not load favorites:", err)
+	}
+
+	m := &TransactionsView{
+		store:     store,
+		favorites: favorites,
+		loading:   true,
+		width:     size.Width,
+		height:    size.Height,
+	}
+	return m, fetchTransactionsCmd(), nil
+}
+
+// loadTransactions downloads the transactions feed unless it is cached and converts it
+func loadTransactions() ([]types.Transaction, []string, error) {
+	cl := nbaAPI.NewClient()
+	if err := cl.FetchTransactions(); err != nil {
+		return nil, nil, fmt.Errorf("could not fetch transactions: %w", err)
+	}
+	resp, err := cl.Loader.LoadTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+	return converters.PopulateTransactions(resp)
+}
+
+func fetchTransactionsCmd() tea.Cmd {
+	return func() tea.Msg {
+		transactions, headers, err := loadTransactions()
+		return transactionsFetchedMsg{transactions: transactions, headers: headers, err: err}
+	}
+}
+
+// team returns the team filter, empty when the transactions of all teams are shown
+func (m TransactionsView) team() string {
+	if m.teamIdx == 0 || m.teamIdx > len(m.teams)+1 {
+		return ""
+	}
+	if m.teamIdx == 1 {
+		return favoritesFilter
+	}
+	return m.teams[m.teamIdx-2]
+}
+
+// transactionType returns the type filter, empty when all types are shown
+func (m TransactionsView) transactionType() string {
+	if m.typeIdx == 0 || m.typeIdx > len(m.txTypes) {
+		return ""
+	}
+	return m.txTypes[m.typeIdx-1]
+}
+
+// isFavorite tells if the team of a transaction is a favorite
+func (m TransactionsView) isFavorite(t types.Transaction) bool {
+	return m.favorites.HasTeam(strconv.Itoa(t.TeamID))
+}
+
+// filterTeams returns the abbreviations of the teams the team filter selects, none selects all teams
+func (m TransactionsView) filterTeams() []string {
+	team := m.team()
+	switch team {
+	case "":
+		return nil
+	case favoritesFilter:
+		var teams []string
+		for _, t := range m.transactions {
+			if m.isFavorite(t) {
+				teams = append(teams, t.Team)
+			}
+		}
+		// no favorite made a move, match nothing rather than everything
+		if len(teams) == 0 {
+			return []string{favoritesFilter}
+		}
+		return teams
+	}
+	return []string{team}
+}
+
+// teamID returns the ID of a team of the feed by its abbreviation
+func (m TransactionsView) teamID(team string) int {
+	for _, t := range m.transactions {
+		if t.Team == team {
+			return t.TeamID
+		}
+	}
+	return 0
+}
+
+func (m TransactionsView) buildTable() table.Model {
+	transactions := converters.FilterTransactions(m.transactions, m.filterTeams(), m.transactionType())
+	t := buildTables(m.headers, types.ConvertToStringMatrix(transactions), types.Transaction{})
+
+	rows := t.GetVisibleRows()
+	for i, tr := range transactions {
+		if i >= len(rows) {
+			break
+		}
+		team := tr.Team
+		if m.isFavorite(tr) {
+			team = "★ " + team
+		}
+		rows[i].Data["TEAM_ABBREVIATION"] = table.NewStyledCell(team,
+			lipgloss.NewStyle().Bold(true).Foreground(TeamNameColor(tr.TeamName)))
+	}
+
+	pageSize := calculatePageSize(m.height-3, 1)
+	return t.WithRows(rows).
+		Focused(true).
+		WithPageSize(pageSize).
+		WithFooterVisibility(len(transactions) > pageSize)
+}
+
+func (m TransactionsView) Init() tea.Cmd { return nil }
+
+func (m TransactionsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case transactionsFetchedMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			log.Println("could not load transactions:", msg.err)
+			return m, nil
+		}
+		m.transactions = msg.transactions
+		m.headers = msg.headers
+		m.teams = converters.TransactionTeams(msg.transactions)
+		m.txTypes = converters.TransactionTypes(msg.transactions)
+		m.feedTable = m.buildTable()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keymap.Back):
+			return InitMenu()
+		case key.Matches(msg, Keymap.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case m.loading || m.err != nil:
+			return m, nil
+		case key.Matches(msg, Keymap.Tab):
+			m.typeIdx = (m.typeIdx + 1) % (len(m.txTypes) + 1)
+			m.feedTable = m.buildTable()
+			return m, nil
+		case key.Matches(msg, Keymap.Right):
+			m.teamIdx = (m.teamIdx + 1) % (len(m.teams) + 2)
+			m.feedTable = m.buildTable()
+			return m, nil
+		case key.Matches(msg, Keymap.Left):
+			m.teamIdx = (m.teamIdx + len(m.teams) + 1) % (len(m.teams) + 2)
+			m.feedTable = m.buildTable()
+			return m, nil
+		case key.Matches(msg, Keymap.Favorite):
+			id := m.teamID(m.team())
+			if id == 0 {
+				return m, nil
+			}
+			m.favorites.ToggleTeam(strconv.Itoa(id))
+			if err := m.store.Save(m.favorites); err != nil {
+				log.Println("could not save favorites:", err)
+			}
+			m.feedTable = m.buildTable()
+			return m, nil
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		if !m.loading && m.err == nil {
+			m.feedTable = m.buildTable()
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.feedTable, cmd = m.feedTable.Update(msg)
+	return m, cmd
+}
+
+// filterView renders the title of the feed and the active filters
+func (m TransactionsView) filterView() string {
+	active := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("5"))
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	team := "all teams"
+	switch t := m.team(); t {
+	case "":
+	case favoritesFilter:
+		team = "★ favorites"
+	default:
+		team = t
+		if m.favorites.HasTeam(strconv.Itoa(m.teamID(t))) {
+			team = "★ " + t
+		}
+	}
+	kind := "all types"
+	if t := m.transactionType(); t != "" {
+		kind = t
+	}
+	filters := dim.Render("Team: ") + active.Render(team) + dim.Render("   Type: ") + active.Render(kind)
+
+	return lipgloss.JoinVertical(lipgloss.Left, lipgloss.NewStyle().Bold(true).Render("TRANSACTIONS"), filters)
+}
+
+func (m TransactionsView) helpView() string {
+	help := Keymap.Back.Help().Key + ": " + Keymap.Back.Help().Desc + " | " +
+		Keymap.Quit.Help().Key + ": " + Keymap.Quit.Help().Desc + " | " +
+		"<- ->: team | " +
+		Keymap.Tab.Help().Key + ": type"
+	if m.teamID(m.team()) != 0 {
+		help += " | " + Keymap.Favorite.Help().Key + ": " + Keymap.Favorite.Help().Desc
+	}
+	return HelpStyle(help)
+}
+
+func (m TransactionsView) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var body string
+	switch {
+	case m.loading:
+		body = "Loading transactions..."
+	case m.err != nil:
+		body = "Could not load transactions: " + m.err.Error()
+	case len(m.feedTable.GetVisibleRows()) == 0:
+		body = "No transactions match the filters"
+	default:
+		body = m.feedTable.View()
+	}
+
+	return DocStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		m.filterView(),
+		"",
+		body,
+		m.helpView()))
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sLg00/nba-now-tui/cmd/nba/types"
+)
+
+func testTransactions() []types.Transaction {
+	return []types.Transaction{
+		{Date: "2025-02-02", Type: "Trade", TeamID: 1610612747, Team: "LAL", TeamName: "Lakers", PlayerID: 1629029, Player: "Luka Doncic"},
+		{Date: "2025-01-07", Type: "Waive", TeamID: 1610612757, Team: "POR", TeamName: "Trail Blazers", PlayerID: 1630625, Player: "Dalano Banton"},
+		{Date: "2024-07-06", Type: "Signing", TeamID: 1610612738, Team: "BOS", TeamName: "Celtics", PlayerID: 1628369, Player: "Jayson Tatum"},
+	}
+}
+
+func TestTransactionsView_Filters(t *testing.T) {
+	defer func(size tea.WindowSizeMsg) { WindowSize = size }(WindowSize)
+	WindowSize = tea.WindowSizeMsg{Width: 200, Height: 40}
+
+	headers := []string{"TRANSACTION_DATE", "TRANSACTION_TYPE", "TEAM_ID", "TEAM_ABBREVIATION", "TEAM_NAME",
+		"PLAYER_ID", "PLAYER_NAME", "TRANSACTION_DESCRIPTION"}
+	m := TransactionsView{loading: true, width: 200, height: 40,
+		favorites: types.Favorites{Teams: []string{"1610612738"}}}
+	model, _ := m.Update(transactionsFetchedMsg{transactions: testTransactions(), headers: headers})
+	m = model.(TransactionsView)
+	if m.loading || len(m.feedTable.GetVisibleRows()) != 3 {
+		t.Fatalf("expected all 3 transactions, got %d", len(m.feedTable.GetVisibleRows()))
+	}
+
+	// the first team option after all teams is the favorites
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m = model.(TransactionsView)
+	if m.team() != favoritesFilter || len(m.feedTable.GetVisibleRows()) != 1 {
+		t.Errorf("team filter = %q with %d transactions, want favorites with 1", m.team(), len(m.feedTable.GetVisibleRows()))
+	}
+	if !strings.Contains(m.View(), "★ BOS") {
+		t.Error("transactions of favorite teams should be marked")
+	}
+
+	// then the teams in alphabetical order
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m = model.(TransactionsView)
+	if m.team() != "BOS" {
+		t.Errorf("expected BOS, got %q", m.team())
+	}
+
+	// left from all teams wraps around to the last team, tab filters by type
+	m.teamIdx = 0
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m = model.(TransactionsView)
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = model.(TransactionsView)
+	if m.team() != "POR" || m.transactionType() != "Signing" || len(m.feedTable.GetVisibleRows()) != 0 {
+		t.Errorf("filters = %q/%q with %d transactions, want POR/Signing with none",
+			m.team(), m.transactionType(), len(m.feedTable.GetVisibleRows()))
+	}
+}
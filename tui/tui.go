@@ -1,16 +1,94 @@
 package tui
 
 import (
-	tea "github.com/charmbracelet/bubbletea"
+	"context"
+	"errors"
+	"io"
 	"log"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	filesystemops "github.com/sLg00/nba-now-tui/cmd/nba/filesystem"
+	"github.com/sLg00/nba-now-tui/cmd/nba/nbaAPI"
+	"github.com/sLg00/nba-now-tui/cmd/notifications"
 )
 
 // RenderUI is the entrypoint into the TUI
 func RenderUI() {
 	m, _ := InitMenu()
-	Program = tea.NewProgram(m, tea.WithAltScreen())
+	Program = tea.NewProgram(m, tea.WithAltScreen(), tea.WithFilter(writeTerminalNotifications))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	startNotifications(ctx)
+
 	if _, err := Program.Run(); err != nil {
 		log.Fatal(err)
 
 	}
 }
+
+// cacheLockTimeout is how long the TUI waits for the daemon to finish a refresh before fetching anyway
+const cacheLockTimeout = 30 * time.Second
+
+// lockCache takes the lockfile shared with the daemon, waiting for a refresh in progress to finish. The TUI only
+// holds it while it writes the startup requests, so the daemon keeps refreshing while the TUI is open.
+func lockCache() (*filesystemops.FileLock, error) {
+	path := nbaAPI.NewClient().Paths.GetFullPath("cacheLock", "")
+	lock, err := filesystemops.TryLock(path)
+	if errors.Is(err, filesystemops.ErrLocked) {
+		log.Println("waiting for the daemon to finish refreshing the cache")
+		lock, err = filesystemops.WaitLock(path, cacheLockTimeout)
+	}
+	return lock, err
+}
+
+// startNotifications polls the live scoreboard in the background for the games of the favorite teams,
+// independent of the view shown, until the context is done
+func startNotifications(ctx context.Context) {
+	cl := nbaAPI.NewClient()
+	cfg, err := notifications.LoadConfig(cl.FileSystem, cl.Paths)
+	if err != nil {
+		log.Println("notifications:", err)
+	}
+	if !cfg.Enabled {
+		return
+	}
+	sinks, err := notifications.NewSinks(cfg, programWriter{})
+	if err != nil {
+		log.Println("notifications:", err)
+	}
+	if len(sinks) == 0 {
+		return
+	}
+	store := filesystemops.NewFavoritesStore(cl.FileSystem, cl.Paths)
+	go notifications.NewNotifier(sinks).Run(ctx, cfg.Interval(), cl.FetchLiveScoreboard, store.Load)
+}
+
+// terminalNotificationMsg carries the bell or OSC sequence of a terminal notification sink to the program
+type terminalNotificationMsg string
+
+// terminalOutput is where the program renders to
+var terminalOutput io.Writer = os.Stdout
+
+// programWriter hands the output of the terminal notification sinks to the program. The notifier polls in its own
+// goroutine and must not write to the terminal while the program renders to it.
+type programWriter struct{}
+
+func (programWriter) Write(p []byte) (int, error) {
+	Program.Send(terminalNotificationMsg(p))
+	return len(p), nil
+}
+
+// writeTerminalNotifications filters the program's messages: the sequences of the terminal notification sinks are
+// written from the event loop, like bubbletea writes the window title, and never reach the models
+func writeTerminalNotifications(_ tea.Model, msg tea.Msg) tea.Msg {
+	if seq, ok := msg.(terminalNotificationMsg); ok {
+		if _, err := io.WriteString(terminalOutput, string(seq)); err != nil {
+			log.Println("notifications:", err)
+		}
+		return nil
+	}
+	return msg
+}
@@ -0,0 +1,27 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWriteTerminalNotifications(t *testing.T) {
+	var out bytes.Buffer
+	terminalOutput = &out
+	defer func() { terminalOutput = os.Stdout }()
+
+	if msg := writeTerminalNotifications(nil, terminalNotificationMsg("\a")); msg != nil {
+		t.Errorf("expected the notification not to reach the model, got %v", msg)
+	}
+	if out.String() != "\a" {
+		t.Errorf("terminal output = %q, want the bell", out.String())
+	}
+
+	size := tea.WindowSizeMsg{Width: 120, Height: 40}
+	if msg := writeTerminalNotifications(nil, size); msg != size {
+		t.Errorf("expected other messages to pass through, got %v", msg)
+	}
+}